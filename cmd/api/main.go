@@ -23,19 +23,34 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
 	"prodyo-backend/cmd/internal/config"
 	"prodyo-backend/cmd/internal/handlers"
+	"prodyo-backend/cmd/internal/jobs"
 	"prodyo-backend/cmd/internal/migrations"
 	"prodyo-backend/cmd/internal/repositories"
+	"prodyo-backend/cmd/internal/storage"
 	"prodyo-backend/cmd/internal/usecases"
 	_ "prodyo-backend/docs"
+	"syscall"
+	"time"
 )
 
+// shutdownTimeout bounds how long requests in flight get to finish once the server is stopped
+const shutdownTimeout = 10 * time.Second
+
 func main() {
 	cfg := config.Load()
 
+	// Cancelled on SIGINT or SIGTERM, stopping the background jobs and the server
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	log.Println("Running database migrations...")
 	if err := migrations.RunMigrations(cfg.DSN(), "cmd/migrations"); err != nil {
 		log.Fatalf("Failed to run migrations: %v", err)
@@ -46,18 +61,31 @@ func main() {
 
 	repos := repositories.New(db)
 
+	fileStorage, err := storage.New(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize file storage: %v", err)
+	}
+
 	// Initialize use cases
-	projectUseCase := usecases.NewProjectUseCase(repos.Project)
+	projectUseCase := usecases.NewProjectUseCase(repos.Project, repos.Attachment, fileStorage)
 	userUseCase := usecases.NewUserUseCase(repos.User)
 	authUseCase := usecases.NewAuthUseCase(repos.User, repos.Session)
-	iterationUseCase := usecases.NewIterationUseCase(repos.Iteration, repos.Task, repos.IndicatorRange)
-	taskUseCase := usecases.NewTaskUseCase(repos.Task)
+	iterationUseCase := usecases.NewIterationUseCase(repos.Iteration, repos.Task, repos.IndicatorRange, repos.Project, repos.Availability, repos.Calendar, repos.Cadence, repos.Attachment, fileStorage)
+	taskUseCase := usecases.NewTaskUseCase(repos.Task, repos.Iteration, repos.StatusTransition, repos.Comment, repos.Attachment, repos.Dependency, repos.WIPLimit, repos.Indicator, repos.Calendar, repos.Project, fileStorage)
 	improvUseCase := usecases.NewImprovUseCase(repos.Improv)
 	bugUseCase := usecases.NewBugUseCase(repos.Bug)
 	indicatorUseCase := usecases.NewIndicatorUseCase(repos.Indicator, repos.IndicatorRange)
 	indicatorRangeUseCase := usecases.NewIndicatorRangeUseCase(repos.IndicatorRange)
 	causeUseCase := usecases.NewCauseUseCase(repos.Cause)
 	actionUseCase := usecases.NewActionUseCase(repos.Action)
+	retrospectiveUseCase := usecases.NewRetrospectiveUseCase(repos.Retrospective, repos.Iteration, repos.Project, repos.IndicatorRange)
+	dashboardUseCase := usecases.NewDashboardUseCase(repos.Dashboard, repos.Project, repos.Calendar)
+	searchUseCase := usecases.NewSearchUseCase(repos.Search)
+	labelUseCase := usecases.NewLabelUseCase(repos.Label, repos.Project, repos.Task, repos.Bug, repos.Improv)
+	commentUseCase := usecases.NewCommentUseCase(repos.Comment, repos.Project)
+	notificationUseCase := usecases.NewNotificationUseCase(repos.Notification)
+	attachmentUseCase := usecases.NewAttachmentUseCase(repos.Attachment, repos.Project, fileStorage)
+	calendarUseCase := usecases.NewCalendarUseCase(repos.Calendar, repos.Project)
 
 	router := handlers.SetupRoutes(
 		projectUseCase,
@@ -71,12 +99,45 @@ func main() {
 		indicatorRangeUseCase,
 		causeUseCase,
 		actionUseCase,
+		retrospectiveUseCase,
+		dashboardUseCase,
+		searchUseCase,
+		labelUseCase,
+		commentUseCase,
+		notificationUseCase,
+		attachmentUseCase,
+		calendarUseCase,
 	)
 
-	handler := handlers.CorsMiddleware(router)
+	// Background jobs
+	var scheduleInterval time.Duration
+	if cfg.IterationScheduleInterval != "" {
+		scheduleInterval, err = time.ParseDuration(cfg.IterationScheduleInterval)
+		if err != nil {
+			log.Fatalf("Invalid ITERATION_SCHEDULE_INTERVAL: %v", err)
+		}
+	}
+	go jobs.NewIterationSchedule(iterationUseCase, scheduleInterval).Run(ctx)
+
+	server := &http.Server{
+		Addr:    ":8081",
+		Handler: handlers.CorsMiddleware(router),
+	}
+	shutdownDone := make(chan struct{})
+	go func() {
+		defer close(shutdownDone)
+		<-ctx.Done()
+		log.Println("Shutting down server...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Printf("Failed to shut down server: %v", err)
+		}
+	}()
 
 	log.Println("Starting server on :8081")
-	if err := http.ListenAndServe(":8081", handler); err != nil {
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		log.Fatalf("Failed to start server: %v", err)
 	}
+	<-shutdownDone
 }
@@ -11,6 +11,18 @@ type Config struct {
 	DBUser     string
 	DBPassword string
 	DBName     string
+
+	// File storage for attachments: local (default) or s3
+	StorageDriver     string
+	StorageLocalPath  string
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+
+	// How often upcoming iterations are generated from project cadences, e.g. 30m; 1h when empty
+	IterationScheduleInterval string
 }
 
 func Load() *Config {
@@ -20,6 +32,16 @@ func Load() *Config {
 		DBUser:     os.Getenv("DB_USER"),
 		DBPassword: os.Getenv("DB_PASSWORD"),
 		DBName:     os.Getenv("DB_NAME"),
+
+		StorageDriver:     os.Getenv("STORAGE_DRIVER"),
+		StorageLocalPath:  os.Getenv("STORAGE_LOCAL_PATH"),
+		S3Endpoint:        os.Getenv("S3_ENDPOINT"),
+		S3Region:          os.Getenv("S3_REGION"),
+		S3Bucket:          os.Getenv("S3_BUCKET"),
+		S3AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+		S3SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+
+		IterationScheduleInterval: os.Getenv("ITERATION_SCHEDULE_INTERVAL"),
 	}
 }
 
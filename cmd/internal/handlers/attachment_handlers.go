@@ -0,0 +1,432 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/attachment"
+	"prodyo-backend/cmd/internal/repositories/project"
+	"prodyo-backend/cmd/internal/storage"
+	"prodyo-backend/cmd/internal/usecases"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+// multipartMemory is how much of an upload is kept in memory before spilling to a temporary file
+const multipartMemory = 8 << 20
+
+type AttachmentHandlers struct {
+	attachmentUseCase *usecases.AttachmentUseCase
+}
+
+func NewAttachmentHandlers(attachmentUseCase *usecases.AttachmentUseCase) *AttachmentHandlers {
+	return &AttachmentHandlers{
+		attachmentUseCase: attachmentUseCase,
+	}
+}
+
+type AttachmentSettingsRequest struct {
+	MaxSizeBytes int64    `json:"max_size_bytes"`
+	AllowedTypes []string `json:"allowed_types"`
+}
+
+// GetTaskAttachments handles GET /tasks/{id}/attachments
+// @Summary Get task attachments
+// @Description Get the metadata of the files attached to a task, oldest first
+// @Tags attachments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {array} models.Attachment "Attachments"
+// @Failure 400 {string} string "Invalid task ID"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project members can see or upload files"
+// @Failure 404 {string} string "Task not found"
+// @Failure 500 {string} string "Failed to retrieve attachments"
+// @Router /tasks/{id}/attachments [get]
+func (h *AttachmentHandlers) GetTaskAttachments(w http.ResponseWriter, r *http.Request) {
+	h.getAttachments(w, r, models.AttachmentOnTask)
+}
+
+// UploadTaskAttachment handles POST /tasks/{id}/attachments
+// @Summary Attach a file to a task
+// @Description Upload a file as multipart/form-data in the file field. Size and media type are checked against the project limits
+// @Tags attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Task ID" format(uuid)
+// @Param file formData file true "File to attach"
+// @Success 201 {object} models.Attachment "Uploaded attachment"
+// @Failure 400 {string} string "Invalid task ID, form or file name"
+// @Failure 403 {string} string "Only project members can see or upload files"
+// @Failure 404 {string} string "Task not found"
+// @Failure 413 {string} string "File is larger than the project allows"
+// @Failure 415 {string} string "File type is not allowed in this project"
+// @Failure 500 {string} string "Failed to upload file"
+// @Router /tasks/{id}/attachments [post]
+func (h *AttachmentHandlers) UploadTaskAttachment(w http.ResponseWriter, r *http.Request) {
+	h.upload(w, r, models.AttachmentOnTask)
+}
+
+// GetBugAttachments handles GET /bugs/{id}/attachments
+// @Summary Get bug attachments
+// @Description Get the metadata of the files attached to a bug, oldest first
+// @Tags attachments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Bug ID" format(uuid)
+// @Success 200 {array} models.Attachment "Attachments"
+// @Failure 400 {string} string "Invalid bug ID"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project members can see or upload files"
+// @Failure 404 {string} string "Bug not found"
+// @Failure 500 {string} string "Failed to retrieve attachments"
+// @Router /bugs/{id}/attachments [get]
+func (h *AttachmentHandlers) GetBugAttachments(w http.ResponseWriter, r *http.Request) {
+	h.getAttachments(w, r, models.AttachmentOnBug)
+}
+
+// UploadBugAttachment handles POST /bugs/{id}/attachments
+// @Summary Attach a file to a bug
+// @Description Upload a file, such as a screenshot or a log, as multipart/form-data in the file field. Size and media type are checked against the project limits
+// @Tags attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Bug ID" format(uuid)
+// @Param file formData file true "File to attach"
+// @Success 201 {object} models.Attachment "Uploaded attachment"
+// @Failure 400 {string} string "Invalid bug ID, form or file name"
+// @Failure 403 {string} string "Only project members can see or upload files"
+// @Failure 404 {string} string "Bug not found"
+// @Failure 413 {string} string "File is larger than the project allows"
+// @Failure 415 {string} string "File type is not allowed in this project"
+// @Failure 500 {string} string "Failed to upload file"
+// @Router /bugs/{id}/attachments [post]
+func (h *AttachmentHandlers) UploadBugAttachment(w http.ResponseWriter, r *http.Request) {
+	h.upload(w, r, models.AttachmentOnBug)
+}
+
+// GetImprovementAttachments handles GET /improvements/{id}/attachments
+// @Summary Get improvement attachments
+// @Description Get the metadata of the files attached to an improvement, oldest first
+// @Tags attachments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Improvement ID" format(uuid)
+// @Success 200 {array} models.Attachment "Attachments"
+// @Failure 400 {string} string "Invalid improvement ID"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project members can see or upload files"
+// @Failure 404 {string} string "Improvement not found"
+// @Failure 500 {string} string "Failed to retrieve attachments"
+// @Router /improvements/{id}/attachments [get]
+func (h *AttachmentHandlers) GetImprovementAttachments(w http.ResponseWriter, r *http.Request) {
+	h.getAttachments(w, r, models.AttachmentOnImprovement)
+}
+
+// UploadImprovementAttachment handles POST /improvements/{id}/attachments
+// @Summary Attach a file to an improvement
+// @Description Upload a file as multipart/form-data in the file field. Size and media type are checked against the project limits
+// @Tags attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Improvement ID" format(uuid)
+// @Param file formData file true "File to attach"
+// @Success 201 {object} models.Attachment "Uploaded attachment"
+// @Failure 400 {string} string "Invalid improvement ID, form or file name"
+// @Failure 403 {string} string "Only project members can see or upload files"
+// @Failure 404 {string} string "Improvement not found"
+// @Failure 413 {string} string "File is larger than the project allows"
+// @Failure 415 {string} string "File type is not allowed in this project"
+// @Failure 500 {string} string "Failed to upload file"
+// @Router /improvements/{id}/attachments [post]
+func (h *AttachmentHandlers) UploadImprovementAttachment(w http.ResponseWriter, r *http.Request) {
+	h.upload(w, r, models.AttachmentOnImprovement)
+}
+
+// DownloadAttachment handles GET /attachments/{id}
+// @Summary Download an attachment
+// @Description Download the content of an attached file
+// @Tags attachments
+// @Produce octet-stream
+// @Security BearerAuth
+// @Param id path string true "Attachment ID" format(uuid)
+// @Success 200 {file} file "File content"
+// @Failure 400 {string} string "Invalid attachment ID"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project members can see or upload files"
+// @Failure 404 {string} string "Attachment not found"
+// @Failure 500 {string} string "Failed to download file"
+// @Router /attachments/{id} [get]
+func (h *AttachmentHandlers) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid attachment ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	a, content, err := h.attachmentUseCase.Open(ctx, id, user.ID)
+	if err != nil {
+		writeAttachmentError(w, err, "", "Failed to download file")
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", a.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(a.SizeBytes, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": a.FileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, content); err != nil {
+		log.Printf("Failed to send attachment %s: %v", a.ID, err)
+	}
+}
+
+// DeleteAttachment handles DELETE /attachments/{id}
+// @Summary Delete an attachment
+// @Description Delete an attached file. The uploader, project owners and maintainers can delete it
+// @Tags attachments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Attachment ID" format(uuid)
+// @Success 204 "Attachment deleted"
+// @Failure 400 {string} string "Invalid attachment ID"
+// @Failure 403 {string} string "Only the uploader, owners and maintainers can delete a file"
+// @Failure 404 {string} string "Attachment not found"
+// @Failure 500 {string} string "Failed to delete file"
+// @Router /attachments/{id} [delete]
+func (h *AttachmentHandlers) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid attachment ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.attachmentUseCase.Delete(ctx, id, user.ID); err != nil {
+		if errors.Is(err, usecases.ErrNotProjectMember) || errors.Is(err, usecases.ErrInsufficientRole) {
+			http.Error(w, "Only the uploader, owners and maintainers can delete a file", http.StatusForbidden)
+			return
+		}
+		writeAttachmentError(w, err, "", "Failed to delete file")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetAttachmentSettings handles GET /projects/{id}/attachment-settings
+// @Summary Get project upload limits
+// @Description Get the maximum file size and the allowed media types of a project
+// @Tags attachments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Success 200 {object} models.AttachmentSettings "Upload limits"
+// @Failure 400 {string} string "Invalid project ID"
+// @Failure 404 {string} string "Project not found"
+// @Failure 500 {string} string "Failed to retrieve upload limits"
+// @Router /projects/{id}/attachment-settings [get]
+func (h *AttachmentHandlers) GetAttachmentSettings(w http.ResponseWriter, r *http.Request) {
+	projectID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	settings, err := h.attachmentUseCase.GetSettings(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, project.ErrNotFound) {
+			http.Error(w, "Project not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to retrieve upload limits", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(settings)
+}
+
+// UpdateAttachmentSettings handles PUT /projects/{id}/attachment-settings
+// @Summary Set project upload limits
+// @Description Set the maximum file size (up to 100 MB) and the allowed media types, such as image/* or application/pdf. Owners and maintainers only
+// @Tags attachments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param settings body AttachmentSettingsRequest true "Upload limits"
+// @Success 200 {object} models.AttachmentSettings "Upload limits"
+// @Failure 400 {string} string "Invalid project ID, request body or limits"
+// @Failure 403 {string} string "Only project owners and maintainers can change upload limits"
+// @Failure 500 {string} string "Failed to update upload limits"
+// @Router /projects/{id}/attachment-settings [put]
+func (h *AttachmentHandlers) UpdateAttachmentSettings(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	projectID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	var req AttachmentSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	settings, err := h.attachmentUseCase.UpdateSettings(ctx, models.AttachmentSettings{
+		ProjectID:    projectID,
+		MaxSizeBytes: req.MaxSizeBytes,
+		AllowedTypes: req.AllowedTypes,
+	}, user.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidAttachmentLimits):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, usecases.ErrNotProjectMember), errors.Is(err, usecases.ErrInsufficientRole):
+			http.Error(w, "Only project owners and maintainers can change upload limits", http.StatusForbidden)
+		default:
+			http.Error(w, "Failed to update upload limits", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(settings)
+}
+
+func (h *AttachmentHandlers) getAttachments(w http.ResponseWriter, r *http.Request, targetType models.AttachmentTargetType) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	targetID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid "+string(targetType)+" ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	attachments, err := h.attachmentUseCase.GetByTarget(ctx, targetType, targetID, user.ID)
+	if err != nil {
+		writeAttachmentError(w, err, targetType, "Failed to retrieve attachments")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(attachments)
+}
+
+func (h *AttachmentHandlers) upload(w http.ResponseWriter, r *http.Request, targetType models.AttachmentTargetType) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	targetID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid "+string(targetType)+" ID", http.StatusBadRequest)
+		return
+	}
+
+	// No project allows more than MaxAttachmentSizeBytes; the margin covers the multipart framing
+	r.Body = http.MaxBytesReader(w, r.Body, models.MaxAttachmentSizeBytes+1<<20)
+	if err := r.ParseMultipartForm(multipartMemory); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, usecases.ErrAttachmentTooLarge.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "Invalid multipart form", http.StatusBadRequest)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "Missing file field", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	ctx := r.Context()
+	created, err := h.attachmentUseCase.Upload(ctx, targetType, targetID, user.ID, usecases.Upload{
+		FileName: header.Filename,
+		Size:     header.Size,
+		Content:  file,
+	})
+	if err != nil {
+		writeAttachmentError(w, err, targetType, "Failed to upload file")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// writeAttachmentError maps attachment errors to HTTP status codes
+func writeAttachmentError(w http.ResponseWriter, err error, targetType models.AttachmentTargetType, fallback string) {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidAttachmentName):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, usecases.ErrNotProjectMember):
+		http.Error(w, "Only project members can see or upload files", http.StatusForbidden)
+	case errors.Is(err, usecases.ErrAttachmentTooLarge):
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+	case errors.Is(err, usecases.ErrAttachmentTypeNotAllowed):
+		http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+	case errors.Is(err, attachment.ErrTargetNotFound):
+		switch targetType {
+		case models.AttachmentOnBug:
+			http.Error(w, "Bug not found", http.StatusNotFound)
+		case models.AttachmentOnImprovement:
+			http.Error(w, "Improvement not found", http.StatusNotFound)
+		default:
+			http.Error(w, "Task not found", http.StatusNotFound)
+		}
+	case errors.Is(err, attachment.ErrNotFound), errors.Is(err, storage.ErrObjectNotFound):
+		http.Error(w, "Attachment not found", http.StatusNotFound)
+	default:
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
@@ -30,14 +30,21 @@ type CreateBugRequest struct {
 
 // GetAll handles GET /bugs
 // @Summary Get all bugs
-// @Description Get all bugs for a specific task
+// @Description Get the bugs of a task, filtered by assignee, labels, points or dates
 // @Tags bugs
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param task_id query string true "Task ID" format(uuid)
+// @Param assignee_id query string false "Comma separated assignee IDs"
+// @Param label query string false "Comma separated label names; bugs with any of them"
+// @Param label_id query string false "Comma separated label IDs; bugs with any of them"
+// @Param points query string false "Points; points_gte, points_lte, points_gt and points_lt are also accepted"
+// @Param created_at_gte query string false "Created at or after; also created_at_lte, updated_at_gte, ..."
+// @Param sort query string false "Comma separated fields, descending with a - prefix, e.g. -points; by number by default" Enums(number, points, created_at, updated_at)
+// @Param q query string false "Search in description"
 // @Success 200 {array} models.Bug "List of bugs"
-// @Failure 400 {string} string "Invalid task_id"
+// @Failure 400 {string} string "Invalid task_id, unknown filter field or invalid value"
 // @Failure 500 {string} string "Failed to retrieve bugs"
 // @Router /bugs [get]
 func (h *BugHandlers) GetAll(w http.ResponseWriter, r *http.Request) {
@@ -53,8 +60,14 @@ func (h *BugHandlers) GetAll(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	listQuery, err := parseListQuery(r, models.BugListSpec, "task_id")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	ctx := r.Context()
-	bugs, err := h.bugUseCase.GetAll(ctx, taskID)
+	bugs, err := h.bugUseCase.GetAll(ctx, taskID, listQuery)
 	if err != nil {
 		http.Error(w, "Failed to retrieve bugs", http.StatusInternalServerError)
 		return
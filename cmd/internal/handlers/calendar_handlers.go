@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/calendar"
+	"prodyo-backend/cmd/internal/services"
+	"prodyo-backend/cmd/internal/usecases"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+// maxICalendarSize bounds the iCalendar files imported as holidays
+const maxICalendarSize = 1 << 20
+
+type CalendarHandlers struct {
+	calendarUseCase *usecases.CalendarUseCase
+}
+
+func NewCalendarHandlers(calendarUseCase *usecases.CalendarUseCase) *CalendarHandlers {
+	return &CalendarHandlers{
+		calendarUseCase: calendarUseCase,
+	}
+}
+
+type SetCalendarRequest struct {
+	Timezone    string           `json:"timezone" example:"America/Sao_Paulo"`
+	WorkingDays []time.Weekday   `json:"working_days" swaggertype:"array,integer" example:"1,2,3,4,5"` // 0 is Sunday
+	Holidays    []models.Holiday `json:"holidays"`
+}
+
+// GetCalendar handles GET /projects/{id}/calendar
+// @Summary Get project calendar
+// @Description Get the timezone, working weekdays and holidays used by every duration in days of the project; projects that never set one work Monday to Friday in UTC
+// @Tags projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Success 200 {object} models.WorkingCalendar "Project calendar"
+// @Failure 400 {string} string "Invalid project ID"
+// @Failure 500 {string} string "Failed to retrieve calendar"
+// @Router /projects/{id}/calendar [get]
+func (h *CalendarHandlers) GetCalendar(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	cal, err := h.calendarUseCase.GetCalendar(ctx, projectID)
+	if err != nil {
+		writeCalendarError(w, err, "Failed to retrieve calendar")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(cal)
+}
+
+// SetCalendar handles PUT /projects/{id}/calendar
+// @Summary Replace project calendar
+// @Description Replace the timezone, working weekdays (0 is Sunday) and holidays of a project; burndown, speed, capacity and forecasts count only its working days
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param calendar body SetCalendarRequest true "Project calendar"
+// @Success 200 {object} models.WorkingCalendar "Project calendar"
+// @Failure 400 {string} string "Invalid project ID or request body"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project owners and maintainers can change the calendar"
+// @Failure 404 {string} string "Project not found"
+// @Failure 422 {string} string "Invalid timezone, working days or holidays"
+// @Failure 500 {string} string "Failed to update calendar"
+// @Router /projects/{id}/calendar [put]
+func (h *CalendarHandlers) SetCalendar(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	var req SetCalendarRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	cal, err := h.calendarUseCase.SetCalendar(ctx, projectID, req.Timezone, req.WorkingDays, req.Holidays, requester.ID)
+	if err != nil {
+		writeCalendarError(w, err, "Failed to update calendar")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(cal)
+}
+
+// ImportHolidays handles POST /projects/{id}/calendar/holidays/import
+// @Summary Import holidays
+// @Description Add the events of an iCalendar (.ics) file to the project holidays, each day of an event becoming a holiday named after its summary; holidays already on those dates are renamed. Recurring events are imported for their first date only
+// @Tags projects
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param file formData file true "iCalendar file"
+// @Success 200 {object} models.WorkingCalendar "Project calendar"
+// @Failure 400 {string} string "Invalid project ID, multipart form or missing file field"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project owners and maintainers can change the calendar"
+// @Failure 404 {string} string "Project not found"
+// @Failure 413 {string} string "File too large"
+// @Failure 422 {string} string "Invalid iCalendar file"
+// @Failure 500 {string} string "Failed to import holidays"
+// @Router /projects/{id}/calendar/holidays/import [post]
+func (h *CalendarHandlers) ImportHolidays(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// The margin covers the multipart framing
+	r.Body = http.MaxBytesReader(w, r.Body, maxICalendarSize+1<<16)
+	if err := r.ParseMultipartForm(multipartMemory); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "File too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "Invalid multipart form", http.StatusBadRequest)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "Missing file field", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	ctx := r.Context()
+	cal, err := h.calendarUseCase.ImportHolidays(ctx, projectID, file, requester.ID)
+	if err != nil {
+		writeCalendarError(w, err, "Failed to import holidays")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(cal)
+}
+
+func writeCalendarError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, usecases.ErrNotProjectMember), errors.Is(err, usecases.ErrInsufficientRole):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, calendar.ErrProjectNotFound):
+		http.Error(w, "Project not found", http.StatusNotFound)
+	case errors.Is(err, models.ErrInvalidTimezone), errors.Is(err, models.ErrInvalidWorkingDays), errors.Is(err, models.ErrInvalidHoliday):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	case errors.Is(err, services.ErrInvalidICalendar):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
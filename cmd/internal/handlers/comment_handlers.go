@@ -0,0 +1,355 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/comment"
+	"prodyo-backend/cmd/internal/usecases"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type CommentHandlers struct {
+	commentUseCase *usecases.CommentUseCase
+}
+
+func NewCommentHandlers(commentUseCase *usecases.CommentUseCase) *CommentHandlers {
+	return &CommentHandlers{
+		commentUseCase: commentUseCase,
+	}
+}
+
+type CreateCommentRequest struct {
+	Body     string     `json:"body"`
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body"`
+}
+
+// GetTaskComments handles GET /tasks/{id}/comments
+// @Summary Get task comments
+// @Description Get the comments of a task as threads, oldest first. Replies are nested under their parent
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {array} models.Comment "Comment threads"
+// @Failure 400 {string} string "Invalid task ID"
+// @Failure 403 {string} string "Only project members can read comments"
+// @Failure 404 {string} string "Task not found"
+// @Failure 500 {string} string "Failed to retrieve comments"
+// @Router /tasks/{id}/comments [get]
+func (h *CommentHandlers) GetTaskComments(w http.ResponseWriter, r *http.Request) {
+	h.getComments(w, r, models.CommentOnTask)
+}
+
+// CreateTaskComment handles POST /tasks/{id}/comments
+// @Summary Comment on a task
+// @Description Add a markdown comment, or a reply when parent_id is set. @email or @user-id mentions of project members notify them, as do @name mentions (the part of the email before the @) when only one member has that name
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Task ID" format(uuid)
+// @Param comment body CreateCommentRequest true "Comment data"
+// @Success 201 {object} models.Comment "Created comment"
+// @Failure 400 {string} string "Invalid task ID, request body or parent comment"
+// @Failure 403 {string} string "Only project members can comment"
+// @Failure 404 {string} string "Task not found"
+// @Failure 500 {string} string "Failed to create comment"
+// @Router /tasks/{id}/comments [post]
+func (h *CommentHandlers) CreateTaskComment(w http.ResponseWriter, r *http.Request) {
+	h.createComment(w, r, models.CommentOnTask)
+}
+
+// GetBugComments handles GET /bugs/{id}/comments
+// @Summary Get bug comments
+// @Description Get the comments of a bug as threads, oldest first. Replies are nested under their parent
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Bug ID" format(uuid)
+// @Success 200 {array} models.Comment "Comment threads"
+// @Failure 400 {string} string "Invalid bug ID"
+// @Failure 403 {string} string "Only project members can read comments"
+// @Failure 404 {string} string "Bug not found"
+// @Failure 500 {string} string "Failed to retrieve comments"
+// @Router /bugs/{id}/comments [get]
+func (h *CommentHandlers) GetBugComments(w http.ResponseWriter, r *http.Request) {
+	h.getComments(w, r, models.CommentOnBug)
+}
+
+// CreateBugComment handles POST /bugs/{id}/comments
+// @Summary Comment on a bug
+// @Description Add a markdown comment, or a reply when parent_id is set. @email or @user-id mentions of project members notify them, as do @name mentions (the part of the email before the @) when only one member has that name
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Bug ID" format(uuid)
+// @Param comment body CreateCommentRequest true "Comment data"
+// @Success 201 {object} models.Comment "Created comment"
+// @Failure 400 {string} string "Invalid bug ID, request body or parent comment"
+// @Failure 403 {string} string "Only project members can comment"
+// @Failure 404 {string} string "Bug not found"
+// @Failure 500 {string} string "Failed to create comment"
+// @Router /bugs/{id}/comments [post]
+func (h *CommentHandlers) CreateBugComment(w http.ResponseWriter, r *http.Request) {
+	h.createComment(w, r, models.CommentOnBug)
+}
+
+// GetImprovementComments handles GET /improvements/{id}/comments
+// @Summary Get improvement comments
+// @Description Get the comments of an improvement as threads, oldest first. Replies are nested under their parent
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Improvement ID" format(uuid)
+// @Success 200 {array} models.Comment "Comment threads"
+// @Failure 400 {string} string "Invalid improvement ID"
+// @Failure 403 {string} string "Only project members can read comments"
+// @Failure 404 {string} string "Improvement not found"
+// @Failure 500 {string} string "Failed to retrieve comments"
+// @Router /improvements/{id}/comments [get]
+func (h *CommentHandlers) GetImprovementComments(w http.ResponseWriter, r *http.Request) {
+	h.getComments(w, r, models.CommentOnImprovement)
+}
+
+// CreateImprovementComment handles POST /improvements/{id}/comments
+// @Summary Comment on an improvement
+// @Description Add a markdown comment, or a reply when parent_id is set. @email or @user-id mentions of project members notify them, as do @name mentions (the part of the email before the @) when only one member has that name
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Improvement ID" format(uuid)
+// @Param comment body CreateCommentRequest true "Comment data"
+// @Success 201 {object} models.Comment "Created comment"
+// @Failure 400 {string} string "Invalid improvement ID, request body or parent comment"
+// @Failure 403 {string} string "Only project members can comment"
+// @Failure 404 {string} string "Improvement not found"
+// @Failure 500 {string} string "Failed to create comment"
+// @Router /improvements/{id}/comments [post]
+func (h *CommentHandlers) CreateImprovementComment(w http.ResponseWriter, r *http.Request) {
+	h.createComment(w, r, models.CommentOnImprovement)
+}
+
+// GetActionComments handles GET /indicators/actions/{id}/comments
+// @Summary Get action comments
+// @Description Get the comments of an action as threads, oldest first. Replies are nested under their parent
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Action ID" format(uuid)
+// @Success 200 {array} models.Comment "Comment threads"
+// @Failure 400 {string} string "Invalid action ID"
+// @Failure 403 {string} string "Only project members can read comments"
+// @Failure 404 {string} string "Action not found"
+// @Failure 500 {string} string "Failed to retrieve comments"
+// @Router /indicators/actions/{id}/comments [get]
+func (h *CommentHandlers) GetActionComments(w http.ResponseWriter, r *http.Request) {
+	h.getComments(w, r, models.CommentOnAction)
+}
+
+// CreateActionComment handles POST /indicators/actions/{id}/comments
+// @Summary Comment on an action
+// @Description Add a markdown comment, or a reply when parent_id is set. @email or @user-id mentions of project members notify them, as do @name mentions (the part of the email before the @) when only one member has that name
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Action ID" format(uuid)
+// @Param comment body CreateCommentRequest true "Comment data"
+// @Success 201 {object} models.Comment "Created comment"
+// @Failure 400 {string} string "Invalid action ID, request body or parent comment"
+// @Failure 403 {string} string "Only project members can comment"
+// @Failure 404 {string} string "Action not found"
+// @Failure 500 {string} string "Failed to create comment"
+// @Router /indicators/actions/{id}/comments [post]
+func (h *CommentHandlers) CreateActionComment(w http.ResponseWriter, r *http.Request) {
+	h.createComment(w, r, models.CommentOnAction)
+}
+
+// UpdateComment handles PUT /comments/{id}
+// @Summary Edit a comment
+// @Description Replace the body of a comment. Only the author can edit; newly mentioned members are notified
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Comment ID" format(uuid)
+// @Param comment body UpdateCommentRequest true "Comment data"
+// @Success 200 {object} models.Comment "Updated comment"
+// @Failure 400 {string} string "Invalid comment ID or request body"
+// @Failure 403 {string} string "Only the author can change a comment"
+// @Failure 404 {string} string "Comment not found"
+// @Failure 500 {string} string "Failed to update comment"
+// @Router /comments/{id} [put]
+func (h *CommentHandlers) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid comment ID", http.StatusBadRequest)
+		return
+	}
+
+	var req UpdateCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	updated, err := h.commentUseCase.Update(ctx, id, req.Body, user.ID)
+	if err != nil {
+		writeCommentError(w, err, "", "Failed to update comment")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(updated)
+}
+
+// DeleteComment handles DELETE /comments/{id}
+// @Summary Delete a comment
+// @Description Delete a comment. Only the author can delete; replies stay in the thread under a deleted placeholder
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Comment ID" format(uuid)
+// @Success 204 "Comment deleted"
+// @Failure 400 {string} string "Invalid comment ID"
+// @Failure 403 {string} string "Only the author can change a comment"
+// @Failure 404 {string} string "Comment not found"
+// @Failure 500 {string} string "Failed to delete comment"
+// @Router /comments/{id} [delete]
+func (h *CommentHandlers) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid comment ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.commentUseCase.Delete(ctx, id, user.ID); err != nil {
+		writeCommentError(w, err, "", "Failed to delete comment")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CommentHandlers) getComments(w http.ResponseWriter, r *http.Request, targetType models.CommentTargetType) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	targetID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid "+commentTargetName(targetType)+" ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	comments, err := h.commentUseCase.GetThreads(ctx, targetType, targetID, user.ID)
+	if err != nil {
+		writeCommentError(w, err, targetType, "Failed to retrieve comments")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(comments)
+}
+
+func (h *CommentHandlers) createComment(w http.ResponseWriter, r *http.Request, targetType models.CommentTargetType) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	targetID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid "+commentTargetName(targetType)+" ID", http.StatusBadRequest)
+		return
+	}
+
+	var req CreateCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	created, err := h.commentUseCase.Create(ctx, models.Comment{
+		TargetType: targetType,
+		TargetID:   targetID,
+		ParentID:   req.ParentID,
+		Body:       req.Body,
+	}, user.ID)
+	if err != nil {
+		writeCommentError(w, err, targetType, "Failed to create comment")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+func commentTargetName(targetType models.CommentTargetType) string {
+	switch targetType {
+	case models.CommentOnTask:
+		return "task"
+	case models.CommentOnBug:
+		return "bug"
+	case models.CommentOnImprovement:
+		return "improvement"
+	case models.CommentOnAction:
+		return "action"
+	}
+	return "item"
+}
+
+// writeCommentError maps comment errors to HTTP status codes
+func writeCommentError(w http.ResponseWriter, err error, targetType models.CommentTargetType, fallback string) {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidCommentBody), errors.Is(err, usecases.ErrInvalidParentComment):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, usecases.ErrNotProjectMember):
+		http.Error(w, "Only project members can read or add comments", http.StatusForbidden)
+	case errors.Is(err, usecases.ErrNotCommentAuthor):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, comment.ErrTargetNotFound):
+		name := commentTargetName(targetType)
+		http.Error(w, strings.ToUpper(name[:1])+name[1:]+" not found", http.StatusNotFound)
+	case errors.Is(err, comment.ErrNotFound):
+		http.Error(w, "Comment not found", http.StatusNotFound)
+	default:
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"prodyo-backend/cmd/internal/repositories/dashboard"
+	"prodyo-backend/cmd/internal/usecases"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type DashboardHandlers struct {
+	dashboardUseCase *usecases.DashboardUseCase
+}
+
+func NewDashboardHandlers(dashboardUseCase *usecases.DashboardUseCase) *DashboardHandlers {
+	return &DashboardHandlers{
+		dashboardUseCase: dashboardUseCase,
+	}
+}
+
+// GetProjectDashboard handles GET /projects/{id}/dashboard
+// @Summary Get project dashboard
+// @Description Get everything the project home page shows in one response: current iteration status, latest classification of each indicator, open and overdue actions, bug and improvement totals and recent activity
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Success 200 {object} models.ProjectDashboard "Project dashboard"
+// @Failure 400 {string} string "Invalid project ID"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "User is not a member of the project"
+// @Failure 404 {string} string "Project not found"
+// @Failure 500 {string} string "Failed to load dashboard"
+// @Router /projects/{id}/dashboard [get]
+func (h *DashboardHandlers) GetProjectDashboard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	result, err := h.dashboardUseCase.GetProjectDashboard(ctx, projectID, requester.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, dashboard.ErrProjectNotFound):
+			http.Error(w, "Project not found", http.StatusNotFound)
+		case errors.Is(err, usecases.ErrNotProjectMember):
+			http.Error(w, err.Error(), http.StatusForbidden)
+		default:
+			http.Error(w, "Failed to load dashboard", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		log.Printf("Error encoding dashboard response: %v", err)
+	}
+}
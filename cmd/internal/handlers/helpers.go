@@ -2,9 +2,14 @@ package handlers
 
 import (
 	"fmt"
+	"net/http"
 	"prodyo-backend/cmd/internal/models"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 func parseDuration(durationStr string) (int64, error) {
@@ -44,27 +49,242 @@ func parseTime(timeStr string) (time.Time, error) {
 	return time.Time{}, err
 }
 
-func normalizeStatus(statusStr string) models.StatusEnum {
-	if statusStr == "" {
-		return models.StatusNotStarted
-	}
-
+// parseStatus accepts the status names in any case, with or without separators
+// Anything that is not a known status is an error, including an empty value
+func parseStatus(statusStr string) (models.StatusEnum, error) {
 	lower := strings.ToLower(statusStr)
 	lower = strings.ReplaceAll(lower, "_", "")
 	lower = strings.ReplaceAll(lower, "-", "")
+	lower = strings.ReplaceAll(lower, " ", "")
+
+	for _, status := range models.AllStatuses() {
+		if strings.ToLower(string(status)) == lower {
+			return status, nil
+		}
+	}
+
+	allowed := make([]string, 0, len(models.AllStatuses()))
+	for _, status := range models.AllStatuses() {
+		allowed = append(allowed, string(status))
+	}
+	return "", fmt.Errorf("unknown status: %s (allowed: %s)", statusStr, strings.Join(allowed, ", "))
+}
+
+// indicatorTypeNames lists the indicator types for error messages
+func indicatorTypeNames() string {
+	names := make([]string, 0, len(models.AllIndicatorTypes()))
+	for _, t := range models.AllIndicatorTypes() {
+		names = append(names, string(t))
+	}
+	return strings.Join(names, ", ")
+}
+
+// parsePagination reads page, page_size and cursor from the query string
+// Out of range page numbers and sizes fall back to the defaults; a malformed cursor is an error
+func parsePagination(r *http.Request) (models.PaginationRequest, error) {
+	pagination := models.PaginationRequest{
+		Page:     1,
+		PageSize: 20,
+	}
+
+	if page := r.URL.Query().Get("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil && p > 0 {
+			pagination.Page = p
+		}
+	}
+
+	if pageSize := r.URL.Query().Get("page_size"); pageSize != "" {
+		if ps, err := strconv.Atoi(pageSize); err == nil && ps > 0 && ps <= 100 {
+			pagination.PageSize = ps
+		}
+	}
+
+	pagination.Cursor = r.URL.Query().Get("cursor")
+	if _, err := pagination.ParseCursor(); err != nil {
+		return models.PaginationRequest{}, err
+	}
+
+	return pagination, nil
+}
+
+// parseInclude reads the comma separated include parameter against the relations the endpoint can load
+func parseInclude(r *http.Request, allowed ...string) (map[string]bool, error) {
+	includes := make(map[string]bool)
+	raw := r.URL.Query().Get("include")
+	if raw == "" {
+		return includes, nil
+	}
+
+	valid := make(map[string]bool, len(allowed))
+	for _, name := range allowed {
+		valid[name] = true
+	}
+
+	for _, part := range strings.Split(raw, ",") {
+		name := strings.TrimSpace(part)
+		if name == "" {
+			continue
+		}
+		if !valid[name] {
+			return nil, fmt.Errorf("unknown include: %s (allowed: %s)", name, strings.Join(allowed, ", "))
+		}
+		includes[name] = true
+	}
+
+	return includes, nil
+}
+
+// listReservedParams are the query parameters every list endpoint handles itself
+var listReservedParams = []string{"page", "page_size", "cursor", "sort", "q"}
+
+var rangeSuffixes = map[string]models.FilterOp{
+	"_gte": models.FilterGte,
+	"_lte": models.FilterLte,
+	"_gt":  models.FilterGt,
+	"_lt":  models.FilterLt,
+}
+
+// parseListQuery reads the filters, sort and search of a list request against the endpoint spec
+// Filters are field=value or field=v1,v2 for any of the values, and field_gte=, _lte=, _gt=, _lt= on ranged fields
+// sort is a comma separated list of fields, descending when prefixed with -; q searches the text columns
+// Parameters in extra are handled by the endpoint and skipped
+func parseListQuery(r *http.Request, spec models.ListSpec, extra ...string) (models.ListQuery, error) {
+	var q models.ListQuery
+	values := r.URL.Query()
+
+	skip := make(map[string]bool)
+	for _, name := range append(listReservedParams, extra...) {
+		skip[name] = true
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if skip[key] {
+			continue
+		}
+
+		field, op, ok := lookupListField(spec, key)
+		if !ok {
+			return models.ListQuery{}, fmt.Errorf("unknown filter field: %s (allowed: %s)", key, strings.Join(filterParamNames(spec, extra), ", "))
+		}
+
+		raw := values.Get(key)
+		parts := []string{raw}
+		if op == models.FilterEq {
+			parts = strings.Split(raw, ",")
+			if len(parts) > 1 {
+				op = models.FilterIn
+			}
+		}
+
+		condition := models.FilterCondition{Field: field.Name, Op: op}
+		for _, part := range parts {
+			v, err := parseFilterValue(field.Type, strings.TrimSpace(part))
+			if err != nil {
+				return models.ListQuery{}, fmt.Errorf("invalid value for %s: %v", key, err)
+			}
+			condition.Values = append(condition.Values, v)
+		}
+		q.Filters = append(q.Filters, condition)
+	}
+
+	if sortParam := values.Get("sort"); sortParam != "" {
+		for _, part := range strings.Split(sortParam, ",") {
+			part = strings.TrimSpace(part)
+			desc := strings.HasPrefix(part, "-")
+			name := strings.TrimPrefix(part, "-")
+
+			field, ok := spec.Field(name)
+			if !ok || !field.Sortable {
+				return models.ListQuery{}, fmt.Errorf("unknown sort field: %s (allowed: %s)", name, strings.Join(sortFieldNames(spec), ", "))
+			}
+			q.Sort = append(q.Sort, models.SortField{Field: name, Desc: desc})
+		}
+	}
+
+	if search := strings.TrimSpace(values.Get("q")); search != "" {
+		if !spec.Searchable {
+			return models.ListQuery{}, fmt.Errorf("q is not supported on this endpoint")
+		}
+		q.Search = search
+	}
+
+	if len(q.Sort) > 0 && values.Get("cursor") != "" {
+		return models.ListQuery{}, models.ErrCursorWithSort
+	}
+
+	return q, nil
+}
 
-	switch lower {
-	case "notstarted":
-		return models.StatusNotStarted
-	case "inprogress":
-		return models.StatusInProgress
-	case "completed":
-		return models.StatusCompleted
+// lookupListField resolves a query parameter name to a filterable field and its operator
+func lookupListField(spec models.ListSpec, key string) (models.ListField, models.FilterOp, bool) {
+	if field, ok := spec.Field(key); ok && field.Filterable {
+		return field, models.FilterEq, true
+	}
+
+	for suffix, op := range rangeSuffixes {
+		if !strings.HasSuffix(key, suffix) {
+			continue
+		}
+		if field, ok := spec.Field(strings.TrimSuffix(key, suffix)); ok && field.Filterable && field.Ranged {
+			return field, op, true
+		}
+	}
+
+	return models.ListField{}, "", false
+}
+
+// parseFilterValue rejects empty values, such as the one a trailing comma leaves, for every type
+func parseFilterValue(t models.FilterType, raw string) (interface{}, error) {
+	if raw == "" {
+		return nil, fmt.Errorf("empty value")
+	}
+
+	switch t {
+	case models.FilterUUID:
+		return uuid.Parse(raw)
+	case models.FilterInt:
+		return strconv.Atoi(raw)
+	case models.FilterTime:
+		return parseTime(raw)
+	case models.FilterStatus:
+		return parseStatus(raw)
 	default:
-		status := models.StatusEnum(statusStr)
-		if status == models.StatusNotStarted || status == models.StatusInProgress || status == models.StatusCompleted {
-			return status
+		return raw, nil
+	}
+}
+
+// filterParamNames lists the query parameters accepted by a list endpoint for error messages
+func filterParamNames(spec models.ListSpec, extra []string) []string {
+	names := append([]string{}, listReservedParams...)
+	if !spec.Searchable {
+		names = names[:len(names)-1]
+	}
+	names = append(names, extra...)
+
+	for _, f := range spec.Fields {
+		if !f.Filterable {
+			continue
+		}
+		names = append(names, f.Name)
+		if f.Ranged {
+			names = append(names, f.Name+"_gte", f.Name+"_lte", f.Name+"_gt", f.Name+"_lt")
+		}
+	}
+	return names
+}
+
+func sortFieldNames(spec models.ListSpec) []string {
+	var names []string
+	for _, f := range spec.Fields {
+		if f.Sortable {
+			names = append(names, f.Name, "-"+f.Name)
 		}
-		return models.StatusNotStarted
 	}
+	return names
 }
@@ -30,14 +30,21 @@ type CreateImprovRequest struct {
 
 // GetAll handles GET /improvements
 // @Summary Get all improvements
-// @Description Get all improvements for a specific task
+// @Description Get the improvements of a task, filtered by assignee, labels, points or dates
 // @Tags improvements
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param task_id query string true "Task ID" format(uuid)
+// @Param assignee_id query string false "Comma separated assignee IDs"
+// @Param label query string false "Comma separated label names; improvements with any of them"
+// @Param label_id query string false "Comma separated label IDs; improvements with any of them"
+// @Param points query string false "Points; points_gte, points_lte, points_gt and points_lt are also accepted"
+// @Param created_at_gte query string false "Created at or after; also created_at_lte, updated_at_gte, ..."
+// @Param sort query string false "Comma separated fields, descending with a - prefix, e.g. -points; by number by default" Enums(number, points, created_at, updated_at)
+// @Param q query string false "Search in description"
 // @Success 200 {array} models.Improv "List of improvements"
-// @Failure 400 {string} string "Invalid task_id"
+// @Failure 400 {string} string "Invalid task_id, unknown filter field or invalid value"
 // @Failure 500 {string} string "Failed to retrieve improvements"
 // @Router /improvements [get]
 func (h *ImprovHandlers) GetAll(w http.ResponseWriter, r *http.Request) {
@@ -53,8 +60,14 @@ func (h *ImprovHandlers) GetAll(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	listQuery, err := parseListQuery(r, models.ImprovementListSpec, "task_id")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	ctx := r.Context()
-	improvements, err := h.improvUseCase.GetAll(ctx, taskID)
+	improvements, err := h.improvUseCase.GetAll(ctx, taskID, listQuery)
 	if err != nil {
 		http.Error(w, "Failed to retrieve improvements", http.StatusInternalServerError)
 		return
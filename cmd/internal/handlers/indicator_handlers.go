@@ -79,7 +79,7 @@ type ProductivityRangeRequest struct {
 // SetRangeRequest is used to create or update a productivity range for an indicator type at project level
 type SetRangeRequest struct {
 	ProjectID     uuid.UUID                `json:"project_id"`
-	IndicatorType string                   `json:"indicator_type"` // SpeedPerIteration, ReworkPerIteration, InstabilityIndex
+	IndicatorType string                   `json:"indicator_type"` // SpeedPerIteration, ReworkPerIteration, InstabilityIndex, LeadTime, CycleTime, EstimateAccuracy
 	Range         ProductivityRangeRequest `json:"range"`
 }
 
@@ -214,10 +214,8 @@ func (h *IndicatorHandlers) SetRange(w http.ResponseWriter, r *http.Request) {
 
 	// Validate indicator type
 	indicatorType := models.IndicatorEnum(req.IndicatorType)
-	if indicatorType != models.IndicatorSpeedPerIteration &&
-		indicatorType != models.IndicatorReworkPerIteration &&
-		indicatorType != models.IndicatorInstabilityIndex {
-		http.Error(w, "Invalid indicator_type. Must be SpeedPerIteration, ReworkPerIteration, or InstabilityIndex", http.StatusBadRequest)
+	if !models.IsValidIndicatorType(indicatorType) {
+		http.Error(w, "Invalid indicator_type. Must be one of "+indicatorTypeNames(), http.StatusBadRequest)
 		return
 	}
 
@@ -291,7 +289,7 @@ func (h *IndicatorHandlers) GetRanges(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Security BearerAuth
 // @Param project_id path string true "Project ID" format(uuid)
-// @Param indicator_type path string true "Indicator type (SpeedPerIteration, ReworkPerIteration, InstabilityIndex)"
+// @Param indicator_type path string true "Indicator type (SpeedPerIteration, ReworkPerIteration, InstabilityIndex, LeadTime, CycleTime, EstimateAccuracy)"
 // @Success 200 {object} models.IndicatorRange "Range configuration"
 // @Failure 400 {string} string "Invalid parameters"
 // @Failure 404 {string} string "Range not found"
@@ -308,9 +306,7 @@ func (h *IndicatorHandlers) GetRangeByIndicatorType(w http.ResponseWriter, r *ht
 	}
 
 	indicatorType := models.IndicatorEnum(indicatorTypeStr)
-	if indicatorType != models.IndicatorSpeedPerIteration &&
-		indicatorType != models.IndicatorReworkPerIteration &&
-		indicatorType != models.IndicatorInstabilityIndex {
+	if !models.IsValidIndicatorType(indicatorType) {
 		http.Error(w, "Invalid indicator_type", http.StatusBadRequest)
 		return
 	}
@@ -633,7 +629,7 @@ func (h *IndicatorHandlers) CreateDefaultRanges(w http.ResponseWriter, r *http.R
 // @Produce json
 // @Security BearerAuth
 // @Param project_id path string true "Project ID" format(uuid)
-// @Param indicator_type path string true "Indicator type (SpeedPerIteration, ReworkPerIteration, InstabilityIndex)"
+// @Param indicator_type path string true "Indicator type (SpeedPerIteration, ReworkPerIteration, InstabilityIndex, LeadTime, CycleTime, EstimateAccuracy)"
 // @Success 200 {object} map[string]interface{} "Indicator range ID"
 // @Failure 400 {string} string "Invalid parameters"
 // @Failure 404 {string} string "Indicator range not found"
@@ -650,10 +646,8 @@ func (h *IndicatorHandlers) GetIndicatorRangeIDByProjectIDAndType(w http.Respons
 	}
 
 	indicatorType := models.IndicatorEnum(indicatorTypeStr)
-	if indicatorType != models.IndicatorSpeedPerIteration &&
-		indicatorType != models.IndicatorReworkPerIteration &&
-		indicatorType != models.IndicatorInstabilityIndex {
-		http.Error(w, "Invalid indicator_type. Must be SpeedPerIteration, ReworkPerIteration, or InstabilityIndex", http.StatusBadRequest)
+	if !models.IsValidIndicatorType(indicatorType) {
+		http.Error(w, "Invalid indicator_type. Must be one of "+indicatorTypeNames(), http.StatusBadRequest)
 		return
 	}
 
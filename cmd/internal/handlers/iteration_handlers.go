@@ -2,10 +2,14 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
 	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/cadence"
+	"prodyo-backend/cmd/internal/repositories/iteration"
 	"prodyo-backend/cmd/internal/usecases"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -21,14 +25,50 @@ func NewIterationHandlers(iterationUseCase *usecases.IterationUseCase) *Iteratio
 	}
 }
 
+// CreateIterationRequest has no number; the server gives the next one of the project
 type CreateIterationRequest struct {
 	ProjectID   uuid.UUID `json:"project_id"`
-	Number      int       `json:"number"`
 	Description string    `json:"description"`
 	StartAt     string    `json:"start_at"`
 	EndAt       string    `json:"end_at"`
 }
 
+type CreateNextIterationRequest struct {
+	Description string `json:"description"`
+}
+
+// SetCadenceRequest is the cadence iterations are generated with
+type SetCadenceRequest struct {
+	LengthDays   int          `json:"length_days" example:"10"`                        // Working days of each iteration
+	StartWeekday time.Weekday `json:"start_weekday" swaggertype:"integer" example:"1"` // 0 is Sunday
+	GapDays      int          `json:"gap_days" example:"0"`                            // Days left between two iterations
+	Ahead        int          `json:"ahead" example:"2"`                               // Upcoming iterations kept created, 0 for none
+}
+
+type GenerateIterationsRequest struct {
+	Count int `json:"count" example:"3"`
+}
+
+type ForecastRequest struct {
+	Mode         string   `json:"mode"` // points (default) or tasks
+	BacklogSize  *float64 `json:"backlog_size,omitempty"`
+	TargetDate   *string  `json:"target_date,omitempty"`
+	SampleWindow int      `json:"sample_window"` // last N closed iterations, 0 for all
+	Seed         *int64   `json:"seed,omitempty"`
+	Simulations  int      `json:"simulations"` // default 5000, at most 10000
+}
+
+// SetAvailabilityRequest replaces the availability of the iteration members
+type SetAvailabilityRequest struct {
+	Members []MemberAvailabilityRequest `json:"members"`
+}
+
+type MemberAvailabilityRequest struct {
+	UserID      uuid.UUID `json:"user_id"`
+	HoursPerDay float64   `json:"hours_per_day"`
+	DaysOff     []string  `json:"days_off"` // Dates within the iteration, e.g. 2024-05-13
+}
+
 // GetAll handles GET /iterations
 // @Summary Get all iterations
 // @Description Get all iterations for a specific project
@@ -100,14 +140,17 @@ func (h *IterationHandlers) GetByID(w http.ResponseWriter, r *http.Request) {
 
 // Create handles POST /iterations
 // @Summary Create a new iteration
-// @Description Create a new iteration for a project
+// @Description Create a new iteration for a project, numbered after the last one. Iterations must end after they start and may not overlap; errors come as JSON with a code (invalid_date_range, overlapping_iteration) and the conflicting iterations
 // @Tags iterations
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param iteration body CreateIterationRequest true "Iteration data"
-// @Success 201 {object} map[string]interface{} "Iteration created successfully"
+// @Success 201 {object} models.Iteration "Iteration created successfully"
 // @Failure 400 {string} string "Invalid request body"
+// @Failure 404 {string} string "Project not found"
+// @Failure 409 {object} models.IterationError "Overlapping iterations"
+// @Failure 422 {object} models.IterationError "Invalid date range"
 // @Failure 500 {string} string "Failed to create iteration"
 // @Router /iterations [post]
 func (h *IterationHandlers) Create(w http.ResponseWriter, r *http.Request) {
@@ -131,31 +174,110 @@ func (h *IterationHandlers) Create(w http.ResponseWriter, r *http.Request) {
 
 	newIteration := models.Iteration{
 		ProjectID:   req.ProjectID,
-		Number:      req.Number,
 		Description: req.Description,
 		StartAt:     startAt,
 		EndAt:       endAt,
 	}
 
 	ctx := r.Context()
-	iterationID, err := h.iterationUseCase.Create(ctx, newIteration)
+	created, err := h.iterationUseCase.Create(ctx, newIteration)
 	if err != nil {
-		http.Error(w, "Failed to create iteration", http.StatusInternalServerError)
+		writeIterationError(w, err, "Failed to create iteration")
 		return
 	}
 
-	response := map[string]interface{}{
-		"id":          iterationID,
-		"project_id":  req.ProjectID,
-		"number":      req.Number,
-		"description": req.Description,
-		"start_at":    req.StartAt,
-		"end_at":      req.EndAt,
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// CreateNext handles POST /projects/{id}/iterations/next
+// @Summary Create the next iteration
+// @Description Create an iteration after the latest one of the project with the same cadence: same weekday and time of day, first week after the latest one ends, same length in days of the project timezone
+// @Tags iterations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param iteration body CreateNextIterationRequest false "Iteration description"
+// @Success 201 {object} models.Iteration "Iteration created successfully"
+// @Failure 400 {string} string "Invalid project ID or request body"
+// @Failure 409 {object} models.IterationError "Overlapping iterations"
+// @Failure 422 {object} models.IterationError "Project has no iteration yet"
+// @Failure 500 {string} string "Failed to create iteration"
+// @Router /projects/{id}/iterations/next [post]
+func (h *IterationHandlers) CreateNext(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	var req CreateNextIterationRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+	}
+
+	ctx := r.Context()
+	created, err := h.iterationUseCase.CreateNext(ctx, projectID, req.Description)
+	if err != nil {
+		writeIterationError(w, err, "Failed to create iteration")
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(created)
+}
+
+// GetDuplicateNumbers handles GET /iterations/duplicate-numbers
+// @Summary Report duplicate iteration numbers
+// @Description List the numbers held by more than one iteration of the same project, with those iterations; every project the caller owns or maintains unless project_id is given
+// @Tags iterations
+// @Produce json
+// @Security BearerAuth
+// @Param project_id query string false "Project ID" format(uuid)
+// @Success 200 {array} models.DuplicateIterationNumber "Duplicate numbers"
+// @Failure 400 {string} string "Invalid project_id"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project owners and maintainers can report duplicate numbers"
+// @Failure 500 {string} string "Failed to detect duplicate numbers"
+// @Router /iterations/duplicate-numbers [get]
+func (h *IterationHandlers) GetDuplicateNumbers(w http.ResponseWriter, r *http.Request) {
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var projectID *uuid.UUID
+	if raw := r.URL.Query().Get("project_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			http.Error(w, "Invalid project_id", http.StatusBadRequest)
+			return
+		}
+		projectID = &id
+	}
+
+	ctx := r.Context()
+	duplicates, err := h.iterationUseCase.GetDuplicateNumbers(ctx, projectID, requester.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrNotProjectMember), errors.Is(err, usecases.ErrInsufficientRole):
+			http.Error(w, err.Error(), http.StatusForbidden)
+		default:
+			http.Error(w, "Failed to detect duplicate numbers", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(duplicates)
 }
 
 // Delete handles DELETE /iterations/{id}
@@ -198,8 +320,12 @@ func (h *IterationHandlers) Delete(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Iteration ID" format(uuid)
+// @Param group_by query string false "Set to assignee for a per-member breakdown (owners and maintainers only) or to label for a per-label breakdown"
 // @Success 200 {object} models.IterationAnalysisResponse "Iteration analysis with indicator data points"
-// @Failure 400 {string} string "Invalid iteration ID"
+// @Success 200 {object} models.MemberAnalysisResponse "Per-member analysis when group_by=assignee"
+// @Success 200 {object} models.LabelAnalysisResponse "Per-label analysis when group_by=label"
+// @Failure 400 {string} string "Invalid iteration ID or group_by"
+// @Failure 403 {string} string "Only project owners and maintainers can see the per-member analysis"
 // @Failure 404 {string} string "Iteration not found"
 // @Failure 500 {string} string "Failed to retrieve analysis"
 // @Router /iterations/{id}/analysis [get]
@@ -213,6 +339,19 @@ func (h *IterationHandlers) GetIterationAnalysis(w http.ResponseWriter, r *http.
 		return
 	}
 
+	switch groupBy := r.URL.Query().Get("group_by"); groupBy {
+	case "":
+	case "assignee":
+		h.getMemberAnalysis(w, r, id)
+		return
+	case "label":
+		h.getLabelAnalysis(w, r, id)
+		return
+	default:
+		http.Error(w, "Invalid group_by. Must be assignee or label", http.StatusBadRequest)
+		return
+	}
+
 	ctx := r.Context()
 	analysis, err := h.iterationUseCase.GetIterationAnalysis(ctx, id)
 	if err != nil {
@@ -227,3 +366,641 @@ func (h *IterationHandlers) GetIterationAnalysis(w http.ResponseWriter, r *http.
 		return
 	}
 }
+
+func (h *IterationHandlers) getMemberAnalysis(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	analysis, err := h.iterationUseCase.GetMemberAnalysis(ctx, id, user.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, iteration.ErrNotFound):
+			http.Error(w, "Iteration not found", http.StatusNotFound)
+		case errors.Is(err, usecases.ErrNotProjectMember), errors.Is(err, usecases.ErrInsufficientRole):
+			http.Error(w, "Only project owners and maintainers can see the per-member analysis", http.StatusForbidden)
+		default:
+			http.Error(w, "Failed to retrieve analysis", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(analysis); err != nil {
+		log.Printf("Failed to encode member analysis response: %v", err)
+		return
+	}
+}
+
+func (h *IterationHandlers) getLabelAnalysis(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	ctx := r.Context()
+	analysis, err := h.iterationUseCase.GetLabelAnalysis(ctx, id)
+	if err != nil {
+		if errors.Is(err, iteration.ErrNotFound) {
+			http.Error(w, "Iteration not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to retrieve analysis", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(analysis); err != nil {
+		log.Printf("Failed to encode label analysis response: %v", err)
+		return
+	}
+}
+
+// GetBurndown handles GET /iterations/{id}/burndown
+// @Summary Get iteration burndown and burnup
+// @Description Get the daily remaining points and hours, the ideal line and the burnup series with scope changes
+// @Tags iterations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Iteration ID" format(uuid)
+// @Success 200 {object} models.BurndownResponse "Iteration burndown and burnup series"
+// @Failure 400 {string} string "Invalid iteration ID"
+// @Failure 404 {string} string "Iteration not found"
+// @Failure 500 {string} string "Failed to retrieve burndown"
+// @Router /iterations/{id}/burndown [get]
+func (h *IterationHandlers) GetBurndown(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		http.Error(w, "Invalid iteration ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	burndown, err := h.iterationUseCase.GetBurndown(ctx, id)
+	if err != nil {
+		if errors.Is(err, iteration.ErrNotFound) {
+			http.Error(w, "Iteration not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to retrieve burndown", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(burndown); err != nil {
+		log.Printf("Failed to encode burndown response: %v", err)
+		return
+	}
+}
+
+// GetAvailability handles GET /iterations/{id}/availability
+// @Summary Get member availability
+// @Description Get the hours per day and days off recorded for the members of an iteration
+// @Tags iterations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Iteration ID" format(uuid)
+// @Success 200 {array} models.MemberAvailability "Availability per member"
+// @Failure 400 {string} string "Invalid iteration ID"
+// @Failure 404 {string} string "Iteration not found"
+// @Failure 500 {string} string "Failed to retrieve availability"
+// @Router /iterations/{id}/availability [get]
+func (h *IterationHandlers) GetAvailability(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid iteration ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	availability, err := h.iterationUseCase.GetAvailability(ctx, id)
+	if err != nil {
+		if errors.Is(err, iteration.ErrNotFound) {
+			http.Error(w, "Iteration not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to retrieve availability", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(availability)
+}
+
+// SetAvailability handles PUT /iterations/{id}/availability
+// @Summary Replace member availability
+// @Description Replace the hours per day and days off of the iteration members; members left out have no capacity
+// @Tags iterations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Iteration ID" format(uuid)
+// @Param availability body SetAvailabilityRequest true "Availability per member"
+// @Success 200 {array} models.MemberAvailability "Saved availability"
+// @Failure 400 {string} string "Invalid iteration ID, request body or date"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project owners and maintainers can change availability"
+// @Failure 404 {string} string "Iteration not found"
+// @Failure 422 {string} string "Member listed twice or outside the project, hours out of range or day off outside the iteration"
+// @Failure 500 {string} string "Failed to update availability"
+// @Router /iterations/{id}/availability [put]
+func (h *IterationHandlers) SetAvailability(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid iteration ID", http.StatusBadRequest)
+		return
+	}
+
+	var req SetAvailabilityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	list := make([]models.MemberAvailability, 0, len(req.Members))
+	for _, m := range req.Members {
+		a := models.MemberAvailability{
+			Member:      models.Member{ID: m.UserID},
+			HoursPerDay: m.HoursPerDay,
+			DaysOff:     make([]time.Time, 0, len(m.DaysOff)),
+		}
+		for _, d := range m.DaysOff {
+			day, err := time.Parse("2006-01-02", d)
+			if err != nil {
+				http.Error(w, "Invalid days_off date: "+d, http.StatusBadRequest)
+				return
+			}
+			a.DaysOff = append(a.DaysOff, day)
+		}
+		list = append(list, a)
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.iterationUseCase.SetAvailability(ctx, id, list, requester.ID); err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrNotProjectMember), errors.Is(err, usecases.ErrInsufficientRole):
+			http.Error(w, err.Error(), http.StatusForbidden)
+		case errors.Is(err, iteration.ErrNotFound):
+			http.Error(w, "Iteration not found", http.StatusNotFound)
+		case errors.Is(err, usecases.ErrInvalidAvailability):
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		default:
+			http.Error(w, "Failed to update availability", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	availability, err := h.iterationUseCase.GetAvailability(ctx, id)
+	if err != nil {
+		http.Error(w, "Failed to retrieve availability", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(availability)
+}
+
+// GetCapacity handles GET /iterations/{id}/capacity
+// @Summary Get iteration capacity
+// @Description Compare the expected time and points of the planned tasks with the capacity of each member and of the team. Capacity counts the weekdays of the iteration minus days off; hours become points at the actual speed of the last 3 iterations closed before this one. Overcommitted members and teams are listed in warnings
+// @Tags iterations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Iteration ID" format(uuid)
+// @Success 200 {object} models.IterationCapacity "Capacity against planned work"
+// @Failure 400 {string} string "Invalid iteration ID"
+// @Failure 404 {string} string "Iteration not found"
+// @Failure 500 {string} string "Failed to retrieve capacity"
+// @Router /iterations/{id}/capacity [get]
+func (h *IterationHandlers) GetCapacity(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid iteration ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	capacity, err := h.iterationUseCase.GetCapacity(ctx, id)
+	if err != nil {
+		if errors.Is(err, iteration.ErrNotFound) {
+			http.Error(w, "Iteration not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to retrieve capacity", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(capacity); err != nil {
+		log.Printf("Failed to encode capacity response: %v", err)
+		return
+	}
+}
+
+// GetCumulativeFlow handles GET /iterations/{id}/cfd
+// @Summary Get iteration cumulative flow diagram
+// @Description Get the number of tasks and points in each status for every day of the iteration
+// @Tags iterations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Iteration ID" format(uuid)
+// @Success 200 {object} models.CumulativeFlowResponse "Cumulative flow series per status"
+// @Failure 400 {string} string "Invalid iteration ID"
+// @Failure 404 {string} string "Iteration not found"
+// @Failure 500 {string} string "Failed to retrieve cumulative flow"
+// @Router /iterations/{id}/cfd [get]
+func (h *IterationHandlers) GetCumulativeFlow(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		http.Error(w, "Invalid iteration ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	cfd, err := h.iterationUseCase.GetCumulativeFlow(ctx, id)
+	if err != nil {
+		if errors.Is(err, iteration.ErrNotFound) {
+			http.Error(w, "Iteration not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to retrieve cumulative flow", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(cfd); err != nil {
+		log.Printf("Failed to encode cumulative flow response: %v", err)
+		return
+	}
+}
+
+// GetThroughput handles GET /projects/{id}/throughput
+// @Summary Get project throughput
+// @Description Get the number of tasks and points completed per day or week across the iterations of a project
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param interval query string false "Bucket size: day (default) or week"
+// @Success 200 {object} models.ThroughputResponse "Throughput series"
+// @Failure 400 {string} string "Invalid project ID or interval"
+// @Failure 500 {string} string "Failed to retrieve throughput"
+// @Router /projects/{id}/throughput [get]
+func (h *IterationHandlers) GetThroughput(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	projectID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	interval := models.ThroughputDaily
+	if intervalStr := r.URL.Query().Get("interval"); intervalStr != "" {
+		interval = models.ThroughputInterval(intervalStr)
+		if interval != models.ThroughputDaily && interval != models.ThroughputWeekly {
+			http.Error(w, "Invalid interval. Must be day or week", http.StatusBadRequest)
+			return
+		}
+	}
+
+	ctx := r.Context()
+	throughput, err := h.iterationUseCase.GetThroughput(ctx, projectID, interval)
+	if err != nil {
+		http.Error(w, "Failed to retrieve throughput", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(throughput); err != nil {
+		log.Printf("Failed to encode throughput response: %v", err)
+		return
+	}
+}
+
+// GetEstimateAccuracyTrend handles GET /projects/{id}/estimate-accuracy
+// @Summary Get estimate accuracy trend
+// @Description Get the estimate error (MAPE and bias) of each iteration of a project, ordered by iteration number
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Success 200 {object} models.EstimateAccuracyTrend "Estimate accuracy per iteration"
+// @Failure 400 {string} string "Invalid project ID"
+// @Failure 500 {string} string "Failed to retrieve estimate accuracy"
+// @Router /projects/{id}/estimate-accuracy [get]
+func (h *IterationHandlers) GetEstimateAccuracyTrend(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	projectID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	trend, err := h.iterationUseCase.GetEstimateAccuracyTrend(ctx, projectID)
+	if err != nil {
+		http.Error(w, "Failed to retrieve estimate accuracy", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(trend); err != nil {
+		log.Printf("Failed to encode estimate accuracy response: %v", err)
+		return
+	}
+}
+
+// Forecast handles POST /projects/{id}/forecast
+// @Summary Forecast delivery with Monte Carlo simulation
+// @Description Simulate when a backlog will be done, or how much will be done by a target date, by sampling the delivery of closed iterations. Results are given at 50, 85 and 95% confidence; send the returned seed to reproduce them. Up to 10000 simulations of at most 200 iterations each are run
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param forecast body ForecastRequest true "Forecast parameters"
+// @Success 200 {object} models.ForecastResponse "Forecast"
+// @Failure 400 {string} string "Invalid project ID or request body"
+// @Failure 422 {string} string "Invalid forecast parameters or no closed iterations"
+// @Failure 500 {string} string "Failed to run forecast"
+// @Router /projects/{id}/forecast [post]
+func (h *IterationHandlers) Forecast(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	projectID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	var req ForecastRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	forecastReq := models.ForecastRequest{
+		Mode:         models.ForecastMode(req.Mode),
+		BacklogSize:  req.BacklogSize,
+		SampleWindow: req.SampleWindow,
+		Seed:         req.Seed,
+		Simulations:  req.Simulations,
+	}
+	if req.TargetDate != nil {
+		targetDate, err := parseTime(*req.TargetDate)
+		if err != nil {
+			http.Error(w, "Invalid target_date format", http.StatusBadRequest)
+			return
+		}
+		forecastReq.TargetDate = &targetDate
+	}
+
+	ctx := r.Context()
+	forecast, err := h.iterationUseCase.Forecast(ctx, projectID, forecastReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidForecastMode),
+			errors.Is(err, usecases.ErrInvalidForecastTarget),
+			errors.Is(err, usecases.ErrInvalidForecastRun),
+			errors.Is(err, usecases.ErrNoForecastHistory):
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		default:
+			http.Error(w, "Failed to run forecast", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(forecast); err != nil {
+		log.Printf("Failed to encode forecast response: %v", err)
+		return
+	}
+}
+
+// GetCadence handles GET /projects/{id}/iteration-cadence
+// @Summary Get iteration cadence
+// @Description Get the cadence the iterations of a project are generated with
+// @Tags iterations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Success 200 {object} models.IterationCadence "Iteration cadence"
+// @Failure 400 {string} string "Invalid project ID"
+// @Failure 404 {string} string "Project has no iteration cadence"
+// @Failure 500 {string} string "Failed to retrieve iteration cadence"
+// @Router /projects/{id}/iteration-cadence [get]
+func (h *IterationHandlers) GetCadence(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	c, err := h.iterationUseCase.GetCadence(ctx, projectID)
+	if err != nil {
+		writeIterationError(w, err, "Failed to retrieve iteration cadence")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(c)
+}
+
+// SetCadence handles PUT /projects/{id}/iteration-cadence
+// @Summary Set iteration cadence
+// @Description Set the cadence of a project: iterations start on start_weekday, gap_days after the previous one ends, and last length_days working days of the project calendar, so holidays push their end. With ahead above 0 the upcoming iterations missing are created now and kept created by a background job; the cadence is saved even when they cannot all be created, and generation_error tells why
+// @Tags iterations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param cadence body SetCadenceRequest true "Iteration cadence"
+// @Success 200 {object} models.CadenceUpdate "Iteration cadence with the iterations generated"
+// @Failure 400 {string} string "Invalid project ID or request body"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project owners and maintainers can change the iteration cadence"
+// @Failure 404 {string} string "Project not found"
+// @Failure 422 {string} string "Invalid cadence"
+// @Failure 500 {string} string "Failed to update iteration cadence"
+// @Router /projects/{id}/iteration-cadence [put]
+func (h *IterationHandlers) SetCadence(w http.ResponseWriter, r *http.Request) {
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	var req SetCadenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	update, err := h.iterationUseCase.SetCadence(ctx, models.IterationCadence{
+		ProjectID:    projectID,
+		LengthDays:   req.LengthDays,
+		StartWeekday: req.StartWeekday,
+		GapDays:      req.GapDays,
+		Ahead:        req.Ahead,
+	}, requester.ID)
+	if err != nil {
+		writeIterationError(w, err, "Failed to update iteration cadence")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(update)
+}
+
+// DeleteCadence handles DELETE /projects/{id}/iteration-cadence
+// @Summary Delete iteration cadence
+// @Description Stop generating iterations for a project; iterations already created stay
+// @Tags iterations
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Success 204 "Iteration cadence deleted"
+// @Failure 400 {string} string "Invalid project ID"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project owners and maintainers can change the iteration cadence"
+// @Failure 404 {string} string "Project has no iteration cadence"
+// @Failure 500 {string} string "Failed to delete iteration cadence"
+// @Router /projects/{id}/iteration-cadence [delete]
+func (h *IterationHandlers) DeleteCadence(w http.ResponseWriter, r *http.Request) {
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.iterationUseCase.DeleteCadence(ctx, projectID, requester.ID); err != nil {
+		writeIterationError(w, err, "Failed to delete iteration cadence")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GenerateIterations handles POST /projects/{id}/iterations/generate
+// @Summary Generate iterations
+// @Description Create the next count iterations (1 to 26) of the project following its cadence, after its latest iteration and never before tomorrow. Stops at the first iteration that overlaps one added by hand
+// @Tags iterations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param request body GenerateIterationsRequest true "Number of iterations"
+// @Success 201 {array} models.Iteration "Iterations created"
+// @Failure 400 {string} string "Invalid project ID or request body"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project owners and maintainers can generate iterations"
+// @Failure 404 {string} string "Project has no iteration cadence"
+// @Failure 409 {object} models.IterationError "Generated iteration overlaps another one"
+// @Failure 422 {string} string "Invalid count or no working days left"
+// @Failure 500 {string} string "Failed to generate iterations"
+// @Router /projects/{id}/iterations/generate [post]
+func (h *IterationHandlers) GenerateIterations(w http.ResponseWriter, r *http.Request) {
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	var req GenerateIterationsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	created, err := h.iterationUseCase.GenerateIterations(ctx, projectID, req.Count, requester.ID)
+	if err != nil {
+		writeIterationError(w, err, "Failed to generate iterations")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// writeIterationError answers rejected iterations with their structured error as JSON
+func writeIterationError(w http.ResponseWriter, err error, fallback string) {
+	var itErr *models.IterationError
+	switch {
+	case errors.As(err, &itErr):
+		status := http.StatusUnprocessableEntity
+		if itErr.Code == models.IterationOverlap {
+			status = http.StatusConflict
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(itErr)
+	case errors.Is(err, usecases.ErrNotProjectMember), errors.Is(err, usecases.ErrInsufficientRole):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, iteration.ErrProjectNotFound), errors.Is(err, cadence.ErrProjectNotFound):
+		http.Error(w, "Project not found", http.StatusNotFound)
+	case errors.Is(err, cadence.ErrNotFound):
+		http.Error(w, "Project has no iteration cadence", http.StatusNotFound)
+	case errors.Is(err, models.ErrInvalidCadence), errors.Is(err, usecases.ErrInvalidIterationCount), errors.Is(err, usecases.ErrCadenceNoWorkingDays):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,357 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/bug"
+	"prodyo-backend/cmd/internal/repositories/improv"
+	"prodyo-backend/cmd/internal/repositories/label"
+	"prodyo-backend/cmd/internal/repositories/project"
+	"prodyo-backend/cmd/internal/repositories/task"
+	"prodyo-backend/cmd/internal/usecases"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type LabelHandlers struct {
+	labelUseCase *usecases.LabelUseCase
+}
+
+func NewLabelHandlers(labelUseCase *usecases.LabelUseCase) *LabelHandlers {
+	return &LabelHandlers{
+		labelUseCase: labelUseCase,
+	}
+}
+
+type LabelRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type SetLabelsRequest struct {
+	LabelIDs []uuid.UUID `json:"label_ids"`
+}
+
+// GetProjectLabels handles GET /projects/{id}/labels
+// @Summary Get project labels
+// @Description Get the labels defined in a project, sorted by name
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Success 200 {array} models.Label "Project labels"
+// @Failure 400 {string} string "Invalid project ID"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project members can see labels"
+// @Failure 404 {string} string "Project not found"
+// @Failure 500 {string} string "Failed to retrieve labels"
+// @Router /projects/{id}/labels [get]
+func (h *LabelHandlers) GetProjectLabels(w http.ResponseWriter, r *http.Request) {
+	projectID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	labels, err := h.labelUseCase.GetByProjectID(ctx, projectID, requester.ID)
+	if err != nil {
+		writeLabelError(w, err, "Failed to retrieve labels")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(labels)
+}
+
+// CreateLabel handles POST /projects/{id}/labels
+// @Summary Create a label
+// @Description Create a label in a project. Names are unique within the project
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param label body LabelRequest true "Label data"
+// @Success 201 {object} models.Label "Created label"
+// @Failure 400 {string} string "Invalid project ID, request body or name"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project owners and maintainers can manage labels"
+// @Failure 404 {string} string "Project not found"
+// @Failure 409 {string} string "A label with this name already exists in the project"
+// @Failure 500 {string} string "Failed to create label"
+// @Router /projects/{id}/labels [post]
+func (h *LabelHandlers) CreateLabel(w http.ResponseWriter, r *http.Request) {
+	projectID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	var req LabelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	created, err := h.labelUseCase.Create(ctx, models.Label{
+		ProjectID: projectID,
+		Name:      req.Name,
+		Color:     req.Color,
+	}, requester.ID)
+	if err != nil {
+		writeLabelError(w, err, "Failed to create label")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// UpdateLabel handles PUT /projects/{id}/labels/{labelId}
+// @Summary Update a label
+// @Description Rename or recolor a label. Tasks, bugs and improvements reference the label, so the change shows everywhere
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param labelId path string true "Label ID" format(uuid)
+// @Param label body LabelRequest true "Label data"
+// @Success 200 {object} models.Label "Updated label"
+// @Failure 400 {string} string "Invalid ID, request body or name"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project owners and maintainers can manage labels"
+// @Failure 404 {string} string "Label not found"
+// @Failure 409 {string} string "A label with this name already exists in the project"
+// @Failure 500 {string} string "Failed to update label"
+// @Router /projects/{id}/labels/{labelId} [put]
+func (h *LabelHandlers) UpdateLabel(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	labelID, err := uuid.Parse(vars["labelId"])
+	if err != nil {
+		http.Error(w, "Invalid label ID", http.StatusBadRequest)
+		return
+	}
+
+	var req LabelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	updated, err := h.labelUseCase.Update(ctx, models.Label{
+		ID:        labelID,
+		ProjectID: projectID,
+		Name:      req.Name,
+		Color:     req.Color,
+	}, requester.ID)
+	if err != nil {
+		writeLabelError(w, err, "Failed to update label")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(updated)
+}
+
+// DeleteLabel handles DELETE /projects/{id}/labels/{labelId}
+// @Summary Delete a label
+// @Description Delete a label and detach it from every task, bug and improvement
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param labelId path string true "Label ID" format(uuid)
+// @Success 204 "Label deleted"
+// @Failure 400 {string} string "Invalid ID"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project owners and maintainers can manage labels"
+// @Failure 404 {string} string "Label not found"
+// @Failure 500 {string} string "Failed to delete label"
+// @Router /projects/{id}/labels/{labelId} [delete]
+func (h *LabelHandlers) DeleteLabel(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	labelID, err := uuid.Parse(vars["labelId"])
+	if err != nil {
+		http.Error(w, "Invalid label ID", http.StatusBadRequest)
+		return
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.labelUseCase.Delete(ctx, projectID, labelID, requester.ID); err != nil {
+		writeLabelError(w, err, "Failed to delete label")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetTaskLabels handles PUT /tasks/{id}/labels
+// @Summary Set task labels
+// @Description Replace the labels of a task. Labels must belong to the task's project; an empty list removes all labels
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Task ID" format(uuid)
+// @Param labels body SetLabelsRequest true "Label IDs"
+// @Success 204 "Labels updated"
+// @Failure 400 {string} string "Invalid task ID or request body"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project members can label items"
+// @Failure 404 {string} string "Task not found"
+// @Failure 422 {string} string "Label does not belong to the project of the item"
+// @Failure 500 {string} string "Failed to update labels"
+// @Router /tasks/{id}/labels [put]
+func (h *LabelHandlers) SetTaskLabels(w http.ResponseWriter, r *http.Request) {
+	h.setLabels(w, r, "Invalid task ID", h.labelUseCase.SetTaskLabels)
+}
+
+// SetBugLabels handles PUT /bugs/{id}/labels
+// @Summary Set bug labels
+// @Description Replace the labels of a bug. Labels must belong to the bug's project; an empty list removes all labels
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Bug ID" format(uuid)
+// @Param labels body SetLabelsRequest true "Label IDs"
+// @Success 204 "Labels updated"
+// @Failure 400 {string} string "Invalid bug ID or request body"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project members can label items"
+// @Failure 404 {string} string "Bug not found"
+// @Failure 422 {string} string "Label does not belong to the project of the item"
+// @Failure 500 {string} string "Failed to update labels"
+// @Router /bugs/{id}/labels [put]
+func (h *LabelHandlers) SetBugLabels(w http.ResponseWriter, r *http.Request) {
+	h.setLabels(w, r, "Invalid bug ID", h.labelUseCase.SetBugLabels)
+}
+
+// SetImprovementLabels handles PUT /improvements/{id}/labels
+// @Summary Set improvement labels
+// @Description Replace the labels of an improvement. Labels must belong to the improvement's project; an empty list removes all labels
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Improvement ID" format(uuid)
+// @Param labels body SetLabelsRequest true "Label IDs"
+// @Success 204 "Labels updated"
+// @Failure 400 {string} string "Invalid improvement ID or request body"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project members can label items"
+// @Failure 404 {string} string "Improvement not found"
+// @Failure 422 {string} string "Label does not belong to the project of the item"
+// @Failure 500 {string} string "Failed to update labels"
+// @Router /improvements/{id}/labels [put]
+func (h *LabelHandlers) SetImprovementLabels(w http.ResponseWriter, r *http.Request) {
+	h.setLabels(w, r, "Invalid improvement ID", h.labelUseCase.SetImprovementLabels)
+}
+
+func (h *LabelHandlers) setLabels(
+	w http.ResponseWriter,
+	r *http.Request,
+	invalidIDMessage string,
+	set func(ctx context.Context, itemID uuid.UUID, labelIDs []uuid.UUID, requesterID uuid.UUID) error,
+) {
+	itemID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, invalidIDMessage, http.StatusBadRequest)
+		return
+	}
+
+	var req SetLabelsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	if err := set(ctx, itemID, req.LabelIDs, requester.ID); err != nil {
+		writeLabelError(w, err, "Failed to update labels")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeLabelError maps label errors to HTTP status codes
+func writeLabelError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, usecases.ErrNotProjectMember), errors.Is(err, usecases.ErrInsufficientRole):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, usecases.ErrInvalidLabelName):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, label.ErrDuplicateName):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, label.ErrLabelNotInProject):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	case errors.Is(err, project.ErrNotFound):
+		http.Error(w, "Project not found", http.StatusNotFound)
+	case errors.Is(err, label.ErrNotFound):
+		http.Error(w, "Label not found", http.StatusNotFound)
+	case errors.Is(err, task.ErrNotFound):
+		http.Error(w, "Task not found", http.StatusNotFound)
+	case errors.Is(err, bug.ErrNotFound):
+		http.Error(w, "Bug not found", http.StatusNotFound)
+	case errors.Is(err, improv.ErrNotFound):
+		http.Error(w, "Improvement not found", http.StatusNotFound)
+	default:
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/notification"
+	"prodyo-backend/cmd/internal/usecases"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type NotificationHandlers struct {
+	notificationUseCase *usecases.NotificationUseCase
+}
+
+func NewNotificationHandlers(notificationUseCase *usecases.NotificationUseCase) *NotificationHandlers {
+	return &NotificationHandlers{
+		notificationUseCase: notificationUseCase,
+	}
+}
+
+// GetNotifications handles GET /notifications
+// @Summary Get my notifications
+// @Description Get the notifications of the authenticated user, newest first, with the number still unread
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param unread query bool false "Only unread notifications"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Param cursor query string false "Cursor from the next_cursor of the previous page of the same list"
+// @Success 200 {object} map[string]interface{} "Notifications with pagination and unread count"
+// @Failure 400 {string} string "Invalid cursor"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Failed to retrieve notifications"
+// @Router /notifications [get]
+func (h *NotificationHandlers) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	pagination, err := parsePagination(r)
+	if err != nil {
+		http.Error(w, "Invalid cursor", http.StatusBadRequest)
+		return
+	}
+
+	unreadOnly := r.URL.Query().Get("unread") == "true"
+
+	ctx := r.Context()
+	notifications, paginationResp, unread, err := h.notificationUseCase.GetByUserID(ctx, user.ID, unreadOnly, pagination)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidCursor) {
+			http.Error(w, "Invalid cursor", http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Failed to retrieve notifications", http.StatusInternalServerError)
+		return
+	}
+
+	response := map[string]interface{}{
+		"data":       notifications,
+		"pagination": paginationResp,
+		"unread":     unread,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// MarkNotificationRead handles POST /notifications/{id}/read
+// @Summary Mark a notification as read
+// @Description Mark one notification of the authenticated user as read
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Notification ID" format(uuid)
+// @Success 204 "Notification marked as read"
+// @Failure 400 {string} string "Invalid notification ID"
+// @Failure 404 {string} string "Notification not found"
+// @Failure 500 {string} string "Failed to update notification"
+// @Router /notifications/{id}/read [post]
+func (h *NotificationHandlers) MarkNotificationRead(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid notification ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.notificationUseCase.MarkRead(ctx, user.ID, id); err != nil {
+		if errors.Is(err, notification.ErrNotFound) {
+			http.Error(w, "Notification not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to update notification", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// MarkAllNotificationsRead handles POST /notifications/read-all
+// @Summary Mark all notifications as read
+// @Description Mark every unread notification of the authenticated user as read
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Number of notifications marked as read"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Failed to update notifications"
+// @Router /notifications/read-all [post]
+func (h *NotificationHandlers) MarkAllNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	updated, err := h.notificationUseCase.MarkAllRead(ctx, user.ID)
+	if err != nil {
+		http.Error(w, "Failed to update notifications", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"updated": updated,
+	})
+}
@@ -2,10 +2,11 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/project"
 	"prodyo-backend/cmd/internal/usecases"
-	"strconv"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -36,6 +37,10 @@ type CreateProjectRequest struct {
 	IndicatorRanges []IndicatorRangeRequest `json:"indicator_ranges"`
 }
 
+type SetMemberRoleRequest struct {
+	Role string `json:"role"` // owner, maintainer or member
+}
+
 type UpdateProjectRequest struct {
 	Name        string   `json:"name" validate:"required"`
 	Description string   `json:"description"`
@@ -52,31 +57,37 @@ type UpdateProjectRequest struct {
 // @Security BearerAuth
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Page size" default(20) maximum(100)
+// @Param cursor query string false "Opaque cursor from next_cursor of the previous page of the same list; takes precedence over page"
+// @Param name query string false "Exact project name"
+// @Param member_id query string false "Comma separated member IDs"
+// @Param created_at_gte query string false "Created at or after; also created_at_lte, updated_at_gte, ..."
+// @Param sort query string false "Comma separated fields, descending with a - prefix, e.g. -updated_at; cannot be combined with cursor"
+// @Param q query string false "Search in name and description"
 // @Success 200 {object} map[string]interface{} "Projects with pagination"
+// @Failure 400 {string} string "Invalid cursor, unknown filter field or invalid value"
 // @Failure 500 {string} string "Internal server error"
 // @Router /projects [get]
 func (h *ProjectHandlers) GetAllProjects(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	pagination := models.PaginationRequest{
-		Page:     1,
-		PageSize: 20,
-	}
-
-	if page := r.URL.Query().Get("page"); page != "" {
-		if p, err := strconv.Atoi(page); err == nil && p > 0 {
-			pagination.Page = p
-		}
+	pagination, err := parsePagination(r)
+	if err != nil {
+		http.Error(w, "Invalid cursor", http.StatusBadRequest)
+		return
 	}
 
-	if pageSize := r.URL.Query().Get("page_size"); pageSize != "" {
-		if ps, err := strconv.Atoi(pageSize); err == nil && ps > 0 && ps <= 100 {
-			pagination.PageSize = ps
-		}
+	listQuery, err := parseListQuery(r, models.ProjectListSpec)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	projects, paginationResp, err := h.projectUseCase.GetAll(ctx, pagination)
+	projects, paginationResp, err := h.projectUseCase.GetAll(ctx, pagination, listQuery)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidCursor) {
+			http.Error(w, "Invalid cursor", http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to retrieve projects", http.StatusInternalServerError)
 		return
 	}
@@ -172,8 +183,10 @@ func (h *ProjectHandlers) CreateProject(w http.ResponseWriter, r *http.Request)
 		Members:     members,
 	}
 
+	creator, _ := GetUserFromContext(r)
+
 	ctx := r.Context()
-	projectID, err := h.projectUseCase.Add(ctx, newProject)
+	projectID, err := h.projectUseCase.Add(ctx, newProject, creator.ID)
 	if err != nil {
 		http.Error(w, "Failed to create project", http.StatusInternalServerError)
 		return
@@ -325,6 +338,7 @@ func (h *ProjectHandlers) DeleteProject(w http.ResponseWriter, r *http.Request)
 // @Param userId path string true "User ID" format(uuid)
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Page size" default(20) maximum(100)
+// @Param cursor query string false "Opaque cursor from next_cursor of the previous page of the same list; takes precedence over page"
 // @Success 200 {object} map[string]interface{} "Projects with pagination"
 // @Failure 400 {string} string "Invalid user ID"
 // @Failure 500 {string} string "Internal server error"
@@ -341,25 +355,18 @@ func (h *ProjectHandlers) GetProjectsByMemberID(w http.ResponseWriter, r *http.R
 
 	ctx := r.Context()
 
-	pagination := models.PaginationRequest{
-		Page:     1,
-		PageSize: 20,
-	}
-
-	if page := r.URL.Query().Get("page"); page != "" {
-		if p, err := strconv.Atoi(page); err == nil && p > 0 {
-			pagination.Page = p
-		}
-	}
-
-	if pageSize := r.URL.Query().Get("page_size"); pageSize != "" {
-		if ps, err := strconv.Atoi(pageSize); err == nil && ps > 0 && ps <= 100 {
-			pagination.PageSize = ps
-		}
+	pagination, err := parsePagination(r)
+	if err != nil {
+		http.Error(w, "Invalid cursor", http.StatusBadRequest)
+		return
 	}
 
 	projects, paginationResp, iterationCounts, err := h.projectUseCase.GetByMemberID(ctx, userID, pagination)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidCursor) {
+			http.Error(w, "Invalid cursor", http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to retrieve projects", http.StatusInternalServerError)
 		return
 	}
@@ -388,3 +395,68 @@ func (h *ProjectHandlers) GetProjectsByMemberID(w http.ResponseWriter, r *http.R
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(response)
 }
+
+// SetMemberRole handles PUT /projects/{id}/members/{userId}/role
+// @Summary Change the role of a project member
+// @Description Change the role (owner, maintainer or member) of a project member. Only owners can change roles and the last owner cannot be demoted
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param userId path string true "User ID" format(uuid)
+// @Param role body SetMemberRoleRequest true "New role"
+// @Success 204 "Role updated"
+// @Failure 400 {string} string "Invalid ID, request body or role"
+// @Failure 403 {string} string "Only owners can change roles"
+// @Failure 404 {string} string "User is not a member of the project"
+// @Failure 409 {string} string "A project must keep at least one owner"
+// @Failure 500 {string} string "Failed to update role"
+// @Router /projects/{id}/members/{userId}/role [put]
+func (h *ProjectHandlers) SetMemberRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	userID, err := uuid.Parse(vars["userId"])
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		return
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req SetMemberRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	err = h.projectUseCase.SetMemberRole(ctx, projectID, requester.ID, userID, models.ProjectRoleEnum(req.Role))
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidRole):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, usecases.ErrNotProjectMember), errors.Is(err, usecases.ErrInsufficientRole):
+			http.Error(w, "Only owners can change roles", http.StatusForbidden)
+		case errors.Is(err, project.ErrMemberNotFound):
+			http.Error(w, "User is not a member of the project", http.StatusNotFound)
+		case errors.Is(err, usecases.ErrLastOwner):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			http.Error(w, "Failed to update role", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,546 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/indicator_range"
+	"prodyo-backend/cmd/internal/repositories/iteration"
+	"prodyo-backend/cmd/internal/repositories/retrospective"
+	"prodyo-backend/cmd/internal/usecases"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type RetrospectiveHandlers struct {
+	retrospectiveUseCase *usecases.RetrospectiveUseCase
+	iterationUseCase     *usecases.IterationUseCase
+}
+
+func NewRetrospectiveHandlers(retrospectiveUseCase *usecases.RetrospectiveUseCase, iterationUseCase *usecases.IterationUseCase) *RetrospectiveHandlers {
+	return &RetrospectiveHandlers{
+		retrospectiveUseCase: retrospectiveUseCase,
+		iterationUseCase:     iterationUseCase,
+	}
+}
+
+type CreateRetrospectiveRequest struct {
+	Columns        []string `json:"columns,omitempty"`
+	VotesPerMember *int     `json:"votes_per_member,omitempty"`
+}
+
+type CreateRetrospectiveColumnRequest struct {
+	Title string `json:"title"`
+}
+
+type CreateRetrospectiveItemRequest struct {
+	ColumnID    uuid.UUID `json:"column_id"`
+	Description string    `json:"description"`
+}
+
+// PromoteRetrospectiveItemRequest promotes an item into a cause or an action.
+// Description fields default to the item description when empty.
+type PromoteRetrospectiveItemRequest struct {
+	Type              string     `json:"type"` // cause or action
+	IndicatorRangeID  uuid.UUID  `json:"indicator_range_id"`
+	Metric            string     `json:"metric"`
+	ProductivityLevel string     `json:"productivity_level,omitempty"`
+	CauseDescription  string     `json:"cause_description,omitempty"`
+	Description       string     `json:"description,omitempty"`
+	Status            *string    `json:"status,omitempty"`
+	StartAt           *time.Time `json:"start_at,omitempty"`
+	EndAt             *time.Time `json:"end_at,omitempty"`
+	AssigneeID        *uuid.UUID `json:"assignee_id,omitempty"`
+}
+
+// GetByIteration handles GET /iterations/{id}/retrospective
+// @Summary Get iteration retrospective
+// @Description Get the retrospective of an iteration with its columns, items, votes and the iteration analysis
+// @Tags retrospectives
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Iteration ID" format(uuid)
+// @Success 200 {object} models.Retrospective "Retrospective details"
+// @Failure 400 {string} string "Invalid iteration ID"
+// @Failure 404 {string} string "Retrospective not found"
+// @Failure 500 {string} string "Failed to retrieve retrospective"
+// @Router /iterations/{id}/retrospective [get]
+func (h *RetrospectiveHandlers) GetByIteration(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	iterationID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.Error(w, "Invalid iteration ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	retro, err := h.retrospectiveUseCase.GetByIterationID(ctx, iterationID)
+	if err != nil {
+		if errors.Is(err, retrospective.ErrNotFound) {
+			http.Error(w, "Retrospective not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to retrieve retrospective", http.StatusInternalServerError)
+		return
+	}
+
+	analysis, err := h.iterationUseCase.GetIterationAnalysis(ctx, iterationID)
+	if err != nil {
+		log.Printf("Failed to get analysis for retrospective: %v", err)
+		http.Error(w, "Failed to retrieve analysis", http.StatusInternalServerError)
+		return
+	}
+	retro.Analysis = &analysis
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(retro)
+}
+
+// Create handles POST /iterations/{id}/retrospective
+// @Summary Create iteration retrospective
+// @Description Open the retrospective of an iteration. Columns default to "Went well", "To improve" and "Ideas".
+// @Tags retrospectives
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Iteration ID" format(uuid)
+// @Param retrospective body CreateRetrospectiveRequest false "Retrospective configuration"
+// @Success 201 {object} models.Retrospective "Retrospective created successfully"
+// @Failure 400 {string} string "Invalid request body"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "User is not a member of the project"
+// @Failure 404 {string} string "Iteration not found"
+// @Failure 409 {string} string "Retrospective already exists"
+// @Failure 500 {string} string "Failed to create retrospective"
+// @Router /iterations/{id}/retrospective [post]
+func (h *RetrospectiveHandlers) Create(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	iterationID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.Error(w, "Invalid iteration ID", http.StatusBadRequest)
+		return
+	}
+
+	var req CreateRetrospectiveRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+	}
+
+	votesPerMember := 3
+	if req.VotesPerMember != nil {
+		if *req.VotesPerMember < 0 {
+			http.Error(w, "votes_per_member must not be negative", http.StatusBadRequest)
+			return
+		}
+		votesPerMember = *req.VotesPerMember
+	}
+
+	newRetro := models.Retrospective{
+		IterationID:    iterationID,
+		VotesPerMember: votesPerMember,
+	}
+	for _, title := range req.Columns {
+		if title == "" {
+			http.Error(w, "Column title is required", http.StatusBadRequest)
+			return
+		}
+		newRetro.Columns = append(newRetro.Columns, models.RetrospectiveColumn{Title: title})
+	}
+
+	ctx := r.Context()
+	retroID, err := h.retrospectiveUseCase.Create(ctx, newRetro, user.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, iteration.ErrNotFound):
+			http.Error(w, "Iteration not found", http.StatusNotFound)
+		case errors.Is(err, usecases.ErrNotProjectMember):
+			http.Error(w, err.Error(), http.StatusForbidden)
+		case errors.Is(err, usecases.ErrRetrospectiveExists):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			http.Error(w, "Failed to create retrospective", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	created, err := h.retrospectiveUseCase.GetByID(ctx, retroID)
+	if err != nil {
+		http.Error(w, "Failed to retrieve retrospective", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// AddColumn handles POST /retrospectives/{id}/columns
+// @Summary Add retrospective column
+// @Description Append a new column to a retrospective
+// @Tags retrospectives
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Retrospective ID" format(uuid)
+// @Param column body CreateRetrospectiveColumnRequest true "Column data"
+// @Success 201 {object} map[string]interface{} "Column created successfully"
+// @Failure 400 {string} string "Invalid request body"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "User is not a member of the project"
+// @Failure 404 {string} string "Retrospective not found"
+// @Failure 500 {string} string "Failed to create column"
+// @Router /retrospectives/{id}/columns [post]
+func (h *RetrospectiveHandlers) AddColumn(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	retroID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.Error(w, "Invalid retrospective ID", http.StatusBadRequest)
+		return
+	}
+
+	var req CreateRetrospectiveColumnRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.Title == "" {
+		http.Error(w, "Title is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	columnID, err := h.retrospectiveUseCase.AddColumn(ctx, models.RetrospectiveColumn{
+		RetrospectiveID: retroID,
+		Title:           req.Title,
+	}, user.ID)
+	if err != nil {
+		if errors.Is(err, retrospective.ErrNotFound) {
+			http.Error(w, "Retrospective not found", http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, usecases.ErrNotProjectMember) {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		http.Error(w, "Failed to create column", http.StatusInternalServerError)
+		return
+	}
+
+	response := map[string]interface{}{
+		"id":               columnID,
+		"retrospective_id": retroID,
+		"title":            req.Title,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// CreateItem handles POST /retrospectives/items
+// @Summary Add retrospective item
+// @Description Add an item to a retrospective column. The author is the authenticated user, who must be a project member.
+// @Tags retrospectives
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param item body CreateRetrospectiveItemRequest true "Item data"
+// @Success 201 {object} models.RetrospectiveItem "Item created successfully"
+// @Failure 400 {string} string "Invalid request body"
+// @Failure 403 {string} string "User is not a member of the project"
+// @Failure 404 {string} string "Column not found"
+// @Failure 500 {string} string "Failed to create item"
+// @Router /retrospectives/items [post]
+func (h *RetrospectiveHandlers) CreateItem(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req CreateRetrospectiveItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.Description == "" {
+		http.Error(w, "Description is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	itemID, err := h.retrospectiveUseCase.CreateItem(ctx, models.RetrospectiveItem{
+		ColumnID:    req.ColumnID,
+		Author:      models.User{ID: user.ID},
+		Description: req.Description,
+	})
+	if err != nil {
+		writeRetrospectiveError(w, err, "Failed to create item")
+		return
+	}
+
+	item := models.RetrospectiveItem{
+		ID:          itemID,
+		ColumnID:    req.ColumnID,
+		Author:      user,
+		Description: req.Description,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(item)
+}
+
+// DeleteItem handles DELETE /retrospectives/items/{id}
+// @Summary Delete retrospective item
+// @Description Delete an item. Only its author may delete it.
+// @Tags retrospectives
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Item ID" format(uuid)
+// @Success 204 "Item deleted successfully"
+// @Failure 400 {string} string "Invalid item ID"
+// @Failure 403 {string} string "Only the author can change this item"
+// @Failure 404 {string} string "Item not found"
+// @Failure 500 {string} string "Failed to delete item"
+// @Router /retrospectives/items/{id} [delete]
+func (h *RetrospectiveHandlers) DeleteItem(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	itemID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid item ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.retrospectiveUseCase.DeleteItem(ctx, itemID, user.ID); err != nil {
+		writeRetrospectiveError(w, err, "Failed to delete item")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Vote handles POST /retrospectives/items/{id}/votes
+// @Summary Vote for retrospective item
+// @Description Place one dot on an item. Each member has a limited number of dots per retrospective.
+// @Tags retrospectives
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Item ID" format(uuid)
+// @Success 200 {object} models.RetrospectiveItem "Item with updated votes"
+// @Failure 400 {string} string "Invalid item ID"
+// @Failure 403 {string} string "User is not a member of the project"
+// @Failure 404 {string} string "Item not found"
+// @Failure 409 {string} string "No votes left"
+// @Failure 500 {string} string "Failed to vote"
+// @Router /retrospectives/items/{id}/votes [post]
+func (h *RetrospectiveHandlers) Vote(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	itemID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid item ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	item, err := h.retrospectiveUseCase.Vote(ctx, itemID, user.ID)
+	if err != nil {
+		writeRetrospectiveError(w, err, "Failed to vote")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(item)
+}
+
+// Unvote handles DELETE /retrospectives/items/{id}/votes
+// @Summary Remove vote from retrospective item
+// @Description Take back one of the authenticated user's dots from an item
+// @Tags retrospectives
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Item ID" format(uuid)
+// @Success 200 {object} models.RetrospectiveItem "Item with updated votes"
+// @Failure 400 {string} string "Invalid item ID"
+// @Failure 404 {string} string "Vote not found"
+// @Failure 500 {string} string "Failed to remove vote"
+// @Router /retrospectives/items/{id}/votes [delete]
+func (h *RetrospectiveHandlers) Unvote(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	itemID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid item ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	item, err := h.retrospectiveUseCase.Unvote(ctx, itemID, user.ID)
+	if err != nil {
+		writeRetrospectiveError(w, err, "Failed to remove vote")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(item)
+}
+
+// Promote handles POST /retrospectives/items/{id}/promote
+// @Summary Promote retrospective item
+// @Description Promote an item into a cause, or into an action (with its cause), on an indicator range of the iteration project
+// @Tags retrospectives
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Item ID" format(uuid)
+// @Param promotion body PromoteRetrospectiveItemRequest true "Promotion data"
+// @Success 200 {object} models.RetrospectiveItem "Promoted item"
+// @Failure 400 {string} string "Invalid request body"
+// @Failure 403 {string} string "User is not a member of the project"
+// @Failure 404 {string} string "Item or indicator range not found"
+// @Failure 409 {string} string "Item has already been promoted"
+// @Failure 500 {string} string "Failed to promote item"
+// @Router /retrospectives/items/{id}/promote [post]
+func (h *RetrospectiveHandlers) Promote(w http.ResponseWriter, r *http.Request) {
+	user, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	itemID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid item ID", http.StatusBadRequest)
+		return
+	}
+
+	var req PromoteRetrospectiveItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	metric := models.MetricEnum(req.Metric)
+	if metric != models.MetricWorkVelocity &&
+		metric != models.MetricReworkIndex &&
+		metric != models.MetricInstabilityIndex {
+		http.Error(w, "Invalid metric. Must be WorkVelocity, ReworkIndex, or InstabilityIndex", http.StatusBadRequest)
+		return
+	}
+
+	level := models.ProductivityCritical
+	if req.ProductivityLevel != "" {
+		level = models.ProductivityEnum(req.ProductivityLevel)
+		if level != models.ProductivityOk && level != models.ProductivityAlert && level != models.ProductivityCritical {
+			http.Error(w, "Invalid productivity_level. Must be Ok, Alert, or Critical", http.StatusBadRequest)
+			return
+		}
+	}
+
+	newCause := models.Cause{
+		IndicatorRangeID:  req.IndicatorRangeID,
+		Metric:            metric,
+		Description:       req.CauseDescription,
+		ProductivityLevel: level,
+	}
+
+	ctx := r.Context()
+	var item models.RetrospectiveItem
+	switch req.Type {
+	case "cause":
+		item, err = h.retrospectiveUseCase.PromoteToCause(ctx, itemID, user.ID, newCause)
+	case "action":
+		newAction := models.Action{
+			Description: req.Description,
+			Status:      models.StatusNotStarted,
+		}
+		if req.Status != nil {
+			newAction.Status = models.StatusEnum(*req.Status)
+		}
+		if req.StartAt != nil {
+			newAction.StartAt = *req.StartAt
+		}
+		if req.EndAt != nil {
+			newAction.EndAt = *req.EndAt
+		}
+		if req.AssigneeID != nil {
+			newAction.Assignee = models.User{ID: *req.AssigneeID}
+		}
+		item, err = h.retrospectiveUseCase.PromoteToAction(ctx, itemID, user.ID, newCause, newAction)
+	default:
+		http.Error(w, "Invalid type. Must be cause or action", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		writeRetrospectiveError(w, err, "Failed to promote item")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(item)
+}
+
+func writeRetrospectiveError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, usecases.ErrNotProjectMember), errors.Is(err, usecases.ErrNotItemAuthor):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, retrospective.ErrItemNotFound),
+		errors.Is(err, retrospective.ErrColumnNotFound),
+		errors.Is(err, retrospective.ErrVoteNotFound),
+		errors.Is(err, indicator_range.ErrNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, retrospective.ErrNoVotesLeft), errors.Is(err, usecases.ErrItemAlreadyPromoted):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, usecases.ErrIndicatorRangeMismatch):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		log.Println(err)
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
@@ -20,6 +20,14 @@ func SetupRoutes(
 	indicatorRangeUseCase *usecases.IndicatorRangeUseCase,
 	causeUseCase *usecases.CauseUseCase,
 	actionUseCase *usecases.ActionUseCase,
+	retrospectiveUseCase *usecases.RetrospectiveUseCase,
+	dashboardUseCase *usecases.DashboardUseCase,
+	searchUseCase *usecases.SearchUseCase,
+	labelUseCase *usecases.LabelUseCase,
+	commentUseCase *usecases.CommentUseCase,
+	notificationUseCase *usecases.NotificationUseCase,
+	attachmentUseCase *usecases.AttachmentUseCase,
+	calendarUseCase *usecases.CalendarUseCase,
 ) *mux.Router {
 	router := mux.NewRouter()
 
@@ -32,6 +40,14 @@ func SetupRoutes(
 	improvHandlers := NewImprovHandlers(improvUseCase)
 	bugHandlers := NewBugHandlers(bugUseCase)
 	indicatorHandlers := NewIndicatorHandlers(indicatorUseCase, indicatorRangeUseCase, causeUseCase, actionUseCase)
+	retrospectiveHandlers := NewRetrospectiveHandlers(retrospectiveUseCase, iterationUseCase)
+	dashboardHandlers := NewDashboardHandlers(dashboardUseCase)
+	searchHandlers := NewSearchHandlers(searchUseCase)
+	labelHandlers := NewLabelHandlers(labelUseCase)
+	commentHandlers := NewCommentHandlers(commentUseCase)
+	notificationHandlers := NewNotificationHandlers(notificationUseCase)
+	attachmentHandlers := NewAttachmentHandlers(attachmentUseCase)
+	calendarHandlers := NewCalendarHandlers(calendarUseCase)
 
 	api := router.PathPrefix("/api/v1").Subrouter()
 
@@ -54,6 +70,36 @@ func SetupRoutes(
 	protected.HandleFunc("/projects/{id}", projectHandlers.GetProjectByID).Methods("GET")
 	protected.HandleFunc("/projects/{id}", projectHandlers.UpdateProject).Methods("PUT")
 	protected.HandleFunc("/projects/{id}", projectHandlers.DeleteProject).Methods("DELETE")
+	protected.HandleFunc("/projects/{id}/dashboard", dashboardHandlers.GetProjectDashboard).Methods("GET")
+	protected.HandleFunc("/projects/{id}/members/{userId}/role", projectHandlers.SetMemberRole).Methods("PUT")
+	protected.HandleFunc("/projects/{id}/status-transitions", taskHandlers.GetStatusTransitions).Methods("GET")
+	protected.HandleFunc("/projects/{id}/status-transitions", taskHandlers.SetStatusTransitions).Methods("PUT")
+	protected.HandleFunc("/projects/{id}/wip-limits", taskHandlers.GetWIPLimits).Methods("GET")
+	protected.HandleFunc("/projects/{id}/wip-limits", taskHandlers.SetWIPLimits).Methods("PUT")
+	protected.HandleFunc("/projects/{id}/backlog", taskHandlers.GetBacklog).Methods("GET")
+	protected.HandleFunc("/projects/{id}/backlog", taskHandlers.CreateBacklogTask).Methods("POST")
+	protected.HandleFunc("/projects/{id}/iterations/next", iterationHandlers.CreateNext).Methods("POST")
+	protected.HandleFunc("/projects/{id}/iterations/generate", iterationHandlers.GenerateIterations).Methods("POST")
+	protected.HandleFunc("/projects/{id}/iteration-cadence", iterationHandlers.GetCadence).Methods("GET")
+	protected.HandleFunc("/projects/{id}/iteration-cadence", iterationHandlers.SetCadence).Methods("PUT")
+	protected.HandleFunc("/projects/{id}/iteration-cadence", iterationHandlers.DeleteCadence).Methods("DELETE")
+	protected.HandleFunc("/projects/{id}/throughput", iterationHandlers.GetThroughput).Methods("GET")
+	protected.HandleFunc("/projects/{id}/estimate-accuracy", iterationHandlers.GetEstimateAccuracyTrend).Methods("GET")
+	protected.HandleFunc("/projects/{id}/forecast", iterationHandlers.Forecast).Methods("POST")
+	protected.HandleFunc("/projects/{id}/attachment-settings", attachmentHandlers.GetAttachmentSettings).Methods("GET")
+	protected.HandleFunc("/projects/{id}/attachment-settings", attachmentHandlers.UpdateAttachmentSettings).Methods("PUT")
+	protected.HandleFunc("/projects/{id}/calendar", calendarHandlers.GetCalendar).Methods("GET")
+	protected.HandleFunc("/projects/{id}/calendar", calendarHandlers.SetCalendar).Methods("PUT")
+	protected.HandleFunc("/projects/{id}/calendar/holidays/import", calendarHandlers.ImportHolidays).Methods("POST")
+
+	// Label routes
+	protected.HandleFunc("/projects/{id}/labels", labelHandlers.GetProjectLabels).Methods("GET")
+	protected.HandleFunc("/projects/{id}/labels", labelHandlers.CreateLabel).Methods("POST")
+	protected.HandleFunc("/projects/{id}/labels/{labelId}", labelHandlers.UpdateLabel).Methods("PUT")
+	protected.HandleFunc("/projects/{id}/labels/{labelId}", labelHandlers.DeleteLabel).Methods("DELETE")
+	protected.HandleFunc("/tasks/{id}/labels", labelHandlers.SetTaskLabels).Methods("PUT")
+	protected.HandleFunc("/bugs/{id}/labels", labelHandlers.SetBugLabels).Methods("PUT")
+	protected.HandleFunc("/improvements/{id}/labels", labelHandlers.SetImprovementLabels).Methods("PUT")
 
 	// Project indicator ranges routes (project-level)
 	protected.HandleFunc("/projects/{project_id}/indicator-ranges", indicatorHandlers.GetRanges).Methods("GET")
@@ -72,28 +118,63 @@ func SetupRoutes(
 	// Iteration routes
 	protected.HandleFunc("/iterations", iterationHandlers.GetAll).Methods("GET")
 	protected.HandleFunc("/iterations", iterationHandlers.Create).Methods("POST")
+	protected.HandleFunc("/iterations/duplicate-numbers", iterationHandlers.GetDuplicateNumbers).Methods("GET")
 	protected.HandleFunc("/iterations/{id}", iterationHandlers.GetByID).Methods("GET")
 	protected.HandleFunc("/iterations/{id}", iterationHandlers.Delete).Methods("DELETE")
 	protected.HandleFunc("/iterations/{id}/analysis", iterationHandlers.GetIterationAnalysis).Methods("GET")
+	protected.HandleFunc("/iterations/{id}/burndown", iterationHandlers.GetBurndown).Methods("GET")
+	protected.HandleFunc("/iterations/{id}/cfd", iterationHandlers.GetCumulativeFlow).Methods("GET")
+	protected.HandleFunc("/iterations/{id}/availability", iterationHandlers.GetAvailability).Methods("GET")
+	protected.HandleFunc("/iterations/{id}/availability", iterationHandlers.SetAvailability).Methods("PUT")
+	protected.HandleFunc("/iterations/{id}/capacity", iterationHandlers.GetCapacity).Methods("GET")
+	protected.HandleFunc("/iterations/{id}/dependency-graph", taskHandlers.GetDependencyGraph).Methods("GET")
+	protected.HandleFunc("/iterations/{id}/plan", taskHandlers.PlanIteration).Methods("POST")
 	protected.HandleFunc("/iterations/{iteration_id}/causes-actions", indicatorHandlers.GetCausesAndActionsByIteration).Methods("GET")
+	protected.HandleFunc("/iterations/{id}/retrospective", retrospectiveHandlers.GetByIteration).Methods("GET")
+	protected.HandleFunc("/iterations/{id}/retrospective", retrospectiveHandlers.Create).Methods("POST")
+
+	// Retrospective routes
+	protected.HandleFunc("/retrospectives/items", retrospectiveHandlers.CreateItem).Methods("POST")
+	protected.HandleFunc("/retrospectives/items/{id}", retrospectiveHandlers.DeleteItem).Methods("DELETE")
+	protected.HandleFunc("/retrospectives/items/{id}/votes", retrospectiveHandlers.Vote).Methods("POST")
+	protected.HandleFunc("/retrospectives/items/{id}/votes", retrospectiveHandlers.Unvote).Methods("DELETE")
+	protected.HandleFunc("/retrospectives/items/{id}/promote", retrospectiveHandlers.Promote).Methods("POST")
+	protected.HandleFunc("/retrospectives/{id}/columns", retrospectiveHandlers.AddColumn).Methods("POST")
 
 	// Task routes
 	protected.HandleFunc("/tasks", taskHandlers.GetAll).Methods("GET")
 	protected.HandleFunc("/tasks", taskHandlers.Create).Methods("POST")
+	protected.HandleFunc("/tasks/bulk", taskHandlers.Bulk).Methods("POST")
 	protected.HandleFunc("/tasks/{id}", taskHandlers.GetByID).Methods("GET")
 	protected.HandleFunc("/tasks/{id}", taskHandlers.Update).Methods("PUT")
 	protected.HandleFunc("/tasks/{id}", taskHandlers.Patch).Methods("PATCH")
 	protected.HandleFunc("/tasks/{id}", taskHandlers.Delete).Methods("DELETE")
+	protected.HandleFunc("/tasks/{id}/status-history", taskHandlers.GetStatusHistory).Methods("GET")
+	protected.HandleFunc("/tasks/{id}/move", taskHandlers.Move).Methods("POST")
+	protected.HandleFunc("/tasks/{id}/dependencies", taskHandlers.AddDependency).Methods("POST")
+	protected.HandleFunc("/tasks/{id}/dependencies/{otherId}", taskHandlers.RemoveDependency).Methods("DELETE")
+	protected.HandleFunc("/tasks/{id}/comments", commentHandlers.GetTaskComments).Methods("GET")
+	protected.HandleFunc("/tasks/{id}/comments", commentHandlers.CreateTaskComment).Methods("POST")
+	protected.HandleFunc("/tasks/{id}/attachments", attachmentHandlers.GetTaskAttachments).Methods("GET")
+	protected.HandleFunc("/tasks/{id}/attachments", attachmentHandlers.UploadTaskAttachment).Methods("POST")
 
 	// Improvement routes
 	protected.HandleFunc("/improvements", improvHandlers.GetAll).Methods("GET")
 	protected.HandleFunc("/improvements", improvHandlers.Create).Methods("POST")
 	protected.HandleFunc("/improvements/{id}", improvHandlers.GetByID).Methods("GET")
+	protected.HandleFunc("/improvements/{id}/comments", commentHandlers.GetImprovementComments).Methods("GET")
+	protected.HandleFunc("/improvements/{id}/comments", commentHandlers.CreateImprovementComment).Methods("POST")
+	protected.HandleFunc("/improvements/{id}/attachments", attachmentHandlers.GetImprovementAttachments).Methods("GET")
+	protected.HandleFunc("/improvements/{id}/attachments", attachmentHandlers.UploadImprovementAttachment).Methods("POST")
 
 	// Bug routes
 	protected.HandleFunc("/bugs", bugHandlers.GetAll).Methods("GET")
 	protected.HandleFunc("/bugs", bugHandlers.Create).Methods("POST")
 	protected.HandleFunc("/bugs/{id}", bugHandlers.GetByID).Methods("GET")
+	protected.HandleFunc("/bugs/{id}/comments", commentHandlers.GetBugComments).Methods("GET")
+	protected.HandleFunc("/bugs/{id}/comments", commentHandlers.CreateBugComment).Methods("POST")
+	protected.HandleFunc("/bugs/{id}/attachments", attachmentHandlers.GetBugAttachments).Methods("GET")
+	protected.HandleFunc("/bugs/{id}/attachments", attachmentHandlers.UploadBugAttachment).Methods("POST")
 
 	// Indicator routes
 	protected.HandleFunc("/indicators", indicatorHandlers.Get).Methods("GET")
@@ -101,11 +182,29 @@ func SetupRoutes(
 	protected.HandleFunc("/indicators/causes", indicatorHandlers.CreateCause).Methods("POST")
 	protected.HandleFunc("/indicators/actions", indicatorHandlers.CreateAction).Methods("POST")
 	protected.HandleFunc("/indicators/actions/{id}", indicatorHandlers.PatchAction).Methods("PATCH")
+	protected.HandleFunc("/indicators/actions/{id}/comments", commentHandlers.GetActionComments).Methods("GET")
+	protected.HandleFunc("/indicators/actions/{id}/comments", commentHandlers.CreateActionComment).Methods("POST")
 	protected.HandleFunc("/indicators/ranges", indicatorHandlers.SetRange).Methods("POST")
 	protected.HandleFunc("/indicators/ranges/{range_id}", indicatorHandlers.DeleteRange).Methods("DELETE")
 	protected.HandleFunc("/indicators/{indicator_id}/metrics", indicatorHandlers.UpdateMetricValues).Methods("PUT")
 	protected.HandleFunc("/indicators/{indicator_id}/summary", indicatorHandlers.GetMetricSummary).Methods("GET")
 
+	// Comment routes
+	protected.HandleFunc("/comments/{id}", commentHandlers.UpdateComment).Methods("PUT")
+	protected.HandleFunc("/comments/{id}", commentHandlers.DeleteComment).Methods("DELETE")
+
+	// Attachment routes
+	protected.HandleFunc("/attachments/{id}", attachmentHandlers.DownloadAttachment).Methods("GET")
+	protected.HandleFunc("/attachments/{id}", attachmentHandlers.DeleteAttachment).Methods("DELETE")
+
+	// Notification routes
+	protected.HandleFunc("/notifications", notificationHandlers.GetNotifications).Methods("GET")
+	protected.HandleFunc("/notifications/read-all", notificationHandlers.MarkAllNotificationsRead).Methods("POST")
+	protected.HandleFunc("/notifications/{id}/read", notificationHandlers.MarkNotificationRead).Methods("POST")
+
+	// Search routes
+	protected.HandleFunc("/search", searchHandlers.Search).Methods("GET")
+
 	// Health check (public)
 	router.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
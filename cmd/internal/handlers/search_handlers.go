@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/usecases"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type SearchHandlers struct {
+	searchUseCase *usecases.SearchUseCase
+}
+
+func NewSearchHandlers(searchUseCase *usecases.SearchUseCase) *SearchHandlers {
+	return &SearchHandlers{
+		searchUseCase: searchUseCase,
+	}
+}
+
+// Search handles GET /search
+// @Summary Full-text search
+// @Description Search project names and descriptions, tasks, bugs, improvements, causes and actions of the projects the user is a member of. Hits are ranked and carry an HTML-escaped fragment with the matched terms in <mark> tags. The query accepts web search syntax: quoted phrases, OR and -excluded words
+// @Tags search
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "Search terms"
+// @Param type query string false "Comma separated hit types" Enums(project, task, bug, improvement, cause, action)
+// @Param project_id query string false "Only search this project" format(uuid)
+// @Param limit query int false "Maximum number of hits" default(20) maximum(50)
+// @Success 200 {object} models.SearchResponse "Search hits"
+// @Failure 400 {string} string "Missing q, invalid type, project_id or limit"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Failed to search"
+// @Router /search [get]
+func (h *SearchHandlers) Search(w http.ResponseWriter, r *http.Request) {
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	values := r.URL.Query()
+	req := models.SearchRequest{Query: values.Get("q")}
+
+	if typeParam := values.Get("type"); typeParam != "" {
+		for _, t := range strings.Split(typeParam, ",") {
+			req.Types = append(req.Types, models.SearchHitType(strings.ToLower(strings.TrimSpace(t))))
+		}
+	}
+
+	if projectIDStr := values.Get("project_id"); projectIDStr != "" {
+		projectID, err := uuid.Parse(projectIDStr)
+		if err != nil {
+			http.Error(w, "Invalid project_id", http.StatusBadRequest)
+			return
+		}
+		req.ProjectID = &projectID
+	}
+
+	if limitStr := values.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+		req.Limit = limit
+	}
+
+	ctx := r.Context()
+	result, err := h.searchUseCase.Search(ctx, requester.ID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrEmptySearchQuery):
+			http.Error(w, "q is required", http.StatusBadRequest)
+		case errors.Is(err, usecases.ErrInvalidSearchType):
+			http.Error(w, fmt.Sprintf("Invalid type (allowed: %s)", searchTypeNames()), http.StatusBadRequest)
+		default:
+			http.Error(w, "Failed to search", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		log.Printf("Error encoding search response: %v", err)
+	}
+}
+
+func searchTypeNames() string {
+	names := make([]string, 0, len(models.AllSearchHitTypes()))
+	for _, t := range models.AllSearchHitTypes() {
+		names = append(names, string(t))
+	}
+	return strings.Join(names, ", ")
+}
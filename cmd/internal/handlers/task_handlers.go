@@ -2,8 +2,13 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"log"
 	"net/http"
 	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/dependency"
+	"prodyo-backend/cmd/internal/repositories/iteration"
+	"prodyo-backend/cmd/internal/repositories/task"
 	"prodyo-backend/cmd/internal/usecases"
 
 	"github.com/google/uuid"
@@ -21,7 +26,12 @@ func NewTaskHandlers(taskUseCase *usecases.TaskUseCase) *TaskHandlers {
 }
 
 type CreateTaskRequest struct {
-	IterationID  uuid.UUID  `json:"iteration_id"`
+	IterationID uuid.UUID `json:"iteration_id"`
+	CreateBacklogTaskRequest
+}
+
+// CreateBacklogTaskRequest is a task added to the project backlog, outside any iteration
+type CreateBacklogTaskRequest struct {
 	Name         string     `json:"name"`
 	Description  string     `json:"description"`
 	AssigneeID   *uuid.UUID `json:"assignee_id,omitempty"`
@@ -41,6 +51,50 @@ type UpdateTaskRequest struct {
 	ExpectedTime float64    `json:"expected_time"`
 }
 
+type SetStatusTransitionsRequest struct {
+	Transitions []models.StatusTransition `json:"transitions"`
+}
+
+// MoveTaskRequest places a task in a board column; without after_id and before_id it goes to the bottom
+type MoveTaskRequest struct {
+	IterationID *uuid.UUID `json:"iteration_id,omitempty"` // Defaults to the current iteration
+	Backlog     bool       `json:"backlog,omitempty"`      // Send the task back to the project backlog
+	Status      string     `json:"status,omitempty"`       // Defaults to the current status
+	AfterID     *uuid.UUID `json:"after_id,omitempty"`     // Task right above the new position
+	BeforeID    *uuid.UUID `json:"before_id,omitempty"`    // Task right below the new position
+}
+
+type SetWIPLimitsRequest struct {
+	Limits []models.WIPLimit `json:"limits"`
+}
+
+// BulkTaskRequest applies one operation to several tasks
+type BulkTaskRequest struct {
+	TaskIDs     []uuid.UUID           `json:"task_ids"`
+	Operation   string                `json:"operation" enums:"patch,move_to_iteration,delete"`
+	Patch       *BulkTaskPatchRequest `json:"patch,omitempty"`        // Required by patch
+	IterationID *uuid.UUID            `json:"iteration_id,omitempty"` // Required by move_to_iteration
+}
+
+type BulkTaskPatchRequest struct {
+	Status        *string    `json:"status,omitempty"`
+	AssigneeID    *uuid.UUID `json:"assignee_id,omitempty"`
+	ClearAssignee bool       `json:"clear_assignee,omitempty"` // Unassign the tasks; not allowed with assignee_id
+	Points        *int       `json:"points,omitempty"`
+	ExpectedTime  *float64   `json:"expected_time,omitempty"`
+}
+
+// PlanIterationRequest lists the backlog tasks pulled into an iteration
+type PlanIterationRequest struct {
+	TaskIDs []uuid.UUID `json:"task_ids"`
+}
+
+// AddDependencyRequest links the task to another one; exactly one of the fields is set
+type AddDependencyRequest struct {
+	BlockedBy *uuid.UUID `json:"blocked_by,omitempty"` // The other task must finish before this one starts
+	Blocks    *uuid.UUID `json:"blocks,omitempty"`     // This task must finish before the other one starts
+}
+
 type PatchTaskRequest struct {
 	Name         *string    `json:"name,omitempty"`
 	Description  *string    `json:"description,omitempty"`
@@ -53,14 +107,22 @@ type PatchTaskRequest struct {
 
 // GetAll handles GET /tasks
 // @Summary Get all tasks
-// @Description Get all tasks for a specific iteration
+// @Description Get all tasks for a specific iteration, optionally filtered, sorted and searched
 // @Tags tasks
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param iteration_id query string true "Iteration ID" format(uuid)
+// @Param status query string false "Comma separated statuses, e.g. InProgress,Completed"
+// @Param assignee_id query string false "Comma separated assignee IDs"
+// @Param label query string false "Comma separated label names; tasks with any of them"
+// @Param label_id query string false "Comma separated label IDs; tasks with any of them"
+// @Param points query string false "Points; points_gte, points_lte, points_gt and points_lt are also accepted"
+// @Param created_at_gte query string false "Created at or after; also created_at_lte, updated_at_gte, ..."
+// @Param sort query string false "Comma separated fields, descending with a - prefix, e.g. -updated_at; board order (rank) by default" Enums(status, points, name, rank, created_at, updated_at)
+// @Param q query string false "Search in name and description"
 // @Success 200 {array} models.Task "List of tasks"
-// @Failure 400 {string} string "Invalid iteration_id"
+// @Failure 400 {string} string "Invalid iteration_id, unknown filter field or invalid value"
 // @Failure 500 {string} string "Failed to retrieve tasks"
 // @Router /tasks [get]
 func (h *TaskHandlers) GetAll(w http.ResponseWriter, r *http.Request) {
@@ -76,8 +138,14 @@ func (h *TaskHandlers) GetAll(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	listQuery, err := parseListQuery(r, models.TaskListSpec, "iteration_id")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	ctx := r.Context()
-	tasks, err := h.taskUseCase.GetAll(ctx, iterationID)
+	tasks, err := h.taskUseCase.GetAll(ctx, iterationID, listQuery)
 	if err != nil {
 		http.Error(w, "Failed to retrieve tasks", http.StatusInternalServerError)
 		return
@@ -89,15 +157,18 @@ func (h *TaskHandlers) GetAll(w http.ResponseWriter, r *http.Request) {
 
 // GetByID handles GET /tasks/{id}
 // @Summary Get task by ID
-// @Description Get a specific task by its ID
+// @Description Get a specific task by its ID. include=comments adds the threaded comments and include=attachments the attached files
 // @Tags tasks
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Task ID" format(uuid)
+// @Param include query string false "Comma separated relations to load: comments, attachments"
 // @Success 200 {object} models.Task "Task details"
-// @Failure 400 {string} string "Invalid task ID"
+// @Failure 400 {string} string "Invalid task ID or include"
+// @Failure 403 {string} string "Only project members can read comments"
 // @Failure 404 {string} string "Task not found"
+// @Failure 500 {string} string "Failed to retrieve task"
 // @Router /tasks/{id} [get]
 func (h *TaskHandlers) GetByID(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
@@ -109,15 +180,38 @@ func (h *TaskHandlers) GetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	includes, err := parseInclude(r, "comments", "attachments")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
 	ctx := r.Context()
-	task, err := h.taskUseCase.GetByID(ctx, id)
+	t, err := h.taskUseCase.GetDetail(ctx, id, usecases.TaskInclude{
+		Comments:    includes["comments"],
+		Attachments: includes["attachments"],
+	}, requester.ID)
 	if err != nil {
-		http.Error(w, "Task not found", http.StatusNotFound)
+		if errors.Is(err, task.ErrNotFound) {
+			http.Error(w, "Task not found", http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, usecases.ErrNotProjectMember) {
+			http.Error(w, "Only project members can read comments", http.StatusForbidden)
+			return
+		}
+		http.Error(w, "Failed to retrieve task", http.StatusInternalServerError)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(task)
+	json.NewEncoder(w).Encode(t)
 }
 
 // Create handles POST /tasks
@@ -130,6 +224,9 @@ func (h *TaskHandlers) GetByID(w http.ResponseWriter, r *http.Request) {
 // @Param task body CreateTaskRequest true "Task data"
 // @Success 201 {object} map[string]interface{} "Task created successfully"
 // @Failure 400 {string} string "Invalid request body"
+// @Failure 404 {string} string "Iteration not found"
+// @Failure 409 {string} string "The status column is at its WIP limit"
+// @Failure 422 {string} string "Unknown status"
 // @Failure 500 {string} string "Failed to create task"
 // @Router /tasks [post]
 func (h *TaskHandlers) Create(w http.ResponseWriter, r *http.Request) {
@@ -139,9 +236,39 @@ func (h *TaskHandlers) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	newTask, ok := req.toTask(w)
+	if !ok {
+		return
+	}
+	newTask.IterationID = &req.IterationID
+
+	actor, _ := GetUserFromContext(r)
+
+	ctx := r.Context()
+	taskID, err := h.taskUseCase.Create(ctx, newTask, actor.ID)
+	if err != nil {
+		writeTaskError(w, err, "Failed to create task")
+		return
+	}
+
+	response := map[string]interface{}{
+		"id":           taskID,
+		"iteration_id": req.IterationID,
+		"name":         req.Name,
+		"description":  req.Description,
+		"status":       newTask.Status,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// toTask builds the new task from the request, writing the error response when it is invalid
+func (req CreateBacklogTaskRequest) toTask(w http.ResponseWriter) (models.Task, bool) {
 	if req.Name == "" {
 		http.Error(w, "Name is required", http.StatusBadRequest)
-		return
+		return models.Task{}, false
 	}
 
 	var assignee models.User
@@ -157,15 +284,23 @@ func (h *TaskHandlers) Create(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	status := normalizeStatus(req.Status)
+	// New tasks start as NotStarted unless the request says otherwise
+	status := models.StatusNotStarted
+	if req.Status != "" {
+		parsed, err := parseStatus(req.Status)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return models.Task{}, false
+		}
+		status = parsed
+	}
 
 	points := req.Points
 	if points == 0 {
 		points = 1
 	}
 
-	newTask := models.Task{
-		IterationID:  req.IterationID,
+	return models.Task{
 		Name:         req.Name,
 		Description:  req.Description,
 		Assignee:     assignee,
@@ -173,21 +308,104 @@ func (h *TaskHandlers) Create(w http.ResponseWriter, r *http.Request) {
 		Timer:        timer,
 		Points:       points,
 		ExpectedTime: req.ExpectedTime,
+	}, true
+}
+
+// GetBacklog handles GET /projects/{id}/backlog
+// @Summary Get project backlog
+// @Description Get the top-level tasks of the project that are not planned in any iteration, in priority order unless sorted otherwise. Backlog tasks are left out of every iteration indicator and report
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param status query string false "Comma separated statuses, e.g. NotStarted,InProgress"
+// @Param assignee_id query string false "Comma separated assignee IDs"
+// @Param label query string false "Comma separated label names; tasks with any of them"
+// @Param label_id query string false "Comma separated label IDs; tasks with any of them"
+// @Param points query string false "Points; points_gte, points_lte, points_gt and points_lt are also accepted"
+// @Param created_at_gte query string false "Created at or after; also created_at_lte, updated_at_gte, ..."
+// @Param sort query string false "Comma separated fields, descending with a - prefix; priority (rank) by default" Enums(status, points, name, rank, created_at, updated_at)
+// @Param q query string false "Search in name and description"
+// @Success 200 {array} models.Task "Backlog tasks"
+// @Failure 400 {string} string "Invalid project ID, unknown filter field or invalid value"
+// @Failure 500 {string} string "Failed to retrieve backlog"
+// @Router /projects/{id}/backlog [get]
+func (h *TaskHandlers) GetBacklog(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	listQuery, err := parseListQuery(r, models.TaskListSpec)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	tasks, err := h.taskUseCase.GetBacklog(ctx, projectID, listQuery)
+	if err != nil {
+		http.Error(w, "Failed to retrieve backlog", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tasks)
+}
+
+// CreateBacklogTask handles POST /projects/{id}/backlog
+// @Summary Add a task to the project backlog
+// @Description Create a task outside any iteration, at the bottom of the backlog priority order
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param task body CreateBacklogTaskRequest true "Task data"
+// @Success 201 {object} map[string]interface{} "Task created successfully"
+// @Failure 400 {string} string "Invalid project ID or request body"
+// @Failure 404 {string} string "Project not found"
+// @Failure 422 {string} string "Unknown status"
+// @Failure 500 {string} string "Failed to create task"
+// @Router /projects/{id}/backlog [post]
+func (h *TaskHandlers) CreateBacklogTask(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
 	}
 
+	var req CreateBacklogTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	newTask, ok := req.toTask(w)
+	if !ok {
+		return
+	}
+	newTask.ProjectID = projectID
+
+	actor, _ := GetUserFromContext(r)
+
 	ctx := r.Context()
-	taskID, err := h.taskUseCase.Create(ctx, newTask)
+	taskID, err := h.taskUseCase.Create(ctx, newTask, actor.ID)
 	if err != nil {
-		http.Error(w, "Failed to create task", http.StatusInternalServerError)
+		writeTaskError(w, err, "Failed to create task")
 		return
 	}
 
 	response := map[string]interface{}{
-		"id":           taskID,
-		"iteration_id": req.IterationID,
-		"name":         req.Name,
-		"description":  req.Description,
-		"status":       status,
+		"id":          taskID,
+		"project_id":  projectID,
+		"name":        req.Name,
+		"description": req.Description,
+		"status":      newTask.Status,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -195,17 +413,70 @@ func (h *TaskHandlers) Create(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// PlanIteration handles POST /iterations/{id}/plan
+// @Summary Pull backlog tasks into an iteration
+// @Description Move up to 200 backlog tasks of the iteration project into the iteration, with their sub-tasks, each at the bottom of its status column. Each task passes the same checks as a bulk move; a failing task is reported and stays in the backlog
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Iteration ID" format(uuid)
+// @Param plan body PlanIterationRequest true "Backlog tasks to plan"
+// @Param force query bool false "Plan tasks even if they have unfinished blockers"
+// @Success 200 {object} models.TaskBulkResponse "Outcome per task"
+// @Failure 400 {string} string "Invalid iteration ID or request body"
+// @Failure 404 {string} string "Iteration not found"
+// @Failure 422 {string} string "Iteration closed"
+// @Failure 500 {string} string "Failed to plan iteration"
+// @Router /iterations/{id}/plan [post]
+func (h *TaskHandlers) PlanIteration(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	iterationID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid iteration ID", http.StatusBadRequest)
+		return
+	}
+
+	var req PlanIterationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	actor, _ := GetUserFromContext(r)
+
+	ctx := r.Context()
+	resp, err := h.taskUseCase.Plan(ctx, iterationID, req.TaskIDs, actor.ID, r.URL.Query().Get("force") == "true")
+	if err != nil {
+		writeTaskError(w, err, "Failed to plan iteration")
+		return
+	}
+
+	for i := range resp.Results {
+		if resp.Results[i].Err != nil {
+			resp.Results[i].Error = bulkErrorMessage(resp.Results[i].Err)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
 // Update handles PUT /tasks/{id}
 // @Summary Update task
-// @Description Update an existing task
+// @Description Update an existing task; an empty status keeps the current one. Moving it to InProgress while a blocking task is unfinished needs force=true
 // @Tags tasks
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Task ID" format(uuid)
 // @Param task body UpdateTaskRequest true "Updated task data"
+// @Param force query bool false "Start the task even if it has unfinished blockers"
 // @Success 200 {object} models.Task "Updated task"
 // @Failure 400 {string} string "Invalid task ID or request body"
+// @Failure 404 {string} string "Task not found"
+// @Failure 409 {string} string "Task has unfinished blockers or the column is at its WIP limit"
+// @Failure 422 {string} string "Unknown status or transition not allowed"
 // @Failure 500 {string} string "Failed to update task"
 // @Router /tasks/{id} [put]
 func (h *TaskHandlers) Update(w http.ResponseWriter, r *http.Request) {
@@ -237,7 +508,15 @@ func (h *TaskHandlers) Update(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	status := normalizeStatus(req.Status)
+	// An empty status keeps the current one
+	var status models.StatusEnum
+	if req.Status != "" {
+		status, err = parseStatus(req.Status)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+	}
 
 	points := req.Points
 	if points == 0 {
@@ -255,15 +534,24 @@ func (h *TaskHandlers) Update(w http.ResponseWriter, r *http.Request) {
 		ExpectedTime: req.ExpectedTime,
 	}
 
+	actor, _ := GetUserFromContext(r)
+	force := r.URL.Query().Get("force") == "true"
+
 	ctx := r.Context()
-	err = h.taskUseCase.Update(ctx, updatedTask)
+	err = h.taskUseCase.Update(ctx, updatedTask, actor.ID, force)
 	if err != nil {
-		http.Error(w, "Failed to update task", http.StatusInternalServerError)
+		writeTaskError(w, err, "Failed to update task")
+		return
+	}
+
+	saved, err := h.taskUseCase.GetByID(ctx, id)
+	if err != nil {
+		http.Error(w, "Failed to retrieve updated task", http.StatusInternalServerError)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(updatedTask)
+	json.NewEncoder(w).Encode(saved)
 }
 
 // Delete handles DELETE /tasks/{id}
@@ -300,16 +588,19 @@ func (h *TaskHandlers) Delete(w http.ResponseWriter, r *http.Request) {
 
 // Patch handles PATCH /tasks/{id}
 // @Summary Partially update task
-// @Description Partially update an existing task (only provided fields will be updated)
+// @Description Partially update an existing task (only provided fields will be updated). Moving it to InProgress while a blocking task is unfinished needs force=true
 // @Tags tasks
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Task ID" format(uuid)
 // @Param task body PatchTaskRequest true "Partial task data"
+// @Param force query bool false "Start the task even if it has unfinished blockers"
 // @Success 200 {object} models.Task "Updated task"
 // @Failure 400 {string} string "Invalid task ID or request body"
 // @Failure 404 {string} string "Task not found"
+// @Failure 409 {string} string "Task has unfinished blockers or the column is at its WIP limit"
+// @Failure 422 {string} string "Unknown status or transition not allowed"
 // @Failure 500 {string} string "Failed to update task"
 // @Router /tasks/{id} [patch]
 func (h *TaskHandlers) Patch(w http.ResponseWriter, r *http.Request) {
@@ -348,8 +639,15 @@ func (h *TaskHandlers) Patch(w http.ResponseWriter, r *http.Request) {
 		existingTask.Assignee.ID = *req.AssigneeID
 	}
 
-	if req.Status != nil {
-		existingTask.Status = normalizeStatus(*req.Status)
+	// The status is resolved under the row lock unless the request changes it
+	existingTask.Status = ""
+	if req.Status != nil && *req.Status != "" {
+		status, err := parseStatus(*req.Status)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		existingTask.Status = status
 	}
 
 	if req.Timer != nil {
@@ -371,9 +669,12 @@ func (h *TaskHandlers) Patch(w http.ResponseWriter, r *http.Request) {
 		existingTask.ExpectedTime = *req.ExpectedTime
 	}
 
-	err = h.taskUseCase.Update(ctx, existingTask)
+	actor, _ := GetUserFromContext(r)
+	force := r.URL.Query().Get("force") == "true"
+
+	err = h.taskUseCase.Update(ctx, existingTask, actor.ID, force)
 	if err != nil {
-		http.Error(w, "Failed to update task", http.StatusInternalServerError)
+		writeTaskError(w, err, "Failed to update task")
 		return
 	}
 
@@ -386,3 +687,546 @@ func (h *TaskHandlers) Patch(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(updatedTask)
 }
+
+// GetStatusHistory handles GET /tasks/{id}/status-history
+// @Summary Get task status history
+// @Description Get every status change of a task with the user who made it, oldest first
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {array} models.TaskStatusChange "Status changes"
+// @Failure 400 {string} string "Invalid task ID"
+// @Failure 404 {string} string "Task not found"
+// @Failure 500 {string} string "Failed to retrieve status history"
+// @Router /tasks/{id}/status-history [get]
+func (h *TaskHandlers) GetStatusHistory(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	history, err := h.taskUseCase.GetStatusHistory(ctx, id)
+	if err != nil {
+		writeTaskError(w, err, "Failed to retrieve status history")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(history)
+}
+
+// GetStatusTransitions handles GET /projects/{id}/status-transitions
+// @Summary Get allowed status transitions
+// @Description Get the status transitions allowed in a project; an empty list means every transition is allowed
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Success 200 {array} models.StatusTransition "Allowed transitions"
+// @Failure 400 {string} string "Invalid project ID"
+// @Failure 500 {string} string "Failed to retrieve status transitions"
+// @Router /projects/{id}/status-transitions [get]
+func (h *TaskHandlers) GetStatusTransitions(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	projectID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	transitions, err := h.taskUseCase.GetStatusTransitions(ctx, projectID)
+	if err != nil {
+		http.Error(w, "Failed to retrieve status transitions", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(transitions)
+}
+
+// SetStatusTransitions handles PUT /projects/{id}/status-transitions
+// @Summary Replace allowed status transitions
+// @Description Replace the status transitions allowed in a project; send an empty list to allow every transition
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param transitions body SetStatusTransitionsRequest true "Allowed transitions"
+// @Success 200 {array} models.StatusTransition "Allowed transitions"
+// @Failure 400 {string} string "Invalid project ID or request body"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only owners and maintainers may change the workflow"
+// @Failure 422 {string} string "Invalid transition"
+// @Failure 500 {string} string "Failed to update status transitions"
+// @Router /projects/{id}/status-transitions [put]
+func (h *TaskHandlers) SetStatusTransitions(w http.ResponseWriter, r *http.Request) {
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	projectID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	var req SetStatusTransitionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	transitions := make([]models.StatusTransition, 0, len(req.Transitions))
+	for _, t := range req.Transitions {
+		from, err := parseStatus(string(t.FromStatus))
+		if err != nil || t.FromStatus == "" {
+			http.Error(w, "Invalid from_status: "+string(t.FromStatus), http.StatusUnprocessableEntity)
+			return
+		}
+		to, err := parseStatus(string(t.ToStatus))
+		if err != nil || t.ToStatus == "" {
+			http.Error(w, "Invalid to_status: "+string(t.ToStatus), http.StatusUnprocessableEntity)
+			return
+		}
+		transitions = append(transitions, models.StatusTransition{FromStatus: from, ToStatus: to})
+	}
+
+	ctx := r.Context()
+	if err := h.taskUseCase.SetStatusTransitions(ctx, projectID, transitions, requester.ID); err != nil {
+		writeTaskError(w, err, "Failed to update status transitions")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(transitions)
+}
+
+// Move handles POST /tasks/{id}/move
+// @Summary Move task on the board or to another iteration
+// @Description Change the iteration, the status and the position of a task within its column in one step. after_id and before_id are tasks of the target column; with neither the task goes to the bottom. A task moved to another open iteration of the same project takes its sub-tasks, bugs, improvements, timer, comments and attachments along; sub-tasks only move with their parent. backlog=true sends the task back to the project backlog, which keeps a single priority order
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Task ID" format(uuid)
+// @Param move body MoveTaskRequest true "Target column and neighbours"
+// @Param force query bool false "Start the task even if it has unfinished blockers"
+// @Success 200 {object} models.Task "Moved task"
+// @Failure 400 {string} string "Invalid task ID or request body"
+// @Failure 404 {string} string "Task or iteration not found"
+// @Failure 409 {string} string "Task has unfinished blockers or the column is at its WIP limit"
+// @Failure 422 {string} string "Unknown status, transition not allowed, neighbour outside the column, sub-task, or iteration closed or of another project"
+// @Failure 500 {string} string "Failed to move task"
+// @Router /tasks/{id}/move [post]
+func (h *TaskHandlers) Move(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	var req MoveTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	var status models.StatusEnum
+	if req.Status != "" {
+		status, err = parseStatus(req.Status)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+	}
+
+	if req.Backlog && req.IterationID != nil {
+		http.Error(w, "Set either iteration_id or backlog", http.StatusBadRequest)
+		return
+	}
+
+	move := usecases.TaskMove{
+		Backlog:  req.Backlog,
+		Status:   status,
+		AfterID:  req.AfterID,
+		BeforeID: req.BeforeID,
+		Force:    r.URL.Query().Get("force") == "true",
+	}
+	if req.IterationID != nil {
+		move.IterationID = *req.IterationID
+	}
+
+	actor, _ := GetUserFromContext(r)
+
+	ctx := r.Context()
+	if err := h.taskUseCase.Move(ctx, id, move, actor.ID); err != nil {
+		writeTaskError(w, err, "Failed to move task")
+		return
+	}
+
+	t, err := h.taskUseCase.GetByID(ctx, id)
+	if err != nil {
+		writeTaskError(w, err, "Failed to retrieve task")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(t)
+}
+
+// Bulk handles POST /tasks/bulk
+// @Summary Apply an operation to several tasks
+// @Description Patch status, assignee (clear_assignee unassigns), points or expected time, move to another iteration of the project, or delete up to 200 tasks in one transaction. Each task passes the same checks as a single change; a failing task is reported and left out without undoing the others. Sub-tasks move with their parent
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param bulk body BulkTaskRequest true "Tasks and operation"
+// @Param force query bool false "Start tasks even if they have unfinished blockers"
+// @Success 200 {object} models.TaskBulkResponse "Outcome per task"
+// @Failure 400 {string} string "Invalid request body or operation"
+// @Failure 404 {string} string "Iteration not found"
+// @Failure 422 {string} string "Unknown status or target iteration closed"
+// @Failure 500 {string} string "Failed to run bulk operation"
+// @Router /tasks/bulk [post]
+func (h *TaskHandlers) Bulk(w http.ResponseWriter, r *http.Request) {
+	var req BulkTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	bulk := models.TaskBulkRequest{
+		TaskIDs:     req.TaskIDs,
+		Operation:   models.TaskBulkOperation(req.Operation),
+		IterationID: req.IterationID,
+		Force:       r.URL.Query().Get("force") == "true",
+	}
+	if req.Patch != nil {
+		if req.Patch.ClearAssignee && req.Patch.AssigneeID != nil {
+			http.Error(w, "assignee_id and clear_assignee cannot be used together", http.StatusBadRequest)
+			return
+		}
+		bulk.Patch = &models.TaskPatch{
+			SetAssignee:  req.Patch.AssigneeID != nil || req.Patch.ClearAssignee,
+			AssigneeID:   req.Patch.AssigneeID,
+			Points:       req.Patch.Points,
+			ExpectedTime: req.Patch.ExpectedTime,
+		}
+		if req.Patch.Status != nil {
+			status, err := parseStatus(*req.Patch.Status)
+			if err != nil || *req.Patch.Status == "" {
+				http.Error(w, "Invalid status: "+*req.Patch.Status, http.StatusUnprocessableEntity)
+				return
+			}
+			bulk.Patch.Status = &status
+		}
+	}
+
+	actor, _ := GetUserFromContext(r)
+
+	ctx := r.Context()
+	resp, err := h.taskUseCase.Bulk(ctx, bulk, actor.ID)
+	if err != nil {
+		writeTaskError(w, err, "Failed to run bulk operation")
+		return
+	}
+
+	for i := range resp.Results {
+		if resp.Results[i].Err != nil {
+			resp.Results[i].Error = bulkErrorMessage(resp.Results[i].Err)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// bulkErrorMessage is the text reported for a task left out of a bulk operation
+func bulkErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, task.ErrNotFound):
+		return "Task not found"
+	case errors.Is(err, usecases.ErrInvalidStatusTransition),
+		errors.Is(err, usecases.ErrTaskBlocked),
+		errors.Is(err, usecases.ErrWIPLimitReached),
+		errors.Is(err, usecases.ErrSubTaskMove),
+		errors.Is(err, usecases.ErrIterationOtherProject),
+		errors.Is(err, usecases.ErrNotInBacklog):
+		return err.Error()
+	default:
+		log.Printf("Bulk task operation failed: %v", err)
+		return "Failed to apply the operation to this task"
+	}
+}
+
+// AddDependency handles POST /tasks/{id}/dependencies
+// @Summary Add a task dependency
+// @Description Declare that the task is blocked by another task, or that it blocks another task. Both must be in the same project, in any iteration
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Task ID" format(uuid)
+// @Param dependency body AddDependencyRequest true "The other task, as blocked_by or blocks"
+// @Success 200 {object} models.Task "Task with its dependencies"
+// @Failure 400 {string} string "Invalid task ID or request body"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project members can change dependencies"
+// @Failure 404 {string} string "Task not found"
+// @Failure 409 {string} string "Dependency would create a cycle"
+// @Failure 422 {string} string "Task depends on itself or on a task of another project"
+// @Failure 500 {string} string "Failed to add dependency"
+// @Router /tasks/{id}/dependencies [post]
+func (h *TaskHandlers) AddDependency(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	var req AddDependencyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	var blockerID, blockedID uuid.UUID
+	switch {
+	case req.BlockedBy != nil && req.Blocks == nil:
+		blockerID, blockedID = *req.BlockedBy, id
+	case req.Blocks != nil && req.BlockedBy == nil:
+		blockerID, blockedID = id, *req.Blocks
+	default:
+		http.Error(w, "Exactly one of blocked_by and blocks is required", http.StatusBadRequest)
+		return
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.taskUseCase.AddDependency(ctx, blockerID, blockedID, requester.ID); err != nil {
+		writeTaskError(w, err, "Failed to add dependency")
+		return
+	}
+
+	t, err := h.taskUseCase.GetByID(ctx, id)
+	if err != nil {
+		writeTaskError(w, err, "Failed to retrieve task")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(t)
+}
+
+// RemoveDependency handles DELETE /tasks/{id}/dependencies/{otherId}
+// @Summary Remove a task dependency
+// @Description Remove the dependency between two tasks, whichever of them is the blocker
+// @Tags tasks
+// @Security BearerAuth
+// @Param id path string true "Task ID" format(uuid)
+// @Param otherId path string true "ID of the other task" format(uuid)
+// @Success 204 "Dependency removed"
+// @Failure 400 {string} string "Invalid task ID"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project members can change dependencies"
+// @Failure 404 {string} string "Dependency not found"
+// @Failure 500 {string} string "Failed to remove dependency"
+// @Router /tasks/{id}/dependencies/{otherId} [delete]
+func (h *TaskHandlers) RemoveDependency(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+	otherID, err := uuid.Parse(vars["otherId"])
+	if err != nil {
+		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.taskUseCase.RemoveDependency(ctx, id, otherID, requester.ID); err != nil {
+		writeTaskError(w, err, "Failed to remove dependency")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetDependencyGraph handles GET /iterations/{id}/dependency-graph
+// @Summary Get the dependency graph of an iteration
+// @Description Get every task of the iteration as a node and every dependency touching them as an edge from blocker to blocked. Tasks of other iterations at the far end of an edge are flagged external
+// @Tags tasks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Iteration ID" format(uuid)
+// @Success 200 {object} models.DependencyGraph "Dependency graph"
+// @Failure 400 {string} string "Invalid iteration ID"
+// @Failure 404 {string} string "Iteration not found"
+// @Failure 500 {string} string "Failed to retrieve dependency graph"
+// @Router /iterations/{id}/dependency-graph [get]
+func (h *TaskHandlers) GetDependencyGraph(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	iterationID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid iteration ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	graph, err := h.taskUseCase.GetDependencyGraph(ctx, iterationID)
+	if err != nil {
+		writeTaskError(w, err, "Failed to retrieve dependency graph")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(graph)
+}
+
+// GetWIPLimits handles GET /projects/{id}/wip-limits
+// @Summary Get WIP limits
+// @Description Get the most tasks each status column of an iteration board may hold; statuses left out have no limit
+// @Tags tasks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Success 200 {array} models.WIPLimit "WIP limits"
+// @Failure 400 {string} string "Invalid project ID"
+// @Failure 500 {string} string "Failed to retrieve WIP limits"
+// @Router /projects/{id}/wip-limits [get]
+func (h *TaskHandlers) GetWIPLimits(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	limits, err := h.taskUseCase.GetWIPLimits(ctx, projectID)
+	if err != nil {
+		http.Error(w, "Failed to retrieve WIP limits", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(limits)
+}
+
+// SetWIPLimits handles PUT /projects/{id}/wip-limits
+// @Summary Replace WIP limits
+// @Description Replace the WIP limits of a project; send an empty list to remove every limit. Columns already above a new limit keep their tasks but accept no more
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Project ID" format(uuid)
+// @Param limits body SetWIPLimitsRequest true "WIP limits"
+// @Success 200 {array} models.WIPLimit "WIP limits"
+// @Failure 400 {string} string "Invalid project ID or request body"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Only project owners and maintainers can change WIP limits"
+// @Failure 422 {string} string "Invalid limit"
+// @Failure 500 {string} string "Failed to update WIP limits"
+// @Router /projects/{id}/wip-limits [put]
+func (h *TaskHandlers) SetWIPLimits(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	var req SetWIPLimitsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	limits := make([]models.WIPLimit, 0, len(req.Limits))
+	for _, l := range req.Limits {
+		status, err := parseStatus(string(l.Status))
+		if err != nil || l.Status == "" {
+			http.Error(w, "Invalid status: "+string(l.Status), http.StatusUnprocessableEntity)
+			return
+		}
+		limits = append(limits, models.WIPLimit{Status: status, MaxTasks: l.MaxTasks})
+	}
+
+	requester, ok := GetUserFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.taskUseCase.SetWIPLimits(ctx, projectID, limits, requester.ID); err != nil {
+		writeTaskError(w, err, "Failed to update WIP limits")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(limits)
+}
+
+func writeTaskError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, usecases.ErrNotProjectMember), errors.Is(err, usecases.ErrInsufficientRole):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, task.ErrNotFound), errors.Is(err, dependency.ErrTaskNotFound):
+		http.Error(w, "Task not found", http.StatusNotFound)
+	case errors.Is(err, iteration.ErrNotFound):
+		http.Error(w, "Iteration not found", http.StatusNotFound)
+	case errors.Is(err, task.ErrProjectNotFound):
+		http.Error(w, "Project not found", http.StatusNotFound)
+	case errors.Is(err, dependency.ErrNotFound):
+		http.Error(w, "Dependency not found", http.StatusNotFound)
+	case errors.Is(err, usecases.ErrTaskBlocked), errors.Is(err, usecases.ErrWIPLimitReached), errors.Is(err, dependency.ErrCycle):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, task.ErrInvalidPosition), errors.Is(err, usecases.ErrInvalidWIPLimit):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	case errors.Is(err, usecases.ErrSubTaskMove), errors.Is(err, usecases.ErrIterationOtherProject), errors.Is(err, usecases.ErrIterationClosed):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	case errors.Is(err, usecases.ErrInvalidBulkRequest):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, dependency.ErrSelfDependency), errors.Is(err, dependency.ErrDifferentProjects):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	case errors.Is(err, usecases.ErrInvalidStatus), errors.Is(err, usecases.ErrInvalidStatusTransition):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
@@ -2,10 +2,10 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"prodyo-backend/cmd/internal/models"
 	"prodyo-backend/cmd/internal/usecases"
-	"strconv"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -40,31 +40,36 @@ type UpdateUserRequest struct {
 // @Security BearerAuth
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Page size" default(20) maximum(100)
+// @Param cursor query string false "Opaque cursor from next_cursor of the previous page of the same list; takes precedence over page"
+// @Param email query string false "Exact email"
+// @Param created_at_gte query string false "Created at or after; also created_at_lte, created_at_gt, created_at_lt"
+// @Param sort query string false "Comma separated fields, descending with a - prefix, e.g. name; cannot be combined with cursor"
+// @Param q query string false "Search in name and email"
 // @Success 200 {object} map[string]interface{} "Users with pagination"
+// @Failure 400 {string} string "Invalid cursor, unknown filter field or invalid value"
 // @Failure 500 {string} string "Internal server error"
 // @Router /users [get]
 func (h *UserHandlers) GetAllUsers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	pagination := models.PaginationRequest{
-		Page:     1,
-		PageSize: 20,
-	}
-
-	if page := r.URL.Query().Get("page"); page != "" {
-		if p, err := strconv.Atoi(page); err == nil && p > 0 {
-			pagination.Page = p
-		}
+	pagination, err := parsePagination(r)
+	if err != nil {
+		http.Error(w, "Invalid cursor", http.StatusBadRequest)
+		return
 	}
 
-	if pageSize := r.URL.Query().Get("page_size"); pageSize != "" {
-		if ps, err := strconv.Atoi(pageSize); err == nil && ps > 0 && ps <= 100 {
-			pagination.PageSize = ps
-		}
+	listQuery, err := parseListQuery(r, models.UserListSpec)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	users, paginationResp, err := h.userUseCase.GetAll(ctx, pagination)
+	users, paginationResp, err := h.userUseCase.GetAll(ctx, pagination, listQuery)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidCursor) {
+			http.Error(w, "Invalid cursor", http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to retrieve users", http.StatusInternalServerError)
 		return
 	}
@@ -247,6 +252,7 @@ func (h *UserHandlers) DeleteUser(w http.ResponseWriter, r *http.Request) {
 // @Param projectId path string true "Project ID" format(uuid)
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Page size" default(20) maximum(100)
+// @Param cursor query string false "Opaque cursor from next_cursor of the previous page of the same list; takes precedence over page"
 // @Success 200 {object} map[string]interface{} "Users with pagination"
 // @Failure 400 {string} string "Invalid project ID"
 // @Failure 500 {string} string "Internal server error"
@@ -261,26 +267,19 @@ func (h *UserHandlers) GetUsersByProjectID(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	pagination := models.PaginationRequest{
-		Page:     1,
-		PageSize: 20,
-	}
-
-	if page := r.URL.Query().Get("page"); page != "" {
-		if p, err := strconv.Atoi(page); err == nil && p > 0 {
-			pagination.Page = p
-		}
-	}
-
-	if pageSize := r.URL.Query().Get("page_size"); pageSize != "" {
-		if ps, err := strconv.Atoi(pageSize); err == nil && ps > 0 && ps <= 100 {
-			pagination.PageSize = ps
-		}
+	pagination, err := parsePagination(r)
+	if err != nil {
+		http.Error(w, "Invalid cursor", http.StatusBadRequest)
+		return
 	}
 
 	ctx := r.Context()
 	users, paginationResp, err := h.userUseCase.GetByProjectID(ctx, projectID, pagination)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidCursor) {
+			http.Error(w, "Invalid cursor", http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to retrieve users", http.StatusInternalServerError)
 		return
 	}
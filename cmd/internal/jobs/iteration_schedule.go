@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"prodyo-backend/cmd/internal/usecases"
+	"time"
+)
+
+// DefaultIterationScheduleInterval is how often upcoming iterations are topped up when not configured
+const DefaultIterationScheduleInterval = time.Hour
+
+// IterationSchedule keeps the upcoming iterations of projects with a cadence created
+// Every replica runs it; KeepSchedulesAhead lets only the one holding the schedule lock create iterations
+type IterationSchedule struct {
+	iterationUseCase *usecases.IterationUseCase
+	interval         time.Duration
+}
+
+func NewIterationSchedule(iterationUseCase *usecases.IterationUseCase, interval time.Duration) *IterationSchedule {
+	if interval <= 0 {
+		interval = DefaultIterationScheduleInterval
+	}
+	return &IterationSchedule{
+		iterationUseCase: iterationUseCase,
+		interval:         interval,
+	}
+}
+
+// Run tops up the schedules right away and then on every interval until ctx is done
+func (j *IterationSchedule) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *IterationSchedule) runOnce(ctx context.Context) {
+	created, err := j.iterationUseCase.KeepSchedulesAhead(ctx, time.Now())
+	if err != nil {
+		log.Printf("Failed to run iteration schedule: %v", err)
+		return
+	}
+	if created > 0 {
+		log.Printf("Iteration schedule created %d iterations", created)
+	}
+}
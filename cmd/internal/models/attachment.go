@@ -0,0 +1,71 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AttachmentTargetType string
+
+const (
+	AttachmentOnTask        AttachmentTargetType = "task"
+	AttachmentOnBug         AttachmentTargetType = "bug"
+	AttachmentOnImprovement AttachmentTargetType = "improvement"
+)
+
+const (
+	// DefaultAttachmentMaxSizeBytes applies to projects that did not set their own limit
+	DefaultAttachmentMaxSizeBytes int64 = 10 << 20
+	// MaxAttachmentSizeBytes caps the limit a project can set
+	MaxAttachmentSizeBytes int64 = 100 << 20
+)
+
+// DefaultAttachmentTypes covers screenshots, logs and documents
+var DefaultAttachmentTypes = []string{"image/*", "text/plain", "application/pdf", "application/zip", "application/x-gzip"}
+
+// Attachment is the metadata of a file uploaded to a task, bug or improvement
+type Attachment struct {
+	ID          uuid.UUID            `json:"id"`
+	ProjectID   uuid.UUID            `json:"project_id"`
+	TargetType  AttachmentTargetType `json:"target_type"`
+	TargetID    uuid.UUID            `json:"target_id"`
+	FileName    string               `json:"file_name"`
+	ContentType string               `json:"content_type"`
+	SizeBytes   int64                `json:"size_bytes"`
+	StorageKey  string               `json:"-"`
+	UploadedBy  *Member              `json:"uploaded_by,omitempty"` // Nil once the uploader account is deleted
+	CreatedAt   time.Time            `json:"created_at"`
+}
+
+// AttachmentSettings are the upload limits of a project
+// AllowedTypes holds media types such as application/pdf, or a whole family such as image/*
+type AttachmentSettings struct {
+	ProjectID    uuid.UUID `json:"project_id"`
+	MaxSizeBytes int64     `json:"max_size_bytes"`
+	AllowedTypes []string  `json:"allowed_types"`
+}
+
+func DefaultAttachmentSettings(projectID uuid.UUID) AttachmentSettings {
+	return AttachmentSettings{
+		ProjectID:    projectID,
+		MaxSizeBytes: DefaultAttachmentMaxSizeBytes,
+		AllowedTypes: append([]string(nil), DefaultAttachmentTypes...),
+	}
+}
+
+// Allows reports whether a file of the media type may be uploaded
+func (s AttachmentSettings) Allows(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	for _, allowed := range s.AllowedTypes {
+		allowed = strings.ToLower(allowed)
+		if allowed == "*/*" || allowed == contentType {
+			return true
+		}
+		if family, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(contentType, family+"/") {
+			return true
+		}
+	}
+	return false
+}
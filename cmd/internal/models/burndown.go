@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BurndownResponse holds the daily progress series of an iteration
+// Every series uses the day (YYYY-MM-DD) as X value
+type BurndownResponse struct {
+	IterationID uuid.UUID      `json:"iterationId"`
+	StartAt     time.Time      `json:"startAt"`
+	EndAt       time.Time      `json:"endAt"`
+	XAxis       AxisDefinition `json:"xAxis"`
+	Burndown    BurndownSeries `json:"burndown"`
+	Burnup      BurnupSeries   `json:"burnup"`
+}
+
+// BurndownSeries contains the remaining work per day and the ideal line to reach zero at EndAt
+type BurndownSeries struct {
+	YAxis           AxisDefinition `json:"yAxis"`
+	RemainingPoints []DataPoint    `json:"remainingPoints"`
+	RemainingHours  []DataPoint    `json:"remainingHours"`
+	IdealPoints     []DataPoint    `json:"idealPoints"`
+	IdealHours      []DataPoint    `json:"idealHours"`
+}
+
+// BurnupSeries contains the completed work per day and the total scope, which changes
+// when tasks are added during the iteration
+type BurnupSeries struct {
+	YAxis           AxisDefinition `json:"yAxis"`
+	CompletedPoints []DataPoint    `json:"completedPoints"`
+	ScopePoints     []DataPoint    `json:"scopePoints"`
+}
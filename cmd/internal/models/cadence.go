@@ -0,0 +1,47 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxCadenceIterations bounds the iterations generated by one request and kept ahead by the scheduler
+	MaxCadenceIterations = 26
+	// MaxCadenceLengthDays bounds the working days of a generated iteration
+	MaxCadenceLengthDays = 120
+)
+
+var ErrInvalidCadence = errors.New("cadence needs length_days from 1 to 120, a start_weekday from 0 (Sunday) to 6 (Saturday), gap_days from 0 and ahead from 0 to 26")
+
+// IterationCadence is the fixed rhythm the iterations of a project follow
+// Each iteration starts on StartWeekday, at least GapDays after the previous one ends, and lasts
+// LengthDays working days of the project calendar; Ahead upcoming iterations are kept created
+type IterationCadence struct {
+	ProjectID    uuid.UUID    `json:"project_id"`
+	LengthDays   int          `json:"length_days"`
+	StartWeekday time.Weekday `json:"start_weekday" swaggertype:"integer"` // 0 is Sunday
+	GapDays      int          `json:"gap_days"`
+	Ahead        int          `json:"ahead"` // 0 generates only on request
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+}
+
+func (c IterationCadence) Validate() error {
+	if c.LengthDays < 1 || c.LengthDays > MaxCadenceLengthDays ||
+		c.StartWeekday < time.Sunday || c.StartWeekday > time.Saturday ||
+		c.GapDays < 0 || c.Ahead < 0 || c.Ahead > MaxCadenceIterations {
+		return ErrInvalidCadence
+	}
+	return nil
+}
+
+// CadenceUpdate is a saved cadence with the upcoming iterations created for it right away
+// The cadence stays saved when they cannot all be created; GenerationError tells why
+type CadenceUpdate struct {
+	IterationCadence
+	Generated       []Iteration     `json:"generated"`
+	GenerationError *IterationError `json:"generation_error,omitempty"`
+}
@@ -0,0 +1,176 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DateLayout is how calendar dates are written in requests and responses
+	DateLayout = "2006-01-02"
+
+	DefaultTimezone = "UTC"
+)
+
+var (
+	ErrInvalidTimezone    = errors.New("unknown timezone")
+	ErrInvalidWorkingDays = errors.New("working_days needs at least one weekday, 0 (Sunday) to 6 (Saturday), listed once")
+	ErrInvalidHoliday     = errors.New("holidays need a date (YYYY-MM-DD), listed once")
+)
+
+// WorkingCalendar is the project calendar every duration in days is measured with: days start at
+// midnight in Timezone and only working weekdays that are not holidays count as working days
+// Build it with NewWorkingCalendar or DefaultWorkingCalendar so the lookups are ready
+type WorkingCalendar struct {
+	ProjectID   uuid.UUID      `json:"project_id"`
+	Timezone    string         `json:"timezone"`
+	WorkingDays []time.Weekday `json:"working_days" swaggertype:"array,integer"` // 0 is Sunday
+	Holidays    []Holiday      `json:"holidays"`
+
+	location    *time.Location
+	workingDays map[time.Weekday]bool
+	holidays    map[string]bool
+}
+
+// Holiday is a day off for the whole project
+type Holiday struct {
+	Date string `json:"date"` // YYYY-MM-DD
+	Name string `json:"name"`
+}
+
+// NewWorkingCalendar validates the settings of a project calendar and prepares its lookups
+func NewWorkingCalendar(projectID uuid.UUID, timezone string, workingDays []time.Weekday, holidays []Holiday) (WorkingCalendar, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "" {
+		return WorkingCalendar{}, fmt.Errorf("%w: %q", ErrInvalidTimezone, timezone)
+	}
+
+	c := WorkingCalendar{
+		ProjectID:   projectID,
+		Timezone:    timezone,
+		WorkingDays: workingDays,
+		Holidays:    holidays,
+		location:    location,
+		workingDays: make(map[time.Weekday]bool, len(workingDays)),
+		holidays:    make(map[string]bool, len(holidays)),
+	}
+	if c.Holidays == nil {
+		c.Holidays = []Holiday{}
+	}
+
+	if len(workingDays) == 0 {
+		return WorkingCalendar{}, ErrInvalidWorkingDays
+	}
+	for _, d := range workingDays {
+		if d < time.Sunday || d > time.Saturday || c.workingDays[d] {
+			return WorkingCalendar{}, ErrInvalidWorkingDays
+		}
+		c.workingDays[d] = true
+	}
+
+	for _, h := range holidays {
+		if _, err := time.Parse(DateLayout, h.Date); err != nil || c.holidays[h.Date] {
+			return WorkingCalendar{}, ErrInvalidHoliday
+		}
+		c.holidays[h.Date] = true
+	}
+
+	return c, nil
+}
+
+// DefaultWorkingCalendar is the calendar of a project that never set one: UTC, Monday to Friday, no holidays
+func DefaultWorkingCalendar(projectID uuid.UUID) WorkingCalendar {
+	c, _ := NewWorkingCalendar(projectID, DefaultTimezone, []time.Weekday{
+		time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday,
+	}, nil)
+	return c
+}
+
+// Location is the timezone of the project, UTC for a calendar that was not built with NewWorkingCalendar
+func (c WorkingCalendar) Location() *time.Location {
+	if c.location == nil {
+		return time.UTC
+	}
+	return c.location
+}
+
+// StartOfDay returns midnight of the project day holding t
+func (c WorkingCalendar) StartOfDay(t time.Time) time.Time {
+	year, month, day := t.In(c.Location()).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, c.Location())
+}
+
+// IsWorkingDay reports whether the project day holding t is a working weekday and not a holiday
+func (c WorkingCalendar) IsWorkingDay(t time.Time) bool {
+	day := t.In(c.Location())
+	return c.workingDays[day.Weekday()] && !c.holidays[day.Format(DateLayout)]
+}
+
+// Days returns the start of every project day between start and end, both included
+func (c WorkingCalendar) Days(start, end time.Time) []time.Time {
+	var days []time.Time
+	last := c.StartOfDay(end)
+	for day := c.StartOfDay(start); !day.After(last); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	return days
+}
+
+// WorkingDaysBetween returns the start of every working day between start and end, both included
+func (c WorkingCalendar) WorkingDaysBetween(start, end time.Time) []time.Time {
+	var days []time.Time
+	for _, day := range c.Days(start, end) {
+		if c.IsWorkingDay(day) {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+// CountWorkingDays counts the working days between start and end, both included
+func (c WorkingCalendar) CountWorkingDays(start, end time.Time) int {
+	return len(c.WorkingDaysBetween(start, end))
+}
+
+// WorkingHoursBetween returns how many of the hours from start to end fall on working days
+func (c WorkingCalendar) WorkingHoursBetween(start, end time.Time) float64 {
+	var hours float64
+	for t := start.In(c.Location()); t.Before(end); {
+		next := c.StartOfDay(t).AddDate(0, 0, 1)
+		if next.After(end) {
+			next = end
+		}
+		if c.IsWorkingDay(t) {
+			hours += next.Sub(t).Hours()
+		}
+		t = next
+	}
+	return hours
+}
+
+// AddWorkingDays returns the time reached after spending the given working days from start,
+// skipping days off; a fraction of a day is a fraction of its 24 hours
+func (c WorkingCalendar) AddWorkingDays(start time.Time, days float64) time.Time {
+	t := start.In(c.Location())
+	// A calendar without working days left in the horizon stops where it is
+	for skipped := 0; days > 0 && skipped < 366; {
+		next := c.StartOfDay(t).AddDate(0, 0, 1)
+		if !c.IsWorkingDay(t) {
+			t = next
+			skipped++
+			continue
+		}
+		skipped = 0
+
+		left := next.Sub(t).Hours() / 24
+		if days <= left {
+			return t.Add(time.Duration(days * float64(24*time.Hour)))
+		}
+		days -= left
+		t = next
+	}
+	return t
+}
@@ -0,0 +1,173 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+func TestNewWorkingCalendar(t *testing.T) {
+	tests := []struct {
+		name        string
+		timezone    string
+		workingDays []time.Weekday
+		holidays    []Holiday
+		wantErr     error
+	}{
+		{name: "valid", timezone: "America/Sao_Paulo", workingDays: weekdays, holidays: []Holiday{{Date: "2026-12-25", Name: "Natal"}}},
+		{name: "empty timezone", timezone: "", workingDays: weekdays, wantErr: ErrInvalidTimezone},
+		{name: "unknown timezone", timezone: "Mars/Olympus", workingDays: weekdays, wantErr: ErrInvalidTimezone},
+		{name: "no working days", timezone: "UTC", wantErr: ErrInvalidWorkingDays},
+		{name: "weekday listed twice", timezone: "UTC", workingDays: []time.Weekday{time.Monday, time.Monday}, wantErr: ErrInvalidWorkingDays},
+		{name: "weekday out of range", timezone: "UTC", workingDays: []time.Weekday{7}, wantErr: ErrInvalidWorkingDays},
+		{name: "holiday without a date", timezone: "UTC", workingDays: weekdays, holidays: []Holiday{{Name: "?"}}, wantErr: ErrInvalidHoliday},
+		{name: "holiday in another layout", timezone: "UTC", workingDays: weekdays, holidays: []Holiday{{Date: "25/12/2026"}}, wantErr: ErrInvalidHoliday},
+		{
+			name:        "holiday listed twice",
+			timezone:    "UTC",
+			workingDays: weekdays,
+			holidays:    []Holiday{{Date: "2026-12-25"}, {Date: "2026-12-25"}},
+			wantErr:     ErrInvalidHoliday,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewWorkingCalendar(uuid.New(), tt.timezone, tt.workingDays, tt.holidays)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("got %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.Location().String() != tt.timezone || c.Holidays == nil {
+				t.Errorf("got location %v and holidays %v", c.Location(), c.Holidays)
+			}
+		})
+	}
+}
+
+func TestWorkingCalendarDays(t *testing.T) {
+	saoPaulo := mustCalendar(t, "America/Sao_Paulo", Holiday{Date: "2026-04-03", Name: "Sexta-feira Santa"})
+	tokyo := mustCalendar(t, "Asia/Tokyo")
+
+	// Monday 2026-03-30 to Sunday 2026-04-05 in São Paulo, UTC-3
+	start := time.Date(2026, time.March, 30, 3, 0, 0, 0, time.UTC)
+	end := time.Date(2026, time.April, 6, 2, 59, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		calendar WorkingCalendar
+		at       time.Time
+		working  bool
+	}{
+		{name: "weekday", calendar: saoPaulo, at: start, working: true},
+		{name: "holiday", calendar: saoPaulo, at: time.Date(2026, time.April, 3, 12, 0, 0, 0, time.UTC), working: false},
+		{name: "early Saturday in UTC is still Friday in São Paulo", calendar: saoPaulo, at: time.Date(2026, time.April, 11, 2, 0, 0, 0, time.UTC), working: true},
+		{name: "Friday afternoon in UTC is Saturday in Tokyo", calendar: tokyo, at: time.Date(2026, time.April, 10, 16, 0, 0, 0, time.UTC), working: false},
+		{name: "Sunday night in UTC is Monday in Tokyo", calendar: tokyo, at: time.Date(2026, time.April, 12, 16, 0, 0, 0, time.UTC), working: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.calendar.IsWorkingDay(tt.at); got != tt.working {
+				t.Errorf("IsWorkingDay(%v) = %v", tt.at, got)
+			}
+		})
+	}
+
+	if got := len(saoPaulo.Days(start, end)); got != 7 {
+		t.Errorf("Days = %d, want 7", got)
+	}
+	days := saoPaulo.WorkingDaysBetween(start, end)
+	if len(days) != 4 || saoPaulo.CountWorkingDays(start, end) != 4 {
+		t.Fatalf("WorkingDaysBetween = %v, want Monday to Thursday", days)
+	}
+	if first := days[0]; first.Location() != saoPaulo.Location() || first.Hour() != 0 || first.Day() != 30 {
+		t.Errorf("first working day starts at %v, want midnight of March 30 in São Paulo", first)
+	}
+}
+
+func TestWorkingCalendarAddWorkingDays(t *testing.T) {
+	c := mustCalendar(t, "UTC", Holiday{Date: "2026-04-06", Name: "Easter Monday"})
+	friday := time.Date(2026, time.April, 3, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		days float64
+		want time.Time
+	}{
+		{name: "nothing to spend", days: 0, want: friday},
+		{name: "rest of the day", days: 0.5, want: time.Date(2026, time.April, 4, 0, 0, 0, 0, time.UTC)},
+		{name: "over the weekend and the holiday", days: 1, want: time.Date(2026, time.April, 7, 12, 0, 0, 0, time.UTC)},
+		{name: "several days", days: 3.25, want: time.Date(2026, time.April, 9, 18, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.AddWorkingDays(friday, tt.days); !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// A calendar without working days gives up after a year instead of looping forever
+	never := mustCalendar(t, "UTC")
+	never.workingDays = map[time.Weekday]bool{}
+	if got := never.AddWorkingDays(friday, 1); got.Before(friday) || got.Sub(friday) > 400*24*time.Hour {
+		t.Errorf("calendar without working days stopped at %v", got)
+	}
+}
+
+func TestWorkingCalendarWorkingHoursBetween(t *testing.T) {
+	saoPaulo := mustCalendar(t, "America/Sao_Paulo", Holiday{Date: "2026-04-03", Name: "Sexta-feira Santa"})
+	// Wall clock hours in São Paulo, handed over in UTC like the task timestamps
+	local := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2026, month, day, hour, 0, 0, 0, saoPaulo.Location()).UTC()
+	}
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       float64
+	}{
+		{name: "same day", start: local(time.March, 30, 9), end: local(time.March, 30, 17), want: 8},
+		{name: "overnight", start: local(time.March, 30, 18), end: local(time.March, 31, 10), want: 16},
+		{name: "Friday evening to early Monday", start: local(time.April, 10, 20), end: local(time.April, 13, 1), want: 5},
+		{name: "over the weekend", start: local(time.April, 10, 12), end: local(time.April, 13, 12), want: 24},
+		{name: "over the holiday", start: local(time.April, 2, 12), end: local(time.April, 6, 12), want: 24},
+		{name: "within the weekend", start: local(time.April, 11, 8), end: local(time.April, 12, 20), want: 0},
+		{name: "end before start", start: local(time.March, 31, 9), end: local(time.March, 30, 9), want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := saoPaulo.WorkingHoursBetween(tt.start, tt.end); got != tt.want {
+				t.Errorf("got %v working hours, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultWorkingCalendar(t *testing.T) {
+	c := DefaultWorkingCalendar(uuid.New())
+	if c.Location() != time.UTC || len(c.WorkingDays) != 5 || len(c.Holidays) != 0 {
+		t.Errorf("got %+v", c)
+	}
+	if (WorkingCalendar{}).Location() != time.UTC {
+		t.Error("a zero calendar should measure days in UTC")
+	}
+}
+
+func mustCalendar(t *testing.T, timezone string, holidays ...Holiday) WorkingCalendar {
+	t.Helper()
+	c, err := NewWorkingCalendar(uuid.New(), timezone, weekdays, holidays)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
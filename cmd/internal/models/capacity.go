@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemberAvailability is the time a member can give to an iteration
+// DaysOff are dates within the iteration the member does not work
+type MemberAvailability struct {
+	Member      Member      `json:"member"`
+	HoursPerDay float64     `json:"hours_per_day"`
+	DaysOff     []time.Time `json:"days_off"`
+}
+
+// IterationCapacity compares the work planned in an iteration with the time the team has for it
+// PointsPerHour is the actual speed of the last iterations closed before this one and turns hours into points;
+// it is 0 when those iterations have no logged time, and then no capacity in points is given
+type IterationCapacity struct {
+	IterationID          uuid.UUID         `json:"iterationId"`
+	WorkingDays          int               `json:"workingDays"`
+	PointsPerHour        float64           `json:"pointsPerHour"`
+	HistoricalIterations int               `json:"historicalIterations"`
+	Team                 CapacitySummary   `json:"team"`
+	Members              []MemberCapacity  `json:"members"`
+	Unassigned           CapacityPlanned   `json:"unassigned"`
+	Warnings             []CapacityWarning `json:"warnings"`
+}
+
+// CapacityPlanned is the expected time and points of the top-level tasks planned in the iteration
+type CapacityPlanned struct {
+	Tasks         int     `json:"tasks"`
+	PlannedHours  float64 `json:"plannedHours"`
+	PlannedPoints int     `json:"plannedPoints"`
+}
+
+// CapacitySummary sets the planned work against the available hours and points
+type CapacitySummary struct {
+	CapacityPlanned
+	CapacityHours  float64 `json:"capacityHours"`
+	CapacityPoints float64 `json:"capacityPoints"`
+	Overcommitted  bool    `json:"overcommitted"`
+}
+
+// MemberCapacity is the capacity of one member; members with planned work but no recorded
+// availability have no capacity at all
+type MemberCapacity struct {
+	Member        Member      `json:"member"`
+	HoursPerDay   float64     `json:"hoursPerDay"`
+	DaysOff       []time.Time `json:"daysOff"`
+	AvailableDays int         `json:"availableDays"`
+	CapacitySummary
+}
+
+// CapacityWarning points at an overcommitment of the team or of one member
+type CapacityWarning struct {
+	MemberID *uuid.UUID `json:"memberId,omitempty"` // Nil for the whole team
+	Message  string     `json:"message"`
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CommentTargetType string
+
+const (
+	CommentOnTask        CommentTargetType = "task"
+	CommentOnBug         CommentTargetType = "bug"
+	CommentOnImprovement CommentTargetType = "improvement"
+	CommentOnAction      CommentTargetType = "action"
+)
+
+// Comment is a markdown message on a task, bug, improvement or action
+// Replies hold the comments whose ParentID is this comment, oldest first
+// A deleted comment keeps its place in the thread with an empty body so the replies stay readable
+type Comment struct {
+	ID         uuid.UUID         `json:"id"`
+	ProjectID  uuid.UUID         `json:"project_id"`
+	TargetType CommentTargetType `json:"target_type"`
+	TargetID   uuid.UUID         `json:"target_id"`
+	ParentID   *uuid.UUID        `json:"parent_id,omitempty"`
+	Author     *Member           `json:"author,omitempty"` // Nil once the author account is deleted
+	Body       string            `json:"body"`
+	Mentions   []Member          `json:"mentions,omitempty"`
+	Deleted    bool              `json:"deleted"`
+	EditedAt   *time.Time        `json:"edited_at,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+	Replies    []Comment         `json:"replies,omitempty"`
+}
+
+// BuildCommentThreads nests a flat list of comments, sorted oldest first, under their parents
+// Comments whose parent is not in the list are returned at the top level
+func BuildCommentThreads(comments []Comment) []Comment {
+	children := make(map[uuid.UUID][]int)
+	present := make(map[uuid.UUID]bool, len(comments))
+	for _, c := range comments {
+		present[c.ID] = true
+	}
+
+	var roots []int
+	for i, c := range comments {
+		if c.ParentID != nil && present[*c.ParentID] {
+			children[*c.ParentID] = append(children[*c.ParentID], i)
+			continue
+		}
+		roots = append(roots, i)
+	}
+
+	var build func(i int) Comment
+	build = func(i int) Comment {
+		c := comments[i]
+		for _, child := range children[c.ID] {
+			c.Replies = append(c.Replies, build(child))
+		}
+		return c
+	}
+
+	threads := make([]Comment, 0, len(roots))
+	for _, i := range roots {
+		threads = append(threads, build(i))
+	}
+	return threads
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProjectDashboard gathers everything the project home page shows in a single response
+type ProjectDashboard struct {
+	Project          DashboardProject     `json:"project"`
+	CurrentIteration *DashboardIteration  `json:"current_iteration,omitempty"`
+	Indicators       []DashboardIndicator `json:"indicators"`
+	Actions          DashboardActions     `json:"actions"`
+	Bugs             DashboardCount       `json:"bugs"`
+	Improvements     DashboardCount       `json:"improvements"`
+	RecentActivity   []ActivityEntry      `json:"recent_activity"`
+}
+
+type DashboardProject struct {
+	ID              uuid.UUID `json:"id"`
+	Name            string    `json:"name"`
+	Description     string    `json:"description"`
+	Color           string    `json:"color"`
+	MembersCount    int       `json:"members_count"`
+	IterationsCount int       `json:"iterations_count"`
+}
+
+// DashboardIteration is the iteration running today, or the latest one that already started
+type DashboardIteration struct {
+	ID              uuid.UUID `json:"id"`
+	Number          int       `json:"number"`
+	Description     string    `json:"description"`
+	StartAt         time.Time `json:"start_at"`
+	EndAt           time.Time `json:"end_at"`
+	DaysLeft        int       `json:"days_left"`
+	NotStarted      int       `json:"not_started"`
+	InProgress      int       `json:"in_progress"`
+	Completed       int       `json:"completed"`
+	TotalPoints     int       `json:"total_points"`
+	CompletedPoints int       `json:"completed_points"`
+}
+
+// DashboardIndicator is the latest stored value of an indicator with its classification
+type DashboardIndicator struct {
+	IndicatorType     IndicatorEnum    `json:"indicator_type"`
+	IterationID       uuid.UUID        `json:"iteration_id"`
+	IterationNumber   int              `json:"iteration_number"`
+	Value             float64          `json:"value"`
+	ProductivityLevel ProductivityEnum `json:"productivity_level,omitempty"`
+}
+
+// DashboardActions counts the actions not completed yet and lists the overdue ones
+type DashboardActions struct {
+	Open    int               `json:"open"`
+	Overdue int               `json:"overdue"`
+	Items   []DashboardAction `json:"overdue_actions"`
+}
+
+type DashboardAction struct {
+	ID            uuid.UUID     `json:"id"`
+	Description   string        `json:"description"`
+	IndicatorType IndicatorEnum `json:"indicator_type"`
+	Status        StatusEnum    `json:"status"`
+	EndAt         time.Time     `json:"end_at"`
+	Assignee      *Member       `json:"assignee,omitempty"`
+}
+
+// DashboardCount is a project-wide total and the part of it in the current iteration
+type DashboardCount struct {
+	Total            int `json:"total"`
+	CurrentIteration int `json:"current_iteration"`
+}
+
+// ActivityEntry is one recent change in the project
+// Type is one of task_created, task_status_changed, bug_created, improvement_created or action_created
+type ActivityEntry struct {
+	Type       string      `json:"type"`
+	EntityID   uuid.UUID   `json:"entity_id"`
+	Title      string      `json:"title"`
+	FromStatus *StatusEnum `json:"from_status,omitempty"`
+	ToStatus   *StatusEnum `json:"to_status,omitempty"`
+	Actor      *Member     `json:"actor,omitempty"`
+	At         time.Time   `json:"at"`
+}
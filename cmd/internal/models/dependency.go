@@ -0,0 +1,42 @@
+package models
+
+import "github.com/google/uuid"
+
+// TaskRef is the short form of a task shown on the other end of a dependency
+type TaskRef struct {
+	ID          uuid.UUID  `json:"id"`
+	IterationID *uuid.UUID `json:"iteration_id"` // Nil for backlog tasks
+	Name        string     `json:"name"`
+	Status      StatusEnum `json:"status"`
+}
+
+// DependencyGraph holds the tasks of an iteration and the "blocks" edges between them
+// Tasks of other iterations linked to the iteration show up as external nodes
+type DependencyGraph struct {
+	IterationID uuid.UUID        `json:"iteration_id"`
+	Nodes       []DependencyNode `json:"nodes"`
+	Edges       []DependencyEdge `json:"edges"`
+}
+
+type DependencyNode struct {
+	TaskRef
+	ParentTaskID *uuid.UUID `json:"parent_task_id,omitempty"`
+	Blocked      bool       `json:"blocked"`
+	External     bool       `json:"external"` // Belongs to another iteration or to the backlog
+}
+
+// DependencyEdge reads as BlockerID blocks BlockedID
+type DependencyEdge struct {
+	BlockerID uuid.UUID `json:"blocker_id"`
+	BlockedID uuid.UUID `json:"blocked_id"`
+}
+
+// HasUnfinished reports whether any of the tasks is not completed yet
+func HasUnfinished(refs []TaskRef) bool {
+	for _, ref := range refs {
+		if ref.Status != StatusCompleted {
+			return true
+		}
+	}
+	return false
+}
@@ -29,6 +29,12 @@ const (
 	IndicatorReworkPerIteration IndicatorEnum = "ReworkPerIteration"
 	// IndicatorInstabilityIndex measures improvement ratio (improvements/tasks)
 	IndicatorInstabilityIndex IndicatorEnum = "InstabilityIndex"
+	// IndicatorLeadTime measures the working hours from task creation to completion
+	IndicatorLeadTime IndicatorEnum = "LeadTime"
+	// IndicatorCycleTime measures the working hours from the first InProgress to completion
+	IndicatorCycleTime IndicatorEnum = "CycleTime"
+	// IndicatorEstimateAccuracy measures the mean absolute percentage error between expected and logged time
+	IndicatorEstimateAccuracy IndicatorEnum = "EstimateAccuracy"
 )
 
 // AllIndicatorTypes returns all available indicator types
@@ -37,9 +43,22 @@ func AllIndicatorTypes() []IndicatorEnum {
 		IndicatorSpeedPerIteration,
 		IndicatorReworkPerIteration,
 		IndicatorInstabilityIndex,
+		IndicatorLeadTime,
+		IndicatorCycleTime,
+		IndicatorEstimateAccuracy,
 	}
 }
 
+// IsValidIndicatorType reports whether the value is one of the indicator types
+func IsValidIndicatorType(indicatorType IndicatorEnum) bool {
+	for _, t := range AllIndicatorTypes() {
+		if t == indicatorType {
+			return true
+		}
+	}
+	return false
+}
+
 // StatusEnum represents the status of a task
 type StatusEnum string
 
@@ -48,3 +67,46 @@ const (
 	StatusInProgress StatusEnum = "InProgress"
 	StatusCompleted  StatusEnum = "Completed"
 )
+
+// AllStatuses returns all available task statuses
+func AllStatuses() []StatusEnum {
+	return []StatusEnum{
+		StatusNotStarted,
+		StatusInProgress,
+		StatusCompleted,
+	}
+}
+
+// IsValidStatus reports whether the value is one of the task statuses
+func IsValidStatus(status StatusEnum) bool {
+	for _, s := range AllStatuses() {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// ProjectRoleEnum represents the role of a member in a project
+type ProjectRoleEnum string
+
+const (
+	RoleOwner      ProjectRoleEnum = "owner"
+	RoleMaintainer ProjectRoleEnum = "maintainer"
+	RoleMember     ProjectRoleEnum = "member"
+)
+
+// IsValidProjectRole reports whether the value is one of the project roles
+func IsValidProjectRole(role ProjectRoleEnum) bool {
+	return role == RoleOwner || role == RoleMaintainer || role == RoleMember
+}
+
+// CanViewMemberAnalysis reports whether the role gives access to per-member productivity data
+func (r ProjectRoleEnum) CanViewMemberAnalysis() bool {
+	return r == RoleOwner || r == RoleMaintainer
+}
+
+// CanManageProject reports whether the role may change project settings and other members' content
+func (r ProjectRoleEnum) CanManageProject() bool {
+	return r == RoleOwner || r == RoleMaintainer
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CumulativeFlowResponse holds, for every day of an iteration, how many tasks
+// (and points) were in each status at the end of the day
+type CumulativeFlowResponse struct {
+	IterationID uuid.UUID      `json:"iterationId"`
+	XAxis       AxisDefinition `json:"xAxis"`
+	YAxis       AxisDefinition `json:"yAxis"`
+	Series      []StatusSeries `json:"series"`
+}
+
+// StatusSeries is the daily count and points of the tasks in one status
+type StatusSeries struct {
+	Status StatusEnum  `json:"status"`
+	Counts []DataPoint `json:"counts"`
+	Points []DataPoint `json:"points"`
+}
+
+// ThroughputInterval is the bucket size used by the throughput series
+type ThroughputInterval string
+
+const (
+	ThroughputDaily  ThroughputInterval = "day"
+	ThroughputWeekly ThroughputInterval = "week"
+)
+
+// ThroughputResponse holds the completed tasks and points per day or week across the iterations of a project
+type ThroughputResponse struct {
+	ProjectID       uuid.UUID          `json:"projectId"`
+	Interval        ThroughputInterval `json:"interval"`
+	XAxis           AxisDefinition     `json:"xAxis"`
+	YAxis           AxisDefinition     `json:"yAxis"`
+	CompletedTasks  []DataPoint        `json:"completedTasks"`
+	CompletedPoints []DataPoint        `json:"completedPoints"`
+}
+
+// TaskCompletion is the moment a task was completed, used to count throughput
+type TaskCompletion struct {
+	TaskID      uuid.UUID `json:"task_id"`
+	IterationID uuid.UUID `json:"iteration_id"`
+	Points      int       `json:"points"`
+	CompletedAt time.Time `json:"completed_at"`
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ForecastMode is the unit the forecast works with
+type ForecastMode string
+
+const (
+	ForecastPoints ForecastMode = "points"
+	ForecastTasks  ForecastMode = "tasks"
+)
+
+// ForecastConfidenceLevels are the confidence levels returned by every forecast
+func ForecastConfidenceLevels() []int {
+	return []int{50, 85, 95}
+}
+
+// ForecastRequest describes a Monte Carlo forecast: either how long a backlog will take
+// (BacklogSize) or how much can be done until a date (TargetDate)
+// SampleWindow limits the history to the last N closed iterations (0 means all of them)
+// The same Seed always gives the same result for the same history
+type ForecastRequest struct {
+	Mode         ForecastMode
+	BacklogSize  *float64
+	TargetDate   *time.Time
+	SampleWindow int
+	Seed         *int64
+	Simulations  int
+}
+
+// ForecastResponse holds the outcome of the simulations for each confidence level
+// CompletionDates is filled for backlog forecasts and Scope for target date forecasts
+type ForecastResponse struct {
+	ProjectID         uuid.UUID        `json:"projectId"`
+	Mode              ForecastMode     `json:"mode"`
+	Simulations       int              `json:"simulations"`
+	Seed              int64            `json:"seed"`
+	SampledIterations []ForecastSample `json:"sampledIterations"`
+	BacklogSize       *float64         `json:"backlogSize,omitempty"`
+	TargetDate        *time.Time       `json:"targetDate,omitempty"`
+	CompletionDates   []ForecastDate   `json:"completionDates,omitempty"`
+	Scope             []ForecastScope  `json:"scope,omitempty"`
+}
+
+// ForecastSample is the delivery of one closed iteration used as history
+type ForecastSample struct {
+	IterationID uuid.UUID `json:"iterationId"`
+	Number      int       `json:"number"`
+	Delivered   float64   `json:"delivered"`
+	Days        float64   `json:"days"`
+}
+
+// ForecastDate is the date by which the backlog is done with the given confidence
+// Reached is false when the simulations did not finish the backlog within the simulation horizon
+type ForecastDate struct {
+	Confidence int       `json:"confidence"`
+	Date       time.Time `json:"date"`
+	Iterations float64   `json:"iterations"`
+	Reached    bool      `json:"reached"`
+}
+
+// ForecastScope is the amount of work done by the target date with the given confidence
+type ForecastScope struct {
+	Confidence int     `json:"confidence"`
+	Value      float64 `json:"value"`
+}
@@ -13,6 +13,7 @@ type Improv struct {
 	Number      int       `json:"number"`
 	Description string    `json:"description"`
 	Points      int       `json:"points"`
+	Labels      []Label   `json:"labels,omitempty"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
@@ -32,6 +32,25 @@ type Indicator struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// SetMetricValues derives the stored metric values from the counts of an iteration
+// Speed is measured per working day of the project calendar
+func (i *Indicator) SetMetricValues(totalTasks, completedTasks, bugs, improvements, workingDays int) {
+	i.SpeedValue, i.ReworkValue, i.InstabilityValue = 0, 0, 0
+
+	if workingDays > 0 {
+		i.SpeedValue = float64(completedTasks) / float64(workingDays)
+	}
+
+	if totalTasks > 0 {
+		i.ReworkValue = float64(bugs) / float64(totalTasks)
+	}
+
+	// Instability index: improvements per task
+	if totalTasks > 0 {
+		i.InstabilityValue = float64(improvements) / float64(totalTasks)
+	}
+}
+
 // CalculateProductivityLevels computes the productivity level for each indicator
 // based on the provided project-level ranges
 func (i *Indicator) CalculateProductivityLevels(ranges []IndicatorRange) {
@@ -7,7 +7,7 @@ import (
 )
 
 // IndicatorRange represents the productivity ranges for a specific indicator type within a project
-// Each project has separate ranges for SpeedPerIteration, ReworkPerIteration, and InstabilityIndex
+// Each project has separate ranges for every IndicatorEnum (see AllIndicatorTypes)
 // These ranges are set at project creation and apply to all iterations
 type IndicatorRange struct {
 	ID            uuid.UUID         `json:"id"`
@@ -18,3 +18,44 @@ type Iteration struct {
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+// IsClosed reports whether the iteration had already ended at the given time
+func (it Iteration) IsClosed(at time.Time) bool {
+	return it.EndAt.Before(at)
+}
+
+// In returns the iteration with its dates in the given location
+func (it Iteration) In(loc *time.Location) Iteration {
+	it.StartAt = it.StartAt.In(loc)
+	it.EndAt = it.EndAt.In(loc)
+	it.CreatedAt = it.CreatedAt.In(loc)
+	it.UpdatedAt = it.UpdatedAt.In(loc)
+	return it
+}
+
+// IterationErrorCode tells clients why an iteration was rejected
+type IterationErrorCode string
+
+const (
+	IterationInvalidDateRange IterationErrorCode = "invalid_date_range"
+	IterationOverlap          IterationErrorCode = "overlapping_iteration"
+	IterationNoPrevious       IterationErrorCode = "no_previous_iteration"
+	IterationNotGenerated     IterationErrorCode = "generation_failed"
+)
+
+// IterationError is a rejected iteration; Conflicts lists the iterations it overlaps
+type IterationError struct {
+	Code      IterationErrorCode `json:"code"`
+	Message   string             `json:"message"`
+	Conflicts []Iteration        `json:"conflicts,omitempty"`
+}
+
+func (e *IterationError) Error() string {
+	return e.Message
+}
+
+// DuplicateIterationNumber is a number held by more than one iteration of a project
+type DuplicateIterationNumber struct {
+	ProjectID  uuid.UUID   `json:"project_id"`
+	Number     int         `json:"number"`
+	Iterations []Iteration `json:"iterations"`
+}
@@ -8,12 +8,14 @@ type IterationAnalysisResponse struct {
 }
 
 type IndicatorAnalysisData struct {
-	IndicatorType string         `json:"indicatorType"`
-	XAxis         AxisDefinition `json:"xAxis"`
-	YAxis         AxisDefinition `json:"yAxis"`
-	Points        []DataPoint    `json:"points"`
-	Summary       *SpeedSummary  `json:"summary,omitempty"`
-	Values        *SpeedValues   `json:"values,omitempty"`
+	IndicatorType string            `json:"indicatorType"`
+	XAxis         AxisDefinition    `json:"xAxis"`
+	YAxis         AxisDefinition    `json:"yAxis"`
+	Points        []DataPoint       `json:"points"`
+	Summary       *SpeedSummary     `json:"summary,omitempty"`
+	Values        *SpeedValues      `json:"values,omitempty"`
+	Percentiles   *TimePercentiles  `json:"percentiles,omitempty"`
+	Accuracy      *EstimateAccuracy `json:"accuracy,omitempty"`
 }
 
 type AxisDefinition struct {
@@ -37,3 +39,107 @@ type SpeedValues struct {
 	ExpectedSpeed float64 `json:"expectedSpeed"`
 	ActualSpeed   float64 `json:"actualSpeed"`
 }
+
+// TimePercentiles summarizes a duration indicator (in hours) over the completed tasks of an iteration
+// Status classifies P85 with the project range, so most tasks are covered by the classification
+type TimePercentiles struct {
+	Count   int              `json:"count"`
+	Average float64          `json:"average"`
+	P50     float64          `json:"p50"`
+	P85     float64          `json:"p85"`
+	P95     float64          `json:"p95"`
+	Status  ProductivityEnum `json:"status,omitempty"`
+}
+
+// EstimateAccuracy compares the logged time of completed tasks with their expected time
+// Ratio is actual/expected, MAPE is the mean absolute percentage error and Bias the mean
+// signed percentage error: a positive bias means tasks take longer than estimated
+type EstimateAccuracy struct {
+	Count      int                     `json:"count"`
+	MeanRatio  float64                 `json:"meanRatio"`
+	MAPE       float64                 `json:"mape"`
+	Bias       float64                 `json:"bias"`
+	Direction  EstimateDirection       `json:"direction"`
+	Status     ProductivityEnum        `json:"status,omitempty"`
+	ByAssignee []EstimateAccuracyGroup `json:"byAssignee"`
+	ByPoints   []EstimateAccuracyGroup `json:"byPoints"`
+}
+
+// EstimateAccuracyGroup is the estimate accuracy of a subset of tasks, such as the tasks of
+// one assignee or the tasks of one points size
+type EstimateAccuracyGroup struct {
+	Key       string            `json:"key"`
+	Label     string            `json:"label"`
+	Count     int               `json:"count"`
+	MeanRatio float64           `json:"meanRatio"`
+	MAPE      float64           `json:"mape"`
+	Bias      float64           `json:"bias"`
+	Direction EstimateDirection `json:"direction"`
+}
+
+// EstimateDirection tells whether estimates are usually too low or too high
+type EstimateDirection string
+
+const (
+	EstimateUnder    EstimateDirection = "Under"
+	EstimateOver     EstimateDirection = "Over"
+	EstimateAccurate EstimateDirection = "Accurate"
+)
+
+// EstimateAccuracyTrend holds the estimate accuracy of each iteration of a project, ordered by iteration number
+type EstimateAccuracyTrend struct {
+	ProjectID uuid.UUID      `json:"projectId"`
+	XAxis     AxisDefinition `json:"xAxis"`
+	YAxis     AxisDefinition `json:"yAxis"`
+	MAPE      []DataPoint    `json:"mape"`
+	Bias      []DataPoint    `json:"bias"`
+}
+
+// MemberAnalysisResponse breaks the iteration indicators down per task assignee
+type MemberAnalysisResponse struct {
+	IterationID uuid.UUID        `json:"iterationId"`
+	GroupBy     string           `json:"groupBy"`
+	Members     []MemberAnalysis `json:"members"`
+}
+
+// MemberAnalysis holds the indicators of the tasks assigned to one member
+// Rework and instability are bug and improvement points per completed task, like the team indicators
+// PointsShare and TimeShare are percentages of the team's completed points and logged hours
+type MemberAnalysis struct {
+	Assignee          Member           `json:"assignee"`
+	CompletedTasks    int              `json:"completedTasks"`
+	CompletedPoints   int              `json:"completedPoints"`
+	PointsShare       float64          `json:"pointsShare"`
+	LoggedHours       float64          `json:"loggedHours"`
+	TimeShare         float64          `json:"timeShare"`
+	Speed             SpeedValues      `json:"speed"`
+	SpeedStatus       ProductivityEnum `json:"speedStatus,omitempty"`
+	Rework            float64          `json:"rework"`
+	ReworkStatus      ProductivityEnum `json:"reworkStatus,omitempty"`
+	Instability       float64          `json:"instability"`
+	InstabilityStatus ProductivityEnum `json:"instabilityStatus,omitempty"`
+}
+
+// LabelAnalysisResponse breaks the iteration indicators down per label
+type LabelAnalysisResponse struct {
+	IterationID uuid.UUID       `json:"iterationId"`
+	GroupBy     string          `json:"groupBy"`
+	Labels      []LabelAnalysis `json:"labels"`
+}
+
+// LabelAnalysis holds the indicators of the tasks carrying one label
+// A task with several labels counts under each of them; Label is nil for unlabeled tasks
+// Bugs and improvements count under their own labels, or under the labels of their task when they have none
+type LabelAnalysis struct {
+	Label             *Label           `json:"label"`
+	Tasks             int              `json:"tasks"`
+	CompletedTasks    int              `json:"completedTasks"`
+	CompletedPoints   int              `json:"completedPoints"`
+	PointsShare       float64          `json:"pointsShare"`
+	BugPoints         float64          `json:"bugPoints"`
+	ImprovementPoints float64          `json:"improvementPoints"`
+	Rework            float64          `json:"rework"`
+	ReworkStatus      ProductivityEnum `json:"reworkStatus,omitempty"`
+	Instability       float64          `json:"instability"`
+	InstabilityStatus ProductivityEnum `json:"instabilityStatus,omitempty"`
+}
@@ -0,0 +1,125 @@
+package models
+
+import "errors"
+
+var (
+	ErrCursorWithSort = errors.New("cursor cannot be combined with sort")
+)
+
+// FilterOp is the comparison applied by a list filter
+type FilterOp string
+
+const (
+	FilterEq  FilterOp = "eq"
+	FilterIn  FilterOp = "in"
+	FilterGte FilterOp = "gte"
+	FilterLte FilterOp = "lte"
+	FilterGt  FilterOp = "gt"
+	FilterLt  FilterOp = "lt"
+)
+
+// FilterType tells how the values of a list field are parsed
+type FilterType int
+
+const (
+	FilterString FilterType = iota
+	FilterUUID
+	FilterInt
+	FilterTime
+	FilterStatus
+)
+
+// ListField is a field a list endpoint can filter or sort on
+// Ranged fields accept the _gte, _lte, _gt and _lt suffixes
+type ListField struct {
+	Name       string
+	Type       FilterType
+	Filterable bool
+	Ranged     bool
+	Sortable   bool
+}
+
+// ListSpec describes the filtering grammar of a list endpoint
+type ListSpec struct {
+	Fields     []ListField
+	Searchable bool
+}
+
+// FilterCondition is one parsed filter; Values hold the typed values (uuid.UUID, int, time.Time, string or StatusEnum)
+type FilterCondition struct {
+	Field  string
+	Op     FilterOp
+	Values []interface{}
+}
+
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// ListQuery is the parsed filtering, sorting and search of a list request
+type ListQuery struct {
+	Filters []FilterCondition
+	Sort    []SortField
+	Search  string
+}
+
+var TaskListSpec = ListSpec{
+	Fields: []ListField{
+		{Name: "status", Type: FilterStatus, Filterable: true, Sortable: true},
+		{Name: "assignee_id", Type: FilterUUID, Filterable: true},
+		{Name: "label", Type: FilterString, Filterable: true},
+		{Name: "label_id", Type: FilterUUID, Filterable: true},
+		{Name: "points", Type: FilterInt, Filterable: true, Ranged: true, Sortable: true},
+		{Name: "name", Type: FilterString, Sortable: true},
+		{Name: "rank", Type: FilterString, Sortable: true},
+		{Name: "created_at", Type: FilterTime, Filterable: true, Ranged: true, Sortable: true},
+		{Name: "updated_at", Type: FilterTime, Filterable: true, Ranged: true, Sortable: true},
+	},
+	Searchable: true,
+}
+
+var BugListSpec = ListSpec{
+	Fields: []ListField{
+		{Name: "assignee_id", Type: FilterUUID, Filterable: true},
+		{Name: "label", Type: FilterString, Filterable: true},
+		{Name: "label_id", Type: FilterUUID, Filterable: true},
+		{Name: "number", Type: FilterInt, Sortable: true},
+		{Name: "points", Type: FilterInt, Filterable: true, Ranged: true, Sortable: true},
+		{Name: "created_at", Type: FilterTime, Filterable: true, Ranged: true, Sortable: true},
+		{Name: "updated_at", Type: FilterTime, Filterable: true, Ranged: true, Sortable: true},
+	},
+	Searchable: true,
+}
+
+// ImprovementListSpec has the same fields as BugListSpec
+var ImprovementListSpec = BugListSpec
+
+var ProjectListSpec = ListSpec{
+	Fields: []ListField{
+		{Name: "name", Type: FilterString, Filterable: true, Sortable: true},
+		{Name: "member_id", Type: FilterUUID, Filterable: true},
+		{Name: "created_at", Type: FilterTime, Filterable: true, Ranged: true, Sortable: true},
+		{Name: "updated_at", Type: FilterTime, Filterable: true, Ranged: true, Sortable: true},
+	},
+	Searchable: true,
+}
+
+var UserListSpec = ListSpec{
+	Fields: []ListField{
+		{Name: "email", Type: FilterString, Filterable: true, Sortable: true},
+		{Name: "name", Type: FilterString, Sortable: true},
+		{Name: "created_at", Type: FilterTime, Filterable: true, Ranged: true, Sortable: true},
+	},
+	Searchable: true,
+}
+
+// Field returns the spec of the named field
+func (s ListSpec) Field(name string) (ListField, bool) {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return ListField{}, false
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationType string
+
+const (
+	NotificationMention NotificationType = "Mention"
+)
+
+// Notification tells a user about something that involves them
+// For mentions, Comment points at the comment and its target so the client can link to it
+type Notification struct {
+	ID        uuid.UUID            `json:"id"`
+	UserID    uuid.UUID            `json:"user_id"`
+	Type      NotificationType     `json:"type"`
+	Actor     *Member              `json:"actor,omitempty"`
+	Comment   *NotificationComment `json:"comment,omitempty"`
+	ReadAt    *time.Time           `json:"read_at,omitempty"`
+	CreatedAt time.Time            `json:"created_at"`
+}
+
+// NotificationComment is the part of a comment shown in a notification
+type NotificationComment struct {
+	ID         uuid.UUID         `json:"id"`
+	ProjectID  uuid.UUID         `json:"project_id"`
+	TargetType CommentTargetType `json:"target_type"`
+	TargetID   uuid.UUID         `json:"target_id"`
+	Body       string            `json:"body"`
+}
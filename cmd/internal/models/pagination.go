@@ -1,17 +1,48 @@
 package models
 
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// PaginationRequest selects a page either by number or, when Cursor is set, by keyset
+// A cursor comes from the next_cursor of a previous response and takes precedence over Page
 type PaginationRequest struct {
-	Page     int `json:"page" form:"page" validate:"min=1"`
-	PageSize int `json:"page_size" form:"page_size" validate:"min=1,max=100"`
+	Page     int    `json:"page" form:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" form:"page_size" validate:"min=1,max=100"`
+	Cursor   string `json:"cursor,omitempty" form:"cursor"`
 }
 
 type PaginationResponse struct {
-	Page       int   `json:"page"`
-	PageSize   int   `json:"page_size"`
-	Total      int64 `json:"total"`
-	TotalPages int   `json:"total_pages"`
-	HasNext    bool  `json:"has_next"`
-	HasPrev    bool  `json:"has_prev"`
+	Page       int    `json:"page"`
+	PageSize   int    `json:"page_size"`
+	Total      int64  `json:"total"`
+	TotalPages int    `json:"total_pages"`
+	HasNext    bool   `json:"has_next"`
+	HasPrev    bool   `json:"has_prev"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// Cursor is the position of the last row of a page: the value of the sort column and the row ID
+// to break ties between rows with the same value
+// Scope names the list the cursor was made for, so it cannot be replayed on another endpoint or order
+type Cursor struct {
+	Scope string    `json:"s"`
+	Key   string    `json:"k"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// CursorScope names a keyset list by its endpoint and the field it is sorted by
+func CursorScope(endpoint, sortField string) string {
+	return endpoint + ":" + sortField
 }
 
 func (p *PaginationRequest) GetOffset() int {
@@ -24,9 +55,65 @@ func (p *PaginationRequest) GetOffset() int {
 	if p.PageSize > 100 {
 		p.PageSize = 100
 	}
+	if p.Cursor != "" {
+		return 0
+	}
 	return (p.Page - 1) * p.PageSize
 }
 
+// ParseCursor returns the position encoded in the request cursor, nil when paginating by page
+// It only checks the cursor is well formed; lists read it with DecodeCursor
+func (p *PaginationRequest) ParseCursor() (*Cursor, error) {
+	if p.Cursor == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(p.Cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID == uuid.Nil || c.Scope == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
+
+// DecodeCursor is ParseCursor for the list with the given scope; a cursor made for another list is invalid
+func (p *PaginationRequest) DecodeCursor(scope string) (*Cursor, error) {
+	c, err := p.ParseCursor()
+	if err != nil || c == nil {
+		return nil, err
+	}
+	if c.Scope != scope {
+		return nil, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// Time reads the cursor key of lists sorted by a timestamp
+func (c *Cursor) Time() (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, c.Key)
+	if err != nil {
+		return time.Time{}, ErrInvalidCursor
+	}
+	return t, nil
+}
+
+// EncodeCursor makes the opaque cursor of the list with the given scope pointing after the row
+// with the given sort key and ID
+func EncodeCursor(scope, key string, id uuid.UUID) string {
+	raw, _ := json.Marshal(Cursor{Scope: scope, Key: key, ID: id})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// EncodeTimeCursor is EncodeCursor for lists sorted by a timestamp
+func EncodeTimeCursor(scope string, t time.Time, id uuid.UUID) string {
+	return EncodeCursor(scope, t.UTC().Format(time.RFC3339Nano), id)
+}
+
 func NewPaginationResponse(page, pageSize int, total int64) PaginationResponse {
 	totalPages := int((total + int64(pageSize) - 1) / int64(pageSize))
 
@@ -39,3 +126,20 @@ func NewPaginationResponse(page, pageSize int, total int64) PaginationResponse {
 		HasPrev:    page > 1,
 	}
 }
+
+// NewPagePaginationResponse builds the response for either pagination mode
+// nextCursor is empty when the page is the last one
+func NewPagePaginationResponse(pagination PaginationRequest, total int64, nextCursor string) PaginationResponse {
+	if pagination.Cursor == "" {
+		resp := NewPaginationResponse(pagination.Page, pagination.PageSize, total)
+		resp.NextCursor = nextCursor
+		return resp
+	}
+
+	// Page numbers have no meaning once a cursor is used
+	resp := NewPaginationResponse(0, pagination.PageSize, total)
+	resp.HasNext = nextCursor != ""
+	resp.HasPrev = true
+	resp.NextCursor = nextCursor
+	return resp
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	id := uuid.New()
+	at := time.Date(2026, time.March, 1, 12, 30, 0, 500, time.UTC)
+	projects := CursorScope("projects", "created_at")
+
+	tests := []struct {
+		name    string
+		cursor  string
+		scope   string
+		wantErr bool
+	}{
+		{name: "no cursor", cursor: "", scope: projects},
+		{name: "same list", cursor: EncodeTimeCursor(projects, at, id), scope: projects},
+		{name: "other endpoint", cursor: EncodeTimeCursor(CursorScope("users", "created_at"), at, id), scope: projects, wantErr: true},
+		{name: "other sort field", cursor: EncodeCursor(CursorScope("projects", "name"), "alpha", id), scope: projects, wantErr: true},
+		{name: "without scope", cursor: base64.RawURLEncoding.EncodeToString([]byte(`{"k":"x","id":"` + id.String() + `"}`)), scope: projects, wantErr: true},
+		{name: "without id", cursor: base64.RawURLEncoding.EncodeToString([]byte(`{"s":"projects:created_at","k":"x"}`)), scope: projects, wantErr: true},
+		{name: "not base64", cursor: "%%%", scope: projects, wantErr: true},
+		{name: "not json", cursor: base64.RawURLEncoding.EncodeToString([]byte("page=2")), scope: projects, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := PaginationRequest{Cursor: tt.cursor}
+			c, err := p.DecodeCursor(tt.scope)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidCursor) {
+					t.Fatalf("got error %v, want ErrInvalidCursor", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.cursor == "" {
+				if c != nil {
+					t.Fatalf("got cursor %+v without one in the request", c)
+				}
+				return
+			}
+			if c.ID != id {
+				t.Errorf("got id %v, want %v", c.ID, id)
+			}
+			got, err := c.Time()
+			if err != nil || !got.Equal(at) {
+				t.Errorf("got time %v (%v), want %v", got, err, at)
+			}
+		})
+	}
+}
+
+func TestParseCursorAcceptsAnyScope(t *testing.T) {
+	p := PaginationRequest{Cursor: EncodeCursor(CursorScope("project-users", "name"), "Ana", uuid.New())}
+	c, err := p.ParseCursor()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Scope != "project-users:name" || c.Key != "Ana" {
+		t.Errorf("got %+v", c)
+	}
+}
@@ -0,0 +1,138 @@
+package models
+
+import (
+	"errors"
+	"strings"
+)
+
+// rankDigits are the characters a rank is made of, in byte order so ranks compare like strings
+// under the C collation
+const rankDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// MaxRankLength caps how long repeated insertions at the same spot can make a rank
+// Past it the column has to be respaced with SpreadRanks
+const MaxRankLength = 32
+
+var (
+	ErrInvalidRank = errors.New("invalid rank")
+	ErrRankTooLong = errors.New("rank too long")
+)
+
+// RankBetween returns a rank sorting after prev and before next without touching either
+// An empty prev means the start of the column and an empty next its end
+// Ranks never end with the lowest digit, which keeps a free spot below every rank
+// It returns ErrRankTooLong when the rank would be longer than MaxRankLength
+func RankBetween(prev, next string) (string, error) {
+	if !validRank(prev) || !validRank(next) || (next != "" && prev >= next) {
+		return "", ErrInvalidRank
+	}
+	rank := rankAfter(prev)
+	if next != "" {
+		rank = rankMidpoint(prev, next)
+	}
+	if len(rank) > MaxRankLength {
+		return "", ErrRankTooLong
+	}
+	return rank, nil
+}
+
+// SpreadRanks returns n increasing ranks of the same, shortest possible length, evenly spaced
+// with room for a few insertions between any two of them
+func SpreadRanks(n int) []string {
+	if n <= 0 {
+		return nil
+	}
+
+	// At least three steps between two ranks, so one can move past a trailing lowest digit
+	// and still leave a spot free before the next
+	base := len(rankDigits)
+	width, space := 1, base
+	for space < 3*(n+1) {
+		width++
+		space *= base
+	}
+
+	ranks := make([]string, n)
+	digits := make([]byte, width)
+	for i := range ranks {
+		v := (i + 1) * space / (n + 1)
+		if v%base == 0 {
+			v++
+		}
+		for j := width - 1; j >= 0; j-- {
+			digits[j] = rankDigits[v%base]
+			v /= base
+		}
+		ranks[i] = string(digits)
+	}
+	return ranks
+}
+
+// rankAfter bumps the first digit that can still grow and drops the rest,
+// so appending to a column keeps ranks short
+func rankAfter(prev string) string {
+	for i := 0; i < len(prev); i++ {
+		if d := strings.IndexByte(rankDigits, prev[i]); d < len(rankDigits)-1 {
+			return prev[:i] + string(rankDigits[d+1])
+		}
+	}
+	return prev + string(rankDigits[len(rankDigits)/2])
+}
+
+// rankMidpoint returns a rank strictly between prev and next, prev < next, next not empty
+func rankMidpoint(prev, next string) string {
+	// Keep the common prefix, prev being padded with the lowest digit
+	n := 0
+	for n < len(next) && rankDigitAt(prev, n) == next[n] {
+		n++
+	}
+	if n > 0 {
+		rest := ""
+		if n < len(prev) {
+			rest = prev[n:]
+		}
+		return next[:n] + rankMidpoint(rest, next[n:])
+	}
+
+	lo := 0
+	if prev != "" {
+		lo = strings.IndexByte(rankDigits, prev[0])
+	}
+	hi := strings.IndexByte(rankDigits, next[0])
+	if hi-lo > 1 {
+		return string(rankDigits[(lo+hi+1)/2])
+	}
+
+	// Consecutive digits: next cut to one digit still sorts after prev when it is longer,
+	// otherwise keep the digit of prev and go one level deeper
+	if len(next) > 1 {
+		return next[:1]
+	}
+	rest := ""
+	if len(prev) > 1 {
+		rest = prev[1:]
+	}
+	return string(rankDigits[lo]) + rankAfter(rest)
+}
+
+func rankDigitAt(rank string, i int) byte {
+	if i < len(rank) {
+		return rank[i]
+	}
+	return rankDigits[0]
+}
+
+func validRank(rank string) bool {
+	if rank == "" {
+		return true
+	}
+	if rank[len(rank)-1] == rankDigits[0] {
+		return false
+	}
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(rankDigits, rank[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
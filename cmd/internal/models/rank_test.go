@@ -0,0 +1,158 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		name       string
+		prev, next string
+		want       string
+		wantErr    error
+	}{
+		{name: "empty column", want: "V"},
+		{name: "append bumps the first digit", prev: "0000000012V", want: "1"},
+		{name: "append after the highest digits", prev: "zz", want: "zzV"},
+		{name: "top of the column", next: "V", want: "G"},
+		{name: "wide gap", prev: "1", next: "9", want: "5"},
+		{name: "consecutive digits go deeper", prev: "1", next: "2", want: "1V"},
+		{name: "shorter next", prev: "1V", next: "2", want: "1W"},
+		{name: "longer next is cut", prev: "1", next: "2V", want: "2"},
+		{name: "common prefix", prev: "a1", next: "a3", want: "a2"},
+		{name: "prev padded with the lowest digit", prev: "a", next: "a1", want: "a0V"},
+		{name: "equal neighbours", prev: "a", next: "a", wantErr: ErrInvalidRank},
+		{name: "neighbours swapped", prev: "b", next: "a", wantErr: ErrInvalidRank},
+		{name: "trailing lowest digit", prev: "a0", wantErr: ErrInvalidRank},
+		{name: "unknown digit", prev: "a-", wantErr: ErrInvalidRank},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RankBetween(tt.prev, tt.next)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("got %q, %v, want %v", got, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if got <= tt.prev || (tt.next != "" && got >= tt.next) {
+				t.Errorf("%q is not between %q and %q", got, tt.prev, tt.next)
+			}
+		})
+	}
+}
+
+// Inserting again and again at the same spot makes ranks longer; they stay ordered and
+// RankBetween reports ErrRankTooLong instead of going past MaxRankLength
+func TestRankBetweenRepeatedInsertions(t *testing.T) {
+	tests := []struct {
+		name       string
+		prev, next string
+		insert     func(prev, next, rank string) (string, string)
+		atLeast    int
+	}{
+		{
+			name:    "top of the column",
+			next:    "2",
+			insert:  func(prev, next, rank string) (string, string) { return "", rank },
+			atLeast: 150,
+		},
+		{
+			name:    "right after the same task",
+			prev:    "1",
+			next:    "2",
+			insert:  func(prev, next, rank string) (string, string) { return prev, rank },
+			atLeast: 150,
+		},
+		{
+			name:    "right before the same task",
+			prev:    "1",
+			next:    "2",
+			insert:  func(prev, next, rank string) (string, string) { return rank, next },
+			atLeast: 900,
+		},
+		{
+			name:    "bottom of the column",
+			prev:    "1",
+			insert:  func(prev, next, rank string) (string, string) { return rank, "" },
+			atLeast: 900,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev, next := tt.prev, tt.next
+			for i := 0; i < 10000; i++ {
+				rank, err := RankBetween(prev, next)
+				if errors.Is(err, ErrRankTooLong) {
+					if i < tt.atLeast {
+						t.Fatalf("rank too long after only %d insertions", i)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("insertion %d: %v", i, err)
+				}
+				if len(rank) > MaxRankLength {
+					t.Fatalf("insertion %d: %q is longer than %d", i, rank, MaxRankLength)
+				}
+				if rank <= prev || (next != "" && rank >= next) {
+					t.Fatalf("insertion %d: %q is not between %q and %q", i, rank, prev, next)
+				}
+				prev, next = tt.insert(prev, next, rank)
+			}
+			t.Fatal("ranks never reached the length cap")
+		})
+	}
+}
+
+func TestSpreadRanks(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 19, 20, 61, 62, 500, 5000} {
+		ranks := SpreadRanks(n)
+		if len(ranks) != n {
+			t.Fatalf("n=%d: got %d ranks", n, len(ranks))
+		}
+		for i, rank := range ranks {
+			if !validRank(rank) || rank == "" {
+				t.Fatalf("n=%d: invalid rank %q", n, rank)
+			}
+			if len(rank) != len(ranks[0]) {
+				t.Fatalf("n=%d: ranks of different lengths %q and %q", n, ranks[0], rank)
+			}
+			if i > 0 && rank <= ranks[i-1] {
+				t.Fatalf("n=%d: %q does not sort after %q", n, rank, ranks[i-1])
+			}
+		}
+	}
+
+	// Respaced ranks leave room to insert anywhere without growing past one more digit
+	ranks := SpreadRanks(5000)
+	width := len(ranks[0])
+	if width > 3 {
+		t.Errorf("5000 ranks take %d digits", width)
+	}
+	for i := 0; i < len(ranks); i++ {
+		prev := ""
+		if i > 0 {
+			prev = ranks[i-1]
+		}
+		rank, err := RankBetween(prev, ranks[i])
+		if err != nil {
+			t.Fatalf("before %q: %v", ranks[i], err)
+		}
+		if len(rank) > width+1 {
+			t.Fatalf("between %q and %q: %q", prev, ranks[i], rank)
+		}
+	}
+	if rank, err := RankBetween(ranks[len(ranks)-1], ""); err != nil || len(rank) > width+1 {
+		t.Errorf("append after %q: %q, %v", ranks[len(ranks)-1], rank, err)
+	}
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Retrospective represents the retrospective held at the end of an iteration
+// Analysis is filled at read time so the discussion is grounded in the iteration numbers
+type Retrospective struct {
+	ID             uuid.UUID                  `json:"id"`
+	IterationID    uuid.UUID                  `json:"iteration_id"`
+	VotesPerMember int                        `json:"votes_per_member"`
+	Columns        []RetrospectiveColumn      `json:"columns"`
+	Analysis       *IterationAnalysisResponse `json:"analysis,omitempty"`
+	CreatedAt      time.Time                  `json:"created_at"`
+	UpdatedAt      time.Time                  `json:"updated_at"`
+}
+
+// RetrospectiveColumn groups items under a title such as "Went well" or "To improve"
+type RetrospectiveColumn struct {
+	ID              uuid.UUID           `json:"id"`
+	RetrospectiveID uuid.UUID           `json:"retrospective_id"`
+	Title           string              `json:"title"`
+	Position        int                 `json:"position"`
+	Items           []RetrospectiveItem `json:"items"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+}
+
+// RetrospectiveItem is a note written by a project member in a column
+// CauseID and ActionID are set once the item has been promoted
+type RetrospectiveItem struct {
+	ID          uuid.UUID  `json:"id"`
+	ColumnID    uuid.UUID  `json:"column_id"`
+	Author      User       `json:"author"`
+	Description string     `json:"description"`
+	Votes       int        `json:"votes"`
+	CauseID     *uuid.UUID `json:"cause_id,omitempty"`
+	ActionID    *uuid.UUID `json:"action_id,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// DefaultRetrospectiveColumns returns the column titles used when none are provided
+func DefaultRetrospectiveColumns() []string {
+	return []string{
+		"Went well",
+		"To improve",
+		"Ideas",
+	}
+}
@@ -0,0 +1,54 @@
+package models
+
+import "github.com/google/uuid"
+
+type SearchHitType string
+
+const (
+	SearchProject     SearchHitType = "project"
+	SearchTask        SearchHitType = "task"
+	SearchBug         SearchHitType = "bug"
+	SearchImprovement SearchHitType = "improvement"
+	SearchCause       SearchHitType = "cause"
+	SearchAction      SearchHitType = "action"
+)
+
+func AllSearchHitTypes() []SearchHitType {
+	return []SearchHitType{SearchProject, SearchTask, SearchBug, SearchImprovement, SearchCause, SearchAction}
+}
+
+func IsValidSearchHitType(t SearchHitType) bool {
+	for _, valid := range AllSearchHitTypes() {
+		if t == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchRequest is a full-text query over the projects the requester belongs to
+// Empty Types searches every kind; ProjectID narrows the search to one project
+type SearchRequest struct {
+	Query     string
+	Types     []SearchHitType
+	ProjectID *uuid.UUID
+	Limit     int
+}
+
+// SearchHit is one matching entity; Highlight is an HTML fragment of the matched text, escaped,
+// with the terms wrapped in <mark> tags
+type SearchHit struct {
+	Type        SearchHitType `json:"type"`
+	ID          uuid.UUID     `json:"id"`
+	ProjectID   uuid.UUID     `json:"project_id"`
+	ProjectName string        `json:"project_name"`
+	TaskID      *uuid.UUID    `json:"task_id,omitempty"`
+	Title       string        `json:"title"`
+	Highlight   string        `json:"highlight"`
+	Rank        float64       `json:"rank"`
+}
+
+type SearchResponse struct {
+	Query string      `json:"query"`
+	Hits  []SearchHit `json:"hits"`
+}
@@ -7,18 +7,37 @@ import (
 )
 
 type Task struct {
-	ID           uuid.UUID  `json:"id"`
-	IterationID  uuid.UUID  `json:"iteration_id"`
-	Name         string     `json:"name"`
-	Description  string     `json:"description"`
-	Assignee     User       `json:"assignee"`
-	Status       StatusEnum `json:"status"`
-	Timer        int64      `json:"timer"`
-	Points       int        `json:"points"`
-	ExpectedTime float64    `json:"expected_time"` 
-	Tasks        []Task     `json:"tasks,omitempty"` // Sub-tasks
-	Improvements []Improv   `json:"improvements,omitempty"`
-	Bugs         []Bug      `json:"bugs,omitempty"`
-	CreatedAt    time.Time  `json:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at"`
+	ID           uuid.UUID    `json:"id"`
+	ProjectID    uuid.UUID    `json:"project_id"`
+	IterationID  *uuid.UUID   `json:"iteration_id"` // Nil while the task sits in the project backlog
+	Name         string       `json:"name"`
+	Description  string       `json:"description"`
+	Assignee     User         `json:"assignee"`
+	Status       StatusEnum   `json:"status"`
+	Rank         string       `json:"rank"` // Position within the status column; compare as plain strings
+	Timer        int64        `json:"timer"`
+	Points       int          `json:"points"`
+	ExpectedTime float64      `json:"expected_time"`
+	ParentTaskID *uuid.UUID   `json:"parent_task_id,omitempty"`
+	Tasks        []Task       `json:"tasks,omitempty"` // Sub-tasks
+	Improvements []Improv     `json:"improvements,omitempty"`
+	Bugs         []Bug        `json:"bugs,omitempty"`
+	Labels       []Label      `json:"labels,omitempty"`
+	BlockedBy    []TaskRef    `json:"blocked_by,omitempty"`
+	Blocks       []TaskRef    `json:"blocks,omitempty"`
+	Blocked      bool         `json:"blocked"`                // Some task in BlockedBy is not completed yet
+	Comments     []Comment    `json:"comments,omitempty"`     // Only loaded with include=comments
+	Attachments  []Attachment `json:"attachments,omitempty"`  // Only loaded with include=attachments
+	StartedAt    *time.Time   `json:"started_at,omitempty"`   // First time the task moved to InProgress
+	CompletedAt  *time.Time   `json:"completed_at,omitempty"` // Last time the task moved to Completed
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+}
+
+// SameIteration reports whether two iteration ids point to the same iteration, nil being the backlog
+func SameIteration(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
 }
@@ -0,0 +1,62 @@
+package models
+
+import "github.com/google/uuid"
+
+// MaxBulkTasks caps how many tasks one bulk request may change
+const MaxBulkTasks = 200
+
+type TaskBulkOperation string
+
+const (
+	TaskBulkPatch           TaskBulkOperation = "patch"
+	TaskBulkMoveToIteration TaskBulkOperation = "move_to_iteration"
+	TaskBulkDelete          TaskBulkOperation = "delete"
+)
+
+func IsValidTaskBulkOperation(op TaskBulkOperation) bool {
+	switch op {
+	case TaskBulkPatch, TaskBulkMoveToIteration, TaskBulkDelete:
+		return true
+	}
+	return false
+}
+
+// TaskPatch holds the triage fields a bulk patch may change; nil fields are left as they are
+// The assignee is written only when SetAssignee is true, a nil AssigneeID then unassigning the tasks
+// It has no JSON form: handlers build it from their own request bodies
+type TaskPatch struct {
+	Status       *StatusEnum
+	SetAssignee  bool
+	AssigneeID   *uuid.UUID
+	Points       *int
+	ExpectedTime *float64
+}
+
+func (p TaskPatch) IsEmpty() bool {
+	return p.Status == nil && !p.SetAssignee && p.Points == nil && p.ExpectedTime == nil
+}
+
+// TaskBulkRequest applies one operation to several tasks
+// Patch is required by the patch operation and IterationID by move_to_iteration
+type TaskBulkRequest struct {
+	TaskIDs     []uuid.UUID       `json:"task_ids"`
+	Operation   TaskBulkOperation `json:"operation"`
+	Patch       *TaskPatch        `json:"patch,omitempty"`
+	IterationID *uuid.UUID        `json:"iteration_id,omitempty"`
+	Force       bool              `json:"-"` // Start tasks even if they have unfinished blockers
+}
+
+// TaskBulkResult is the outcome of the operation for one task
+type TaskBulkResult struct {
+	TaskID uuid.UUID `json:"task_id"`
+	OK     bool      `json:"ok"`
+	Error  string    `json:"error,omitempty"`
+	Err    error     `json:"-"`
+}
+
+type TaskBulkResponse struct {
+	Operation TaskBulkOperation `json:"operation"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+	Results   []TaskBulkResult  `json:"results"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskStatusChange records a task moving from one status to another
+// FromStatus is nil for the initial status set when the task was created
+// ActorID is nil when the user who made the change is unknown
+type TaskStatusChange struct {
+	ID         uuid.UUID   `json:"id"`
+	TaskID     uuid.UUID   `json:"task_id"`
+	FromStatus *StatusEnum `json:"from_status,omitempty"`
+	ToStatus   StatusEnum  `json:"to_status"`
+	ActorID    *uuid.UUID  `json:"actor_id,omitempty"`
+	ChangedAt  time.Time   `json:"changed_at"`
+}
+
+// StatusTransition is a status change allowed in a project
+type StatusTransition struct {
+	FromStatus StatusEnum `json:"from_status"`
+	ToStatus   StatusEnum `json:"to_status"`
+}
+
+// WIPLimit is the most top-level tasks an iteration board of the project may hold in a status
+type WIPLimit struct {
+	Status   StatusEnum `json:"status"`
+	MaxTasks int        `json:"max_tasks"`
+}
@@ -7,11 +7,12 @@ import (
 )
 
 type User struct {
-	ID           uuid.UUID  `json:"id"`
-	Name         string     `json:"name"`
-	Email        string     `json:"email"`
-	PasswordHash string     `json:"-"` // Never return password in JSON
-	ProjectID    *uuid.UUID `json:"project_id,omitempty"`
-	CreatedAt    time.Time  `json:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at"`
+	ID           uuid.UUID       `json:"id"`
+	Name         string          `json:"name"`
+	Email        string          `json:"email"`
+	PasswordHash string          `json:"-"` // Never return password in JSON
+	ProjectID    *uuid.UUID      `json:"project_id,omitempty"`
+	Role         ProjectRoleEnum `json:"role,omitempty"` // Only set when listed as a project member
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
 }
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -208,6 +210,17 @@ func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Action,
 }
 
 func (r *Repository) Create(ctx context.Context, action models.Action) error {
+	return create(ctx, r.db, action)
+}
+
+// CreateTx inserts the action as part of a transaction owned by another repository
+func (r *Repository) CreateTx(ctx context.Context, tx pgx.Tx, action models.Action) error {
+	return create(ctx, tx, action)
+}
+
+func create(ctx context.Context, db interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}, action models.Action) error {
 	const query = `
 		INSERT INTO actions (id, indicator_range_id, cause_id, description, status, start_at, end_at, assignee_id)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
@@ -234,7 +247,7 @@ func (r *Repository) Create(ctx context.Context, action models.Action) error {
 		status = models.StatusNotStarted
 	}
 
-	_, err := r.db.Exec(ctx, query,
+	_, err := db.Exec(ctx, query,
 		action.ID,
 		action.IndicatorRangeID,
 		action.Cause.ID,
@@ -0,0 +1,280 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrNotFound       = errors.New("attachment not found")
+	ErrTargetNotFound = errors.New("attachment target not found")
+)
+
+// target describes the column an attachment uses to point at one kind of item
+// and how to find the project of an item of that kind
+type target struct {
+	column       string
+	projectQuery string
+}
+
+var targets = map[models.AttachmentTargetType]target{
+	models.AttachmentOnTask: {
+		column:       "task_id",
+		projectQuery: `SELECT project_id FROM tasks WHERE id = $1`,
+	},
+	models.AttachmentOnBug: {
+		column: "bug_id",
+		projectQuery: `SELECT t.project_id FROM bugs b
+			INNER JOIN tasks t ON b.task_id = t.id
+			WHERE b.id = $1`,
+	},
+	models.AttachmentOnImprovement: {
+		column: "improvement_id",
+		projectQuery: `SELECT t.project_id FROM improvements im
+			INNER JOIN tasks t ON im.task_id = t.id
+			WHERE im.id = $1`,
+	},
+}
+
+const selectColumns = `
+	SELECT a.id, a.project_id, a.task_id, a.bug_id, a.improvement_id,
+		a.file_name, a.content_type, a.size_bytes, a.storage_key,
+		a.uploaded_by, COALESCE(u.name, ''), COALESCE(u.email, ''), a.created_at
+	FROM attachments a
+	LEFT JOIN users u ON a.uploaded_by = u.id
+`
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// GetProjectID returns the project of the item a file would be attached to
+func (r *Repository) GetProjectID(ctx context.Context, targetType models.AttachmentTargetType, targetID uuid.UUID) (uuid.UUID, error) {
+	t, ok := targets[targetType]
+	if !ok {
+		return uuid.Nil, ErrTargetNotFound
+	}
+
+	var projectID uuid.UUID
+	err := r.db.QueryRow(ctx, t.projectQuery, targetID).Scan(&projectID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, ErrTargetNotFound
+		}
+		return uuid.Nil, err
+	}
+	return projectID, nil
+}
+
+func (r *Repository) Create(ctx context.Context, a models.Attachment) error {
+	t, ok := targets[a.TargetType]
+	if !ok {
+		return ErrTargetNotFound
+	}
+
+	var uploadedBy interface{}
+	if a.UploadedBy != nil {
+		uploadedBy = a.UploadedBy.ID
+	}
+
+	query := `
+		INSERT INTO attachments (id, project_id, ` + t.column + `, file_name, content_type, size_bytes, storage_key, uploaded_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err := r.db.Exec(ctx, query, a.ID, a.ProjectID, a.TargetID, a.FileName, a.ContentType, a.SizeBytes, a.StorageKey, uploadedBy)
+	return err
+}
+
+func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Attachment, error) {
+	rows, err := r.db.Query(ctx, selectColumns+` WHERE a.id = $1`, id)
+	if err != nil {
+		return models.Attachment{}, err
+	}
+
+	attachments, err := scanAttachments(rows)
+	if err != nil {
+		return models.Attachment{}, err
+	}
+	if len(attachments) == 0 {
+		return models.Attachment{}, ErrNotFound
+	}
+	return attachments[0], nil
+}
+
+// GetByTarget returns the attachments of an item, oldest first
+func (r *Repository) GetByTarget(ctx context.Context, targetType models.AttachmentTargetType, targetID uuid.UUID) ([]models.Attachment, error) {
+	t, ok := targets[targetType]
+	if !ok {
+		return nil, ErrTargetNotFound
+	}
+
+	query := selectColumns + ` WHERE a.` + t.column + ` = $1 ORDER BY a.created_at ASC, a.id ASC`
+	rows, err := r.db.Query(ctx, query, targetID)
+	if err != nil {
+		return nil, err
+	}
+	return scanAttachments(rows)
+}
+
+func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
+	cmd, err := r.db.Exec(ctx, `DELETE FROM attachments WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// GetStorageKeysByTaskID returns the storage keys of every file that goes away with the task:
+// those of the task, of its sub-tasks at any depth and of their bugs and improvements
+func (r *Repository) GetStorageKeysByTaskID(ctx context.Context, taskID uuid.UUID) ([]string, error) {
+	return queryStorageKeys(ctx, r.db, storageKeysByTaskID, taskID)
+}
+
+// GetStorageKeysByTaskIDTx is GetStorageKeysByTaskID as part of a transaction owned by another repository
+func (r *Repository) GetStorageKeysByTaskIDTx(ctx context.Context, tx pgx.Tx, taskID uuid.UUID) ([]string, error) {
+	return queryStorageKeys(ctx, tx, storageKeysByTaskID, taskID)
+}
+
+const storageKeysByTaskID = `
+	WITH RECURSIVE tree AS (
+		SELECT id FROM tasks WHERE id = $1
+		UNION ALL
+		SELECT t.id FROM tasks t INNER JOIN tree ON t.parent_task_id = tree.id
+	)
+	SELECT a.storage_key
+	FROM attachments a
+	WHERE a.task_id IN (SELECT id FROM tree)
+		OR a.bug_id IN (SELECT b.id FROM bugs b WHERE b.task_id IN (SELECT id FROM tree))
+		OR a.improvement_id IN (SELECT im.id FROM improvements im WHERE im.task_id IN (SELECT id FROM tree))
+`
+
+// GetStorageKeysByIterationID returns the storage keys of every file that goes away with the iteration,
+// attached to its tasks at any depth or to their bugs and improvements
+func (r *Repository) GetStorageKeysByIterationID(ctx context.Context, iterationID uuid.UUID) ([]string, error) {
+	const query = `
+		WITH RECURSIVE tree AS (
+			SELECT id FROM tasks WHERE iteration_id = $1
+			UNION
+			SELECT t.id FROM tasks t INNER JOIN tree ON t.parent_task_id = tree.id
+		)
+		SELECT a.storage_key
+		FROM attachments a
+		WHERE a.task_id IN (SELECT id FROM tree)
+			OR a.bug_id IN (SELECT b.id FROM bugs b WHERE b.task_id IN (SELECT id FROM tree))
+			OR a.improvement_id IN (SELECT im.id FROM improvements im WHERE im.task_id IN (SELECT id FROM tree))
+	`
+	return queryStorageKeys(ctx, r.db, query, iterationID)
+}
+
+// GetStorageKeysByProjectID returns the storage keys of every file uploaded to the project
+func (r *Repository) GetStorageKeysByProjectID(ctx context.Context, projectID uuid.UUID) ([]string, error) {
+	const query = `SELECT storage_key FROM attachments WHERE project_id = $1`
+	return queryStorageKeys(ctx, r.db, query, projectID)
+}
+
+func queryStorageKeys(ctx context.Context, db interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}, query string, arg uuid.UUID) ([]string, error) {
+	rows, err := db.Query(ctx, query, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// GetSettings returns the upload limits of a project, or the defaults when it has none
+func (r *Repository) GetSettings(ctx context.Context, projectID uuid.UUID) (models.AttachmentSettings, error) {
+	const query = `
+		SELECT project_id, max_size_bytes, allowed_types
+		FROM attachment_settings
+		WHERE project_id = $1
+	`
+	var s models.AttachmentSettings
+	err := r.db.QueryRow(ctx, query, projectID).Scan(&s.ProjectID, &s.MaxSizeBytes, &s.AllowedTypes)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.DefaultAttachmentSettings(projectID), nil
+		}
+		return models.AttachmentSettings{}, err
+	}
+	return s, nil
+}
+
+func (r *Repository) SaveSettings(ctx context.Context, s models.AttachmentSettings) error {
+	const query = `
+		INSERT INTO attachment_settings (project_id, max_size_bytes, allowed_types)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (project_id) DO UPDATE
+		SET max_size_bytes = EXCLUDED.max_size_bytes, allowed_types = EXCLUDED.allowed_types
+	`
+	_, err := r.db.Exec(ctx, query, s.ProjectID, s.MaxSizeBytes, s.AllowedTypes)
+	return err
+}
+
+func scanAttachments(rows pgx.Rows) ([]models.Attachment, error) {
+	defer rows.Close()
+
+	attachments := []models.Attachment{}
+	for rows.Next() {
+		var a models.Attachment
+		var taskID, bugID, improvementID, uploadedBy *uuid.UUID
+		var uploaderName, uploaderEmail string
+		if err := rows.Scan(
+			&a.ID,
+			&a.ProjectID,
+			&taskID,
+			&bugID,
+			&improvementID,
+			&a.FileName,
+			&a.ContentType,
+			&a.SizeBytes,
+			&a.StorageKey,
+			&uploadedBy,
+			&uploaderName,
+			&uploaderEmail,
+			&a.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case taskID != nil:
+			a.TargetType, a.TargetID = models.AttachmentOnTask, *taskID
+		case bugID != nil:
+			a.TargetType, a.TargetID = models.AttachmentOnBug, *bugID
+		case improvementID != nil:
+			a.TargetType, a.TargetID = models.AttachmentOnImprovement, *improvementID
+		}
+
+		if uploadedBy != nil {
+			a.UploadedBy = &models.Member{ID: *uploadedBy, Name: uploaderName, Email: uploaderEmail, ProjectID: a.ProjectID}
+		}
+
+		attachments = append(attachments, a)
+	}
+
+	return attachments, rows.Err()
+}
@@ -0,0 +1,77 @@
+package availability
+
+import (
+	"context"
+	"prodyo-backend/cmd/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// GetByIterationID returns the recorded availability of the members of an iteration, by member name
+func (r *Repository) GetByIterationID(ctx context.Context, iterationID uuid.UUID) ([]models.MemberAvailability, error) {
+	const query = `
+		SELECT u.id, u.name, u.email, a.hours_per_day, a.days_off
+		FROM iteration_member_availability a
+		INNER JOIN users u ON a.user_id = u.id
+		WHERE a.iteration_id = $1
+		ORDER BY u.name ASC, u.id ASC
+	`
+	rows, err := r.db.Query(ctx, query, iterationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	availability := []models.MemberAvailability{}
+	for rows.Next() {
+		var a models.MemberAvailability
+		if err := rows.Scan(&a.Member.ID, &a.Member.Name, &a.Member.Email, &a.HoursPerDay, &a.DaysOff); err != nil {
+			return nil, err
+		}
+		if a.DaysOff == nil {
+			a.DaysOff = []time.Time{}
+		}
+		availability = append(availability, a)
+	}
+
+	return availability, rows.Err()
+}
+
+// Replace swaps the availability recorded for an iteration for the given list
+func (r *Repository) Replace(ctx context.Context, iterationID uuid.UUID, availability []models.MemberAvailability) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM iteration_member_availability WHERE iteration_id = $1`, iterationID); err != nil {
+		return err
+	}
+
+	const query = `
+		INSERT INTO iteration_member_availability (iteration_id, user_id, hours_per_day, days_off)
+		VALUES ($1, $2, $3, $4::date[])
+	`
+	for _, a := range availability {
+		daysOff := a.DaysOff
+		if daysOff == nil {
+			daysOff = []time.Time{}
+		}
+		if _, err := tx.Exec(ctx, query, iterationID, a.Member.ID, a.HoursPerDay, daysOff); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"prodyo-backend/cmd/internal/models"
+	labelRepo "prodyo-backend/cmd/internal/repositories/label"
+	"prodyo-backend/cmd/internal/repositories/listquery"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,29 +18,108 @@ var (
 )
 
 type Repository struct {
-	db *pgxpool.Pool
+	db        *pgxpool.Pool
+	labelRepo *labelRepo.Repository
 }
 
 func New(db *pgxpool.Pool) *Repository {
-	return &Repository{db: db}
+	return &Repository{
+		db:        db,
+		labelRepo: labelRepo.New(db),
+	}
+}
+
+// listColumns maps the fields of models.BugListSpec to columns
+var listColumns = listquery.Columns{
+	"assignee_id": "b.assignee_id",
+	"label":       "EXISTS (SELECT 1 FROM bug_labels bl INNER JOIN labels lb ON bl.label_id = lb.id WHERE bl.bug_id = b.id AND lb.name %s)",
+	"label_id":    "EXISTS (SELECT 1 FROM bug_labels bl WHERE bl.bug_id = b.id AND bl.label_id %s)",
+	"number":      "b.number",
+	"points":      "b.points",
+	"created_at":  "b.created_at",
+	"updated_at":  "b.updated_at",
 }
 
-func (r *Repository) GetAll(ctx context.Context, taskID uuid.UUID) ([]models.Bug, error) {
+// GetAll returns the bugs of the task matching the query, by number unless sorted otherwise
+func (r *Repository) GetAll(ctx context.Context, taskID uuid.UUID, q models.ListQuery) ([]models.Bug, error) {
+	b := listquery.NewBuilder(taskID)
+	b.Where("b.task_id = $1")
+	if err := b.Filters(q, listColumns); err != nil {
+		return nil, err
+	}
+	b.Search(q.Search, "COALESCE(b.description, '')")
+
+	orderBy, err := listquery.OrderBy(q, listColumns, "b.number ASC, b.id ASC", "b.id ASC")
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT b.id, b.task_id, b.number, b.description, b.points, b.created_at, b.updated_at,
+		       u.id as assignee_id, u.name as assignee_name, u.email as assignee_email,
+		       u.created_at as assignee_created_at, u.updated_at as assignee_updated_at
+		FROM bugs b
+		LEFT JOIN users u ON b.assignee_id = u.id
+		` + b.WhereClause() + `
+		` + orderBy
+	rows, err := r.db.Query(ctx, query, b.Args()...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bugs, err := scanBugs(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(bugs))
+	for i, item := range bugs {
+		ids[i] = item.ID
+	}
+
+	labels, err := r.labelRepo.GetByBugIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range bugs {
+		bugs[i].Labels = labels[bugs[i].ID]
+	}
+
+	return bugs, nil
+}
+
+// GetAllByTaskIDs loads the bugs of several tasks in one query, grouped by task
+func (r *Repository) GetAllByTaskIDs(ctx context.Context, taskIDs []uuid.UUID) (map[uuid.UUID][]models.Bug, error) {
 	const query = `
 		SELECT b.id, b.task_id, b.number, b.description, b.points, b.created_at, b.updated_at,
 		       u.id as assignee_id, u.name as assignee_name, u.email as assignee_email,
 		       u.created_at as assignee_created_at, u.updated_at as assignee_updated_at
 		FROM bugs b
 		LEFT JOIN users u ON b.assignee_id = u.id
-		WHERE b.task_id = $1
-		ORDER BY b.number ASC
+		WHERE b.task_id = ANY($1)
+		ORDER BY b.task_id, b.number ASC
 	`
-	rows, err := r.db.Query(ctx, query, taskID)
+	rows, err := r.db.Query(ctx, query, taskIDs)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	bugs, err := scanBugs(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	byTask := make(map[uuid.UUID][]models.Bug)
+	for _, item := range bugs {
+		byTask[item.TaskID] = append(byTask[item.TaskID], item)
+	}
+
+	return byTask, nil
+}
+
+func scanBugs(rows pgx.Rows) ([]models.Bug, error) {
 	var bugs []models.Bug
 	for rows.Next() {
 		var bg models.Bug
@@ -124,6 +205,12 @@ func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Bug, err
 		}
 	}
 
+	labels, err := r.labelRepo.GetByBugIDs(ctx, []uuid.UUID{bg.ID})
+	if err != nil {
+		return models.Bug{}, err
+	}
+	bg.Labels = labels[bg.ID]
+
 	return bg, nil
 }
 
@@ -0,0 +1,147 @@
+package cadence
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrNotFound        = errors.New("project has no iteration cadence")
+	ErrProjectNotFound = errors.New("project not found")
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) GetByProjectID(ctx context.Context, projectID uuid.UUID) (models.IterationCadence, error) {
+	const query = `
+		SELECT project_id, length_days, start_weekday, gap_days, ahead, created_at, updated_at
+		FROM iteration_cadences
+		WHERE project_id = $1
+	`
+	c, err := scanCadence(r.db.QueryRow(ctx, query, projectID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.IterationCadence{}, ErrNotFound
+		}
+		return models.IterationCadence{}, err
+	}
+	return c, nil
+}
+
+// GetScheduled returns the cadences that keep upcoming iterations created
+func (r *Repository) GetScheduled(ctx context.Context) ([]models.IterationCadence, error) {
+	const query = `
+		SELECT project_id, length_days, start_weekday, gap_days, ahead, created_at, updated_at
+		FROM iteration_cadences
+		WHERE ahead > 0
+		ORDER BY project_id ASC
+	`
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cadences := []models.IterationCadence{}
+	for rows.Next() {
+		c, err := scanCadence(rows)
+		if err != nil {
+			return nil, err
+		}
+		cadences = append(cadences, c)
+	}
+
+	return cadences, rows.Err()
+}
+
+// Upsert saves the cadence of a project and returns it as stored
+func (r *Repository) Upsert(ctx context.Context, c models.IterationCadence) (models.IterationCadence, error) {
+	const query = `
+		INSERT INTO iteration_cadences (project_id, length_days, start_weekday, gap_days, ahead)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (project_id) DO UPDATE
+		SET length_days = EXCLUDED.length_days, start_weekday = EXCLUDED.start_weekday,
+			gap_days = EXCLUDED.gap_days, ahead = EXCLUDED.ahead, updated_at = NOW()
+		RETURNING project_id, length_days, start_weekday, gap_days, ahead, created_at, updated_at
+	`
+	stored, err := scanCadence(r.db.QueryRow(ctx, query, c.ProjectID, c.LengthDays, int16(c.StartWeekday), c.GapDays, c.Ahead))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return models.IterationCadence{}, ErrProjectNotFound
+		}
+		return models.IterationCadence{}, err
+	}
+	return stored, nil
+}
+
+func (r *Repository) Delete(ctx context.Context, projectID uuid.UUID) error {
+	cmd, err := r.db.Exec(ctx, `DELETE FROM iteration_cadences WHERE project_id = $1`, projectID)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// CountUpcoming counts the iterations of a project that start after the given time
+func (r *Repository) CountUpcoming(ctx context.Context, projectID uuid.UUID, after time.Time) (int, error) {
+	const query = `SELECT COUNT(*) FROM iterations WHERE project_id = $1 AND start_at > $2`
+	var count int
+	err := r.db.QueryRow(ctx, query, projectID, after).Scan(&count)
+	return count, err
+}
+
+// scheduleLockKey names the session lock held by the replica running the iteration schedule
+const scheduleLockKey = "iteration-schedule"
+
+// TryLockSchedule takes the session lock that lets a single replica run the iteration schedule,
+// on a connection set aside from the pool for as long as it is held
+// ok is false when another replica holds it; otherwise unlock frees it and returns the connection
+func (r *Repository) TryLockSchedule(ctx context.Context) (unlock func(), ok bool, err error) {
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock(hashtextextended($1::text, 0))`, scheduleLockKey).Scan(&ok); err != nil {
+		conn.Release()
+		return nil, false, err
+	}
+	if !ok {
+		conn.Release()
+		return nil, false, nil
+	}
+
+	return func() {
+		// Not the caller's context: the lock has to be freed even once it is cancelled on shutdown
+		if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock(hashtextextended($1::text, 0))`, scheduleLockKey); err != nil {
+			// Ending the session frees the lock too; the pool drops the closed connection
+			conn.Conn().Close(context.Background())
+		}
+		conn.Release()
+	}, true, nil
+}
+
+func scanCadence(row pgx.Row) (models.IterationCadence, error) {
+	var c models.IterationCadence
+	var weekday int16
+	err := row.Scan(&c.ProjectID, &c.LengthDays, &weekday, &c.GapDays, &c.Ahead, &c.CreatedAt, &c.UpdatedAt)
+	c.StartWeekday = time.Weekday(weekday)
+	return c, err
+}
@@ -0,0 +1,149 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrProjectNotFound = errors.New("project not found")
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// GetByProjectID returns the working calendar of a project, the default one when it never set its own
+func (r *Repository) GetByProjectID(ctx context.Context, projectID uuid.UUID) (models.WorkingCalendar, error) {
+	const query = `SELECT timezone, working_days FROM project_calendars WHERE project_id = $1`
+
+	timezone := models.DefaultTimezone
+	weekdays := models.DefaultWorkingCalendar(projectID).WorkingDays
+	var stored []int16
+	err := r.db.QueryRow(ctx, query, projectID).Scan(&timezone, &stored)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return models.WorkingCalendar{}, err
+	}
+	if err == nil {
+		weekdays = make([]time.Weekday, len(stored))
+		for i, d := range stored {
+			weekdays[i] = time.Weekday(d)
+		}
+	}
+
+	holidays, err := r.getHolidays(ctx, projectID)
+	if err != nil {
+		return models.WorkingCalendar{}, err
+	}
+
+	return models.NewWorkingCalendar(projectID, timezone, weekdays, holidays)
+}
+
+func (r *Repository) getHolidays(ctx context.Context, projectID uuid.UUID) ([]models.Holiday, error) {
+	const query = `
+		SELECT date, name
+		FROM project_holidays
+		WHERE project_id = $1
+		ORDER BY date ASC
+	`
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	holidays := []models.Holiday{}
+	for rows.Next() {
+		var date time.Time
+		var h models.Holiday
+		if err := rows.Scan(&date, &h.Name); err != nil {
+			return nil, err
+		}
+		h.Date = date.Format(models.DateLayout)
+		holidays = append(holidays, h)
+	}
+
+	return holidays, rows.Err()
+}
+
+// Replace saves the timezone and working days of a project and swaps its holidays for the given ones
+func (r *Repository) Replace(ctx context.Context, cal models.WorkingCalendar) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	weekdays := make([]int16, len(cal.WorkingDays))
+	for i, d := range cal.WorkingDays {
+		weekdays[i] = int16(d)
+	}
+
+	const query = `
+		INSERT INTO project_calendars (project_id, timezone, working_days)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (project_id) DO UPDATE
+		SET timezone = EXCLUDED.timezone, working_days = EXCLUDED.working_days, updated_at = NOW()
+	`
+	if _, err := tx.Exec(ctx, query, cal.ProjectID, cal.Timezone, weekdays); err != nil {
+		return translateError(err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM project_holidays WHERE project_id = $1`, cal.ProjectID); err != nil {
+		return err
+	}
+	if err := addHolidays(ctx, tx, cal.ProjectID, cal.Holidays); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// AddHolidays adds holidays to a project, renaming those already on the same date
+func (r *Repository) AddHolidays(ctx context.Context, projectID uuid.UUID, holidays []models.Holiday) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := addHolidays(ctx, tx, projectID, holidays); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func addHolidays(ctx context.Context, tx pgx.Tx, projectID uuid.UUID, holidays []models.Holiday) error {
+	const query = `
+		INSERT INTO project_holidays (project_id, date, name)
+		VALUES ($1, $2::date, $3)
+		ON CONFLICT (project_id, date) DO UPDATE SET name = EXCLUDED.name
+	`
+	for _, h := range holidays {
+		if _, err := tx.Exec(ctx, query, projectID, h.Date, h.Name); err != nil {
+			return translateError(err)
+		}
+	}
+	return nil
+}
+
+// translateError maps the project foreign key violation to ErrProjectNotFound
+func translateError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+		return ErrProjectNotFound
+	}
+	return err
+}
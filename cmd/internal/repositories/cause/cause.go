@@ -6,6 +6,8 @@ import (
 	"prodyo-backend/cmd/internal/models"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -90,6 +92,17 @@ func (r *Repository) GetByIterationID(ctx context.Context, iterationID uuid.UUID
 }
 
 func (r *Repository) Create(ctx context.Context, cause models.Cause) error {
+	return create(ctx, r.db, cause)
+}
+
+// CreateTx inserts the cause as part of a transaction owned by another repository
+func (r *Repository) CreateTx(ctx context.Context, tx pgx.Tx, cause models.Cause) error {
+	return create(ctx, tx, cause)
+}
+
+func create(ctx context.Context, db interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}, cause models.Cause) error {
 	const query = `
 		INSERT INTO causes (id, indicator_range_id, metric, description, productivity_level)
 		VALUES ($1, $2, $3, $4, $5)
@@ -98,7 +111,7 @@ func (r *Repository) Create(ctx context.Context, cause models.Cause) error {
 		cause.ID = uuid.New()
 	}
 
-	_, err := r.db.Exec(ctx, query,
+	_, err := db.Exec(ctx, query,
 		cause.ID,
 		cause.IndicatorRangeID,
 		cause.Metric,
@@ -0,0 +1,426 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrNotFound       = errors.New("comment not found")
+	ErrTargetNotFound = errors.New("comment target not found")
+)
+
+// target describes the column a comment uses to point at one kind of item
+// and how to find the project of an item of that kind
+type target struct {
+	column       string
+	projectQuery string
+}
+
+var targets = map[models.CommentTargetType]target{
+	models.CommentOnTask: {
+		column:       "task_id",
+		projectQuery: `SELECT project_id FROM tasks WHERE id = $1`,
+	},
+	models.CommentOnBug: {
+		column: "bug_id",
+		projectQuery: `SELECT t.project_id FROM bugs b
+			INNER JOIN tasks t ON b.task_id = t.id
+			WHERE b.id = $1`,
+	},
+	models.CommentOnImprovement: {
+		column: "improvement_id",
+		projectQuery: `SELECT t.project_id FROM improvements im
+			INNER JOIN tasks t ON im.task_id = t.id
+			WHERE im.id = $1`,
+	},
+	models.CommentOnAction: {
+		column: "action_id",
+		projectQuery: `SELECT ir.project_id FROM actions a
+			INNER JOIN indicator_ranges ir ON a.indicator_range_id = ir.id
+			WHERE a.id = $1`,
+	},
+}
+
+const selectColumns = `
+	SELECT c.id, c.project_id, c.task_id, c.bug_id, c.improvement_id, c.action_id, c.parent_id,
+		c.author_id, COALESCE(u.name, ''), COALESCE(u.email, ''),
+		c.body, c.edited_at, c.deleted_at, c.created_at, c.updated_at
+	FROM comments c
+	LEFT JOIN users u ON c.author_id = u.id
+`
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// GetProjectID returns the project of the item a comment would be attached to
+func (r *Repository) GetProjectID(ctx context.Context, targetType models.CommentTargetType, targetID uuid.UUID) (uuid.UUID, error) {
+	t, ok := targets[targetType]
+	if !ok {
+		return uuid.Nil, ErrTargetNotFound
+	}
+
+	var projectID uuid.UUID
+	err := r.db.QueryRow(ctx, t.projectQuery, targetID).Scan(&projectID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, ErrTargetNotFound
+		}
+		return uuid.Nil, err
+	}
+	return projectID, nil
+}
+
+// ResolveMentions returns the members of the project matching the handles
+// A handle matches a member by user ID, by full email, or by the part of the email before the @ when no
+// other member shares it, ignoring case; an ambiguous short handle notifies nobody
+func (r *Repository) ResolveMentions(ctx context.Context, projectID uuid.UUID, handles []string) ([]uuid.UUID, error) {
+	if len(handles) == 0 {
+		return nil, nil
+	}
+
+	const query = `
+		SELECT u.id
+		FROM project_members pm
+		INNER JOIN users u ON pm.user_id = u.id
+		WHERE pm.project_id = $1
+			AND (
+				u.id::text = ANY($2)
+				OR LOWER(u.email) = ANY($2)
+				OR (
+					LOWER(split_part(u.email, '@', 1)) = ANY($2)
+					AND NOT EXISTS (
+						SELECT 1
+						FROM project_members opm
+						INNER JOIN users ou ON opm.user_id = ou.id
+						WHERE opm.project_id = pm.project_id
+							AND ou.id <> u.id
+							AND LOWER(split_part(ou.email, '@', 1)) = LOWER(split_part(u.email, '@', 1))
+					)
+				)
+			)
+		ORDER BY u.name ASC
+	`
+	rows, err := r.db.Query(ctx, query, projectID, handles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// Create inserts the comment with its mentions and notifies the mentioned users in one transaction
+// The author is never notified of their own mention
+func (r *Repository) Create(ctx context.Context, c models.Comment, mentionIDs []uuid.UUID) error {
+	t, ok := targets[c.TargetType]
+	if !ok {
+		return ErrTargetNotFound
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	insert := `
+		INSERT INTO comments (id, project_id, ` + t.column + `, parent_id, author_id, body)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	var parentID interface{}
+	if c.ParentID != nil {
+		parentID = *c.ParentID
+	}
+	if _, err := tx.Exec(ctx, insert, c.ID, c.ProjectID, c.TargetID, parentID, c.Author.ID, c.Body); err != nil {
+		return err
+	}
+
+	if err := addMentions(ctx, tx, c.ID, c.Author.ID, mentionIDs); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Update replaces the body and mentions of a comment
+// Only users mentioned for the first time are notified
+func (r *Repository) Update(ctx context.Context, id uuid.UUID, body string, mentionIDs []uuid.UUID) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	const update = `
+		UPDATE comments
+		SET body = $2, edited_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING author_id
+	`
+	var authorID *uuid.UUID
+	if err := tx.QueryRow(ctx, update, id, body).Scan(&authorID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	rows, err := tx.Query(ctx, `SELECT user_id FROM comment_mentions WHERE comment_id = $1`, id)
+	if err != nil {
+		return err
+	}
+	existing := make(map[uuid.UUID]bool)
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			rows.Close()
+			return err
+		}
+		existing[userID] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// A nil slice would be sent as NULL and keep every old mention
+	keep := append([]uuid.UUID{}, mentionIDs...)
+	if _, err := tx.Exec(ctx, `DELETE FROM comment_mentions WHERE comment_id = $1 AND NOT (user_id = ANY($2))`, id, keep); err != nil {
+		return err
+	}
+
+	var added []uuid.UUID
+	for _, userID := range mentionIDs {
+		if !existing[userID] {
+			added = append(added, userID)
+		}
+	}
+
+	var actorID uuid.UUID
+	if authorID != nil {
+		actorID = *authorID
+	}
+	if err := addMentions(ctx, tx, id, actorID, added); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Delete blanks the comment and removes its mentions and notifications
+// The row is kept so replies stay in their thread
+func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	const query = `
+		UPDATE comments
+		SET body = '', deleted_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	cmd, err := tx.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM comment_mentions WHERE comment_id = $1`, id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM notifications WHERE comment_id = $1`, id); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Comment, error) {
+	rows, err := r.db.Query(ctx, selectColumns+` WHERE c.id = $1`, id)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	comments, err := scanComments(rows)
+	if err != nil {
+		return models.Comment{}, err
+	}
+	if len(comments) == 0 {
+		return models.Comment{}, ErrNotFound
+	}
+
+	if err := r.loadMentions(ctx, comments); err != nil {
+		return models.Comment{}, err
+	}
+	return comments[0], nil
+}
+
+// GetByTarget returns the comments of an item as a flat list, oldest first
+func (r *Repository) GetByTarget(ctx context.Context, targetType models.CommentTargetType, targetID uuid.UUID) ([]models.Comment, error) {
+	t, ok := targets[targetType]
+	if !ok {
+		return nil, ErrTargetNotFound
+	}
+
+	query := selectColumns + ` WHERE c.` + t.column + ` = $1 ORDER BY c.created_at ASC, c.id ASC`
+	rows, err := r.db.Query(ctx, query, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := scanComments(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadMentions(ctx, comments); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// loadMentions attaches the mentioned members to the comments in one query
+func (r *Repository) loadMentions(ctx context.Context, comments []models.Comment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(comments))
+	index := make(map[uuid.UUID]int, len(comments))
+	for i, c := range comments {
+		ids[i] = c.ID
+		index[c.ID] = i
+	}
+
+	const query = `
+		SELECT cm.comment_id, u.id, u.name, u.email
+		FROM comment_mentions cm
+		INNER JOIN users u ON cm.user_id = u.id
+		WHERE cm.comment_id = ANY($1)
+		ORDER BY u.name ASC
+	`
+	rows, err := r.db.Query(ctx, query, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var commentID uuid.UUID
+		var m models.Member
+		if err := rows.Scan(&commentID, &m.ID, &m.Name, &m.Email); err != nil {
+			return err
+		}
+		i := index[commentID]
+		m.ProjectID = comments[i].ProjectID
+		comments[i].Mentions = append(comments[i].Mentions, m)
+	}
+
+	return rows.Err()
+}
+
+func scanComments(rows pgx.Rows) ([]models.Comment, error) {
+	defer rows.Close()
+
+	comments := []models.Comment{}
+	for rows.Next() {
+		var c models.Comment
+		var taskID, bugID, improvementID, actionID, authorID *uuid.UUID
+		var authorName, authorEmail string
+		var deletedAt *time.Time
+		if err := rows.Scan(
+			&c.ID,
+			&c.ProjectID,
+			&taskID,
+			&bugID,
+			&improvementID,
+			&actionID,
+			&c.ParentID,
+			&authorID,
+			&authorName,
+			&authorEmail,
+			&c.Body,
+			&c.EditedAt,
+			&deletedAt,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case taskID != nil:
+			c.TargetType, c.TargetID = models.CommentOnTask, *taskID
+		case bugID != nil:
+			c.TargetType, c.TargetID = models.CommentOnBug, *bugID
+		case improvementID != nil:
+			c.TargetType, c.TargetID = models.CommentOnImprovement, *improvementID
+		case actionID != nil:
+			c.TargetType, c.TargetID = models.CommentOnAction, *actionID
+		}
+
+		if authorID != nil {
+			c.Author = &models.Member{ID: *authorID, Name: authorName, Email: authorEmail, ProjectID: c.ProjectID}
+		}
+		c.Deleted = deletedAt != nil
+
+		comments = append(comments, c)
+	}
+
+	return comments, rows.Err()
+}
+
+// addMentions records the mentions of a comment and notifies each mentioned user except the actor
+func addMentions(ctx context.Context, tx pgx.Tx, commentID, actorID uuid.UUID, userIDs []uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	const mentions = `
+		INSERT INTO comment_mentions (comment_id, user_id)
+		SELECT $1::uuid, unnest($2::uuid[])
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := tx.Exec(ctx, mentions, commentID, userIDs); err != nil {
+		return err
+	}
+
+	var actor interface{}
+	if actorID != uuid.Nil {
+		actor = actorID
+	}
+
+	const notifications = `
+		INSERT INTO notifications (user_id, type, comment_id, actor_id)
+		SELECT user_id, $2::varchar, $1::uuid, $3::uuid
+		FROM unnest($4::uuid[]) AS user_id
+		WHERE $3::uuid IS NULL OR user_id <> $3::uuid
+	`
+	_, err := tx.Exec(ctx, notifications, commentID, models.NotificationMention, actor, userIDs)
+	return err
+}
@@ -0,0 +1,340 @@
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrProjectNotFound = errors.New("project not found")
+)
+
+// Repository reads the aggregates shown on the project home page
+// Each method runs a single query so the whole dashboard needs only a handful of them
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// GetProject returns the project summary and its current iteration: the one running at now,
+// or else the latest iteration that already started
+func (r *Repository) GetProject(ctx context.Context, projectID uuid.UUID, now time.Time) (models.DashboardProject, *models.DashboardIteration, error) {
+	const query = `
+		SELECT p.id, p.name, COALESCE(p.description, ''), COALESCE(p.color, ''),
+		       (SELECT COUNT(*) FROM project_members pm WHERE pm.project_id = p.id) as members_count,
+		       (SELECT COUNT(*) FROM iterations i WHERE i.project_id = p.id) as iterations_count,
+		       ci.id, ci.number, ci.description, ci.start_at, ci.end_at,
+		       COALESCE(ts.not_started, 0), COALESCE(ts.in_progress, 0), COALESCE(ts.completed, 0),
+		       COALESCE(ts.total_points, 0), COALESCE(ts.completed_points, 0)
+		FROM projects p
+		LEFT JOIN LATERAL (
+			SELECT i.id, i.number, i.description, i.start_at, i.end_at
+			FROM iterations i
+			WHERE i.project_id = p.id AND i.start_at <= $2
+			ORDER BY (i.end_at >= $2) DESC, i.start_at DESC
+			LIMIT 1
+		) ci ON true
+		LEFT JOIN LATERAL (
+			SELECT COUNT(*) FILTER (WHERE t.status = 'NotStarted') as not_started,
+			       COUNT(*) FILTER (WHERE t.status = 'InProgress') as in_progress,
+			       COUNT(*) FILTER (WHERE t.status = 'Completed') as completed,
+			       SUM(t.points) as total_points,
+			       SUM(t.points) FILTER (WHERE t.status = 'Completed') as completed_points
+			FROM tasks t
+			WHERE t.iteration_id = ci.id AND t.parent_task_id IS NULL
+		) ts ON true
+		WHERE p.id = $1
+	`
+	var project models.DashboardProject
+	var iterationID *uuid.UUID
+	var number *int
+	var description *string
+	var startAt, endAt *time.Time
+	var it models.DashboardIteration
+
+	err := r.db.QueryRow(ctx, query, projectID, now).Scan(
+		&project.ID,
+		&project.Name,
+		&project.Description,
+		&project.Color,
+		&project.MembersCount,
+		&project.IterationsCount,
+		&iterationID,
+		&number,
+		&description,
+		&startAt,
+		&endAt,
+		&it.NotStarted,
+		&it.InProgress,
+		&it.Completed,
+		&it.TotalPoints,
+		&it.CompletedPoints,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.DashboardProject{}, nil, ErrProjectNotFound
+		}
+		return models.DashboardProject{}, nil, err
+	}
+
+	if iterationID == nil {
+		return project, nil, nil
+	}
+
+	it.ID = *iterationID
+	it.Number = *number
+	if description != nil {
+		it.Description = *description
+	}
+	it.StartAt = *startAt
+	it.EndAt = *endAt
+
+	return project, &it, nil
+}
+
+// GetLatestIndicators returns the values stored for the latest iteration with indicators,
+// classified with the project ranges
+func (r *Repository) GetLatestIndicators(ctx context.Context, projectID uuid.UUID) ([]models.DashboardIndicator, error) {
+	const query = `
+		WITH latest AS (
+			SELECT ind.iteration_id, it.number,
+			       COALESCE(ind.velocity_value, 0) as velocity_value,
+			       COALESCE(ind.rework_value, 0) as rework_value,
+			       COALESCE(ind.instability_value, 0) as instability_value
+			FROM indicators ind
+			INNER JOIN iterations it ON ind.iteration_id = it.id
+			WHERE it.project_id = $1
+			ORDER BY it.start_at DESC
+			LIMIT 1
+		), vals AS (
+			SELECT iteration_id, number, 'SpeedPerIteration' as indicator_type, velocity_value as value FROM latest
+			UNION ALL
+			SELECT iteration_id, number, 'ReworkPerIteration', rework_value FROM latest
+			UNION ALL
+			SELECT iteration_id, number, 'InstabilityIndex', instability_value FROM latest
+		)
+		SELECT v.indicator_type, v.iteration_id, v.number, v.value,
+		       ir.ok_min, ir.ok_max, ir.alert_min, ir.alert_max, ir.critical_min, ir.critical_max
+		FROM vals v
+		LEFT JOIN indicator_ranges ir ON ir.project_id = $1 AND ir.indicator_type = v.indicator_type
+	`
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	indicators := []models.DashboardIndicator{}
+	for rows.Next() {
+		var ind models.DashboardIndicator
+		var okMin, okMax, alertMin, alertMax, criticalMin, criticalMax *float64
+
+		if err := rows.Scan(
+			&ind.IndicatorType,
+			&ind.IterationID,
+			&ind.IterationNumber,
+			&ind.Value,
+			&okMin, &okMax,
+			&alertMin, &alertMax,
+			&criticalMin, &criticalMax,
+		); err != nil {
+			return nil, err
+		}
+
+		if okMin != nil {
+			pr := models.ProductivityRange{
+				Ok:       models.RangeValues{Min: *okMin, Max: *okMax},
+				Alert:    models.RangeValues{Min: *alertMin, Max: *alertMax},
+				Critical: models.RangeValues{Min: *criticalMin, Max: *criticalMax},
+			}
+			ind.ProductivityLevel = pr.ClassifyValue(ind.Value)
+		}
+
+		indicators = append(indicators, ind)
+	}
+
+	return indicators, rows.Err()
+}
+
+// GetActions counts the open and overdue actions of the project and lists up to limit overdue ones,
+// the most late first
+func (r *Repository) GetActions(ctx context.Context, projectID uuid.UUID, now time.Time, limit int) (models.DashboardActions, error) {
+	const query = `
+		SELECT a.id, a.description, ir.indicator_type, a.status, a.end_at,
+		       u.id, u.name, u.email,
+		       COUNT(*) FILTER (WHERE a.status <> 'Completed') OVER () as open_count,
+		       COUNT(*) FILTER (WHERE a.status <> 'Completed' AND a.end_at < $2) OVER () as overdue_count
+		FROM actions a
+		INNER JOIN indicator_ranges ir ON a.indicator_range_id = ir.id
+		LEFT JOIN users u ON a.assignee_id = u.id
+		WHERE ir.project_id = $1
+		ORDER BY (a.status <> 'Completed' AND a.end_at < $2) DESC, a.end_at ASC NULLS LAST
+	`
+	rows, err := r.db.Query(ctx, query, projectID, now)
+	if err != nil {
+		return models.DashboardActions{}, err
+	}
+	defer rows.Close()
+
+	actions := models.DashboardActions{Items: []models.DashboardAction{}}
+	for rows.Next() {
+		var a models.DashboardAction
+		var description *string
+		var endAt *time.Time
+		var assigneeID *uuid.UUID
+		var assigneeName, assigneeEmail *string
+
+		if err := rows.Scan(
+			&a.ID,
+			&description,
+			&a.IndicatorType,
+			&a.Status,
+			&endAt,
+			&assigneeID,
+			&assigneeName,
+			&assigneeEmail,
+			&actions.Open,
+			&actions.Overdue,
+		); err != nil {
+			return models.DashboardActions{}, err
+		}
+
+		// Rows are ordered with the overdue actions first
+		if len(actions.Items) >= limit || a.Status == models.StatusCompleted || endAt == nil || !endAt.Before(now) {
+			continue
+		}
+
+		if description != nil {
+			a.Description = *description
+		}
+		a.EndAt = *endAt
+		if assigneeID != nil {
+			a.Assignee = &models.Member{ID: *assigneeID, Name: *assigneeName, Email: *assigneeEmail}
+		}
+		actions.Items = append(actions.Items, a)
+	}
+
+	return actions, rows.Err()
+}
+
+// GetReworkCounts returns the bug and improvement totals of the project and of one iteration
+func (r *Repository) GetReworkCounts(ctx context.Context, projectID uuid.UUID, iterationID *uuid.UUID) (models.DashboardCount, models.DashboardCount, error) {
+	const query = `
+		SELECT
+			(SELECT COUNT(*) FROM bugs b
+			 INNER JOIN tasks t ON b.task_id = t.id
+			 INNER JOIN iterations i ON t.iteration_id = i.id
+			 WHERE i.project_id = $1),
+			(SELECT COUNT(*) FROM bugs b
+			 INNER JOIN tasks t ON b.task_id = t.id
+			 WHERE t.iteration_id = $2),
+			(SELECT COUNT(*) FROM improvements im
+			 INNER JOIN tasks t ON im.task_id = t.id
+			 INNER JOIN iterations i ON t.iteration_id = i.id
+			 WHERE i.project_id = $1),
+			(SELECT COUNT(*) FROM improvements im
+			 INNER JOIN tasks t ON im.task_id = t.id
+			 WHERE t.iteration_id = $2)
+	`
+	var current interface{}
+	if iterationID != nil {
+		current = *iterationID
+	} else {
+		current = nil
+	}
+
+	var bugs, improvements models.DashboardCount
+	err := r.db.QueryRow(ctx, query, projectID, current).Scan(
+		&bugs.Total,
+		&bugs.CurrentIteration,
+		&improvements.Total,
+		&improvements.CurrentIteration,
+	)
+	return bugs, improvements, err
+}
+
+// GetRecentActivity returns the latest task, bug, improvement and action events of the project
+func (r *Repository) GetRecentActivity(ctx context.Context, projectID uuid.UUID, limit int) ([]models.ActivityEntry, error) {
+	const query = `
+		SELECT * FROM (
+			SELECT CASE WHEN sc.from_status IS NULL THEN 'task_created' ELSE 'task_status_changed' END as type,
+			       t.id as entity_id, t.name as title, sc.from_status, sc.to_status,
+			       u.id as actor_id, u.name as actor_name, u.email as actor_email, sc.changed_at as at
+			FROM task_status_changes sc
+			INNER JOIN tasks t ON sc.task_id = t.id
+			LEFT JOIN users u ON sc.actor_id = u.id
+			WHERE t.project_id = $1
+
+			UNION ALL
+
+			SELECT 'bug_created', b.id, COALESCE(b.description, ''), NULL, NULL,
+			       u.id, u.name, u.email, b.created_at
+			FROM bugs b
+			INNER JOIN tasks t ON b.task_id = t.id
+			LEFT JOIN users u ON b.assignee_id = u.id
+			WHERE t.project_id = $1
+
+			UNION ALL
+
+			SELECT 'improvement_created', im.id, COALESCE(im.description, ''), NULL, NULL,
+			       u.id, u.name, u.email, im.created_at
+			FROM improvements im
+			INNER JOIN tasks t ON im.task_id = t.id
+			LEFT JOIN users u ON im.assignee_id = u.id
+			WHERE t.project_id = $1
+
+			UNION ALL
+
+			SELECT 'action_created', a.id, COALESCE(a.description, ''), NULL, NULL,
+			       u.id, u.name, u.email, a.created_at
+			FROM actions a
+			INNER JOIN indicator_ranges ir ON a.indicator_range_id = ir.id
+			LEFT JOIN users u ON a.assignee_id = u.id
+			WHERE ir.project_id = $1
+		) activity
+		ORDER BY at DESC
+		LIMIT $2
+	`
+	rows, err := r.db.Query(ctx, query, projectID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []models.ActivityEntry{}
+	for rows.Next() {
+		var e models.ActivityEntry
+		var actorID *uuid.UUID
+		var actorName, actorEmail *string
+
+		if err := rows.Scan(
+			&e.Type,
+			&e.EntityID,
+			&e.Title,
+			&e.FromStatus,
+			&e.ToStatus,
+			&actorID,
+			&actorName,
+			&actorEmail,
+			&e.At,
+		); err != nil {
+			return nil, err
+		}
+
+		if actorID != nil {
+			e.Actor = &models.Member{ID: *actorID, Name: *actorName, Email: *actorEmail}
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
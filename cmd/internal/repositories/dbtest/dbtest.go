@@ -0,0 +1,64 @@
+// Package dbtest connects tests and benchmarks to a real Postgres, such as the one started by
+// docker-compose, and skips them when none is reachable
+package dbtest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"prodyo-backend/cmd/internal/migrations"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DSN reads the same DB_* variables as the API, falling back to the docker-compose defaults
+func DSN() string {
+	return fmt.Sprintf(
+		"postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		env("DB_USER", "prodyo_user"), env("DB_PASSWORD", "prodyo_password"),
+		env("DB_HOST", "localhost"), env("DB_PORT", "5432"), env("DB_NAME", "prodyo_db"),
+	)
+}
+
+// Open migrates the database and returns a pool closed at the end of tb
+// tb is skipped when the database cannot be reached
+func Open(tb testing.TB) *pgxpool.Pool {
+	tb.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	pool, err := pgxpool.New(ctx, DSN())
+	if err != nil {
+		tb.Skipf("no database available: %v", err)
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		tb.Skipf("no database available: %v", err)
+	}
+	tb.Cleanup(pool.Close)
+
+	if err := migrations.RunMigrations(DSN(), migrationsPath()); err != nil {
+		tb.Fatalf("failed to migrate test database: %v", err)
+	}
+
+	return pool
+}
+
+// migrationsPath locates cmd/migrations from this file, so it works from any package directory
+func migrationsPath() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "..", "migrations")
+}
+
+func env(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
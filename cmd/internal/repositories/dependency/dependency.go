@@ -0,0 +1,267 @@
+package dependency
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrNotFound          = errors.New("dependency not found")
+	ErrTaskNotFound      = errors.New("task not found")
+	ErrSelfDependency    = errors.New("a task cannot depend on itself")
+	ErrDifferentProjects = errors.New("tasks belong to different projects")
+	ErrCycle             = errors.New("dependency would create a cycle")
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// Add records that blockerID blocks blockedID; adding an existing dependency again is a no-op
+// Both tasks must be in the same project and the new edge must not close a cycle
+func (r *Repository) Add(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	if blockerID == blockedID {
+		return ErrSelfDependency
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	const projectsQuery = `
+		SELECT project_id
+		FROM tasks
+		WHERE id = ANY($1)
+	`
+	rows, err := tx.Query(ctx, projectsQuery, []uuid.UUID{blockerID, blockedID})
+	if err != nil {
+		return err
+	}
+	var projectIDs []uuid.UUID
+	for rows.Next() {
+		var projectID uuid.UUID
+		if err := rows.Scan(&projectID); err != nil {
+			rows.Close()
+			return err
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return rows.Err()
+	}
+	if len(projectIDs) != 2 {
+		return ErrTaskNotFound
+	}
+	if projectIDs[0] != projectIDs[1] {
+		return ErrDifferentProjects
+	}
+
+	// Two concurrent inserts could each pass the cycle check and close a cycle together,
+	// so changes to the graph of a project are serialized
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended('dependencies:' || $1::text, 0))`, projectIDs[0]); err != nil {
+		return err
+	}
+
+	// The edge closes a cycle when the blocker is already reachable from the blocked task
+	const cycleQuery = `
+		WITH RECURSIVE reachable AS (
+			SELECT blocked_id FROM task_dependencies WHERE blocker_id = $1
+			UNION
+			SELECT d.blocked_id FROM task_dependencies d INNER JOIN reachable r ON d.blocker_id = r.blocked_id
+		)
+		SELECT EXISTS (SELECT 1 FROM reachable WHERE blocked_id = $2)
+	`
+	var cycle bool
+	if err := tx.QueryRow(ctx, cycleQuery, blockedID, blockerID).Scan(&cycle); err != nil {
+		return err
+	}
+	if cycle {
+		return ErrCycle
+	}
+
+	const insertQuery = `
+		INSERT INTO task_dependencies (blocker_id, blocked_id)
+		VALUES ($1, $2)
+		ON CONFLICT (blocker_id, blocked_id) DO NOTHING
+	`
+	if _, err := tx.Exec(ctx, insertQuery, blockerID, blockedID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Remove deletes the dependency between the two tasks, whichever of them is the blocker
+func (r *Repository) Remove(ctx context.Context, taskID, otherID uuid.UUID) error {
+	const query = `
+		DELETE FROM task_dependencies
+		WHERE (blocker_id = $1 AND blocked_id = $2) OR (blocker_id = $2 AND blocked_id = $1)
+	`
+	cmd, err := r.db.Exec(ctx, query, taskID, otherID)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// GetByTaskIDs loads both sides of the dependencies of several tasks in one query:
+// the tasks blocking each of them and the tasks each of them blocks
+func (r *Repository) GetByTaskIDs(ctx context.Context, taskIDs []uuid.UUID) (blockedBy, blocks map[uuid.UUID][]models.TaskRef, err error) {
+	blockedBy = make(map[uuid.UUID][]models.TaskRef)
+	blocks = make(map[uuid.UUID][]models.TaskRef)
+	if len(taskIDs) == 0 {
+		return blockedBy, blocks, nil
+	}
+
+	const query = `
+		SELECT d.blocked_id, true, t.id, t.iteration_id, t.name, t.status
+		FROM task_dependencies d
+		INNER JOIN tasks t ON d.blocker_id = t.id
+		WHERE d.blocked_id = ANY($1)
+		UNION ALL
+		SELECT d.blocker_id, false, t.id, t.iteration_id, t.name, t.status
+		FROM task_dependencies d
+		INNER JOIN tasks t ON d.blocked_id = t.id
+		WHERE d.blocker_id = ANY($1)
+		ORDER BY 5 ASC, 3 ASC
+	`
+	rows, err := r.db.Query(ctx, query, taskIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var taskID uuid.UUID
+		var isBlocker bool
+		var ref models.TaskRef
+		if err := rows.Scan(&taskID, &isBlocker, &ref.ID, &ref.IterationID, &ref.Name, &ref.Status); err != nil {
+			return nil, nil, err
+		}
+		if isBlocker {
+			blockedBy[taskID] = append(blockedBy[taskID], ref)
+		} else {
+			blocks[taskID] = append(blocks[taskID], ref)
+		}
+	}
+
+	return blockedBy, blocks, rows.Err()
+}
+
+// GetUnfinishedBlockersTx returns the tasks blocking the given one that are not completed yet,
+// as seen by a transaction owned by another repository
+func (r *Repository) GetUnfinishedBlockersTx(ctx context.Context, tx pgx.Tx, taskID uuid.UUID) ([]models.TaskRef, error) {
+	const query = `
+		SELECT t.id, t.iteration_id, t.name, t.status
+		FROM task_dependencies d
+		INNER JOIN tasks t ON d.blocker_id = t.id
+		WHERE d.blocked_id = $1 AND t.status <> 'Completed'
+		ORDER BY t.name ASC, t.id ASC
+	`
+	rows, err := tx.Query(ctx, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	blockers := []models.TaskRef{}
+	for rows.Next() {
+		var ref models.TaskRef
+		if err := rows.Scan(&ref.ID, &ref.IterationID, &ref.Name, &ref.Status); err != nil {
+			return nil, err
+		}
+		blockers = append(blockers, ref)
+	}
+
+	return blockers, rows.Err()
+}
+
+// GetIterationGraph returns every task of the iteration, sub-tasks included, with the dependencies
+// touching any of them; tasks of other iterations on the far end of an edge are marked external
+func (r *Repository) GetIterationGraph(ctx context.Context, iterationID uuid.UUID) (models.DependencyGraph, error) {
+	graph := models.DependencyGraph{
+		IterationID: iterationID,
+		Nodes:       []models.DependencyNode{},
+		Edges:       []models.DependencyEdge{},
+	}
+
+	const edgesQuery = `
+		SELECT d.blocker_id, d.blocked_id
+		FROM task_dependencies d
+		INNER JOIN tasks a ON d.blocker_id = a.id
+		INNER JOIN tasks b ON d.blocked_id = b.id
+		WHERE a.iteration_id = $1 OR b.iteration_id = $1
+		ORDER BY d.created_at ASC, d.blocker_id ASC, d.blocked_id ASC
+	`
+	rows, err := r.db.Query(ctx, edgesQuery, iterationID)
+	if err != nil {
+		return models.DependencyGraph{}, err
+	}
+	linked := []uuid.UUID{}
+	for rows.Next() {
+		var e models.DependencyEdge
+		if err := rows.Scan(&e.BlockerID, &e.BlockedID); err != nil {
+			rows.Close()
+			return models.DependencyGraph{}, err
+		}
+		graph.Edges = append(graph.Edges, e)
+		linked = append(linked, e.BlockerID, e.BlockedID)
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return models.DependencyGraph{}, rows.Err()
+	}
+
+	const nodesQuery = `
+		SELECT t.id, t.iteration_id, t.name, t.status, t.parent_task_id
+		FROM tasks t
+		WHERE t.iteration_id = $1 OR t.id = ANY($2)
+		ORDER BY t.iteration_id IS DISTINCT FROM $1, t.created_at ASC, t.id ASC
+	`
+	rows, err = r.db.Query(ctx, nodesQuery, iterationID, linked)
+	if err != nil {
+		return models.DependencyGraph{}, err
+	}
+	defer rows.Close()
+
+	status := make(map[uuid.UUID]models.StatusEnum)
+	for rows.Next() {
+		var n models.DependencyNode
+		if err := rows.Scan(&n.ID, &n.IterationID, &n.Name, &n.Status, &n.ParentTaskID); err != nil {
+			return models.DependencyGraph{}, err
+		}
+		n.External = n.IterationID == nil || *n.IterationID != iterationID
+		status[n.ID] = n.Status
+		graph.Nodes = append(graph.Nodes, n)
+	}
+	if rows.Err() != nil {
+		return models.DependencyGraph{}, rows.Err()
+	}
+
+	blocked := make(map[uuid.UUID]bool)
+	for _, e := range graph.Edges {
+		if status[e.BlockerID] != models.StatusCompleted {
+			blocked[e.BlockedID] = true
+		}
+	}
+	for i := range graph.Nodes {
+		graph.Nodes[i].Blocked = blocked[graph.Nodes[i].ID]
+	}
+
+	return graph, nil
+}
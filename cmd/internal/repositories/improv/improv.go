@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"prodyo-backend/cmd/internal/models"
+	labelRepo "prodyo-backend/cmd/internal/repositories/label"
+	"prodyo-backend/cmd/internal/repositories/listquery"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,29 +18,108 @@ var (
 )
 
 type Repository struct {
-	db *pgxpool.Pool
+	db        *pgxpool.Pool
+	labelRepo *labelRepo.Repository
 }
 
 func New(db *pgxpool.Pool) *Repository {
-	return &Repository{db: db}
+	return &Repository{
+		db:        db,
+		labelRepo: labelRepo.New(db),
+	}
+}
+
+// listColumns maps the fields of models.ImprovementListSpec to columns
+var listColumns = listquery.Columns{
+	"assignee_id": "i.assignee_id",
+	"label":       "EXISTS (SELECT 1 FROM improvement_labels bl INNER JOIN labels lb ON bl.label_id = lb.id WHERE bl.improvement_id = i.id AND lb.name %s)",
+	"label_id":    "EXISTS (SELECT 1 FROM improvement_labels bl WHERE bl.improvement_id = i.id AND bl.label_id %s)",
+	"number":      "i.number",
+	"points":      "i.points",
+	"created_at":  "i.created_at",
+	"updated_at":  "i.updated_at",
 }
 
-func (r *Repository) GetAll(ctx context.Context, taskID uuid.UUID) ([]models.Improv, error) {
+// GetAll returns the improvements of the task matching the query, by number unless sorted otherwise
+func (r *Repository) GetAll(ctx context.Context, taskID uuid.UUID, q models.ListQuery) ([]models.Improv, error) {
+	b := listquery.NewBuilder(taskID)
+	b.Where("i.task_id = $1")
+	if err := b.Filters(q, listColumns); err != nil {
+		return nil, err
+	}
+	b.Search(q.Search, "COALESCE(i.description, '')")
+
+	orderBy, err := listquery.OrderBy(q, listColumns, "i.number ASC, i.id ASC", "i.id ASC")
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT i.id, i.task_id, i.number, i.description, i.points, i.created_at, i.updated_at,
+		       u.id as assignee_id, u.name as assignee_name, u.email as assignee_email,
+		       u.created_at as assignee_created_at, u.updated_at as assignee_updated_at
+		FROM improvements i
+		LEFT JOIN users u ON i.assignee_id = u.id
+		` + b.WhereClause() + `
+		` + orderBy
+	rows, err := r.db.Query(ctx, query, b.Args()...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	improvements, err := scanImprovs(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(improvements))
+	for i, item := range improvements {
+		ids[i] = item.ID
+	}
+
+	labels, err := r.labelRepo.GetByImprovementIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range improvements {
+		improvements[i].Labels = labels[improvements[i].ID]
+	}
+
+	return improvements, nil
+}
+
+// GetAllByTaskIDs loads the improvements of several tasks in one query, grouped by task
+func (r *Repository) GetAllByTaskIDs(ctx context.Context, taskIDs []uuid.UUID) (map[uuid.UUID][]models.Improv, error) {
 	const query = `
 		SELECT i.id, i.task_id, i.number, i.description, i.points, i.created_at, i.updated_at,
 		       u.id as assignee_id, u.name as assignee_name, u.email as assignee_email,
 		       u.created_at as assignee_created_at, u.updated_at as assignee_updated_at
 		FROM improvements i
 		LEFT JOIN users u ON i.assignee_id = u.id
-		WHERE i.task_id = $1
-		ORDER BY i.number ASC
+		WHERE i.task_id = ANY($1)
+		ORDER BY i.task_id, i.number ASC
 	`
-	rows, err := r.db.Query(ctx, query, taskID)
+	rows, err := r.db.Query(ctx, query, taskIDs)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	improvements, err := scanImprovs(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	byTask := make(map[uuid.UUID][]models.Improv)
+	for _, item := range improvements {
+		byTask[item.TaskID] = append(byTask[item.TaskID], item)
+	}
+
+	return byTask, nil
+}
+
+func scanImprovs(rows pgx.Rows) ([]models.Improv, error) {
 	var improvements []models.Improv
 	for rows.Next() {
 		var imp models.Improv
@@ -124,6 +205,12 @@ func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Improv,
 		}
 	}
 
+	labels, err := r.labelRepo.GetByImprovementIDs(ctx, []uuid.UUID{imp.ID})
+	if err != nil {
+		return models.Improv{}, err
+	}
+	imp.Labels = labels[imp.ID]
+
 	return imp, nil
 }
 
@@ -215,6 +215,39 @@ func (r *Repository) CreateDefaultRanges(ctx context.Context, projectID uuid.UUI
 				Critical: models.RangeValues{Min: 0.4, Max: 1},
 			},
 		},
+		{
+			// Hours from creation to completion
+			ID:            uuid.New(),
+			ProjectID:     projectID,
+			IndicatorType: models.IndicatorLeadTime,
+			Range: models.ProductivityRange{
+				Ok:       models.RangeValues{Min: 0, Max: 72},
+				Alert:    models.RangeValues{Min: 72, Max: 168},
+				Critical: models.RangeValues{Min: 168, Max: 100000},
+			},
+		},
+		{
+			// Hours from first InProgress to completion
+			ID:            uuid.New(),
+			ProjectID:     projectID,
+			IndicatorType: models.IndicatorCycleTime,
+			Range: models.ProductivityRange{
+				Ok:       models.RangeValues{Min: 0, Max: 24},
+				Alert:    models.RangeValues{Min: 24, Max: 72},
+				Critical: models.RangeValues{Min: 72, Max: 100000},
+			},
+		},
+		{
+			// Mean absolute percentage error of the estimates
+			ID:            uuid.New(),
+			ProjectID:     projectID,
+			IndicatorType: models.IndicatorEstimateAccuracy,
+			Range: models.ProductivityRange{
+				Ok:       models.RangeValues{Min: 0, Max: 20},
+				Alert:    models.RangeValues{Min: 20, Max: 50},
+				Critical: models.RangeValues{Min: 50, Max: 100000},
+			},
+		},
 	}
 
 	for _, ir := range defaults {
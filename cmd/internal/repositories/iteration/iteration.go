@@ -7,11 +7,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var (
-	ErrNotFound = errors.New("iteration not found")
+	ErrNotFound        = errors.New("iteration not found")
+	ErrProjectNotFound = errors.New("project not found")
 )
 
 type Repository struct {
@@ -84,24 +86,163 @@ func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Iteratio
 	return it, nil
 }
 
-func (r *Repository) Create(ctx context.Context, iteration models.Iteration) error {
-	const query = `
-		INSERT INTO iterations (id, project_id, number, description, start_at, end_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
-	`
+// Create numbers the iteration after the last one of its project and inserts it, unless its dates
+// overlap another iteration of the project; the project is locked so concurrent creations queue up
+func (r *Repository) Create(ctx context.Context, iteration models.Iteration) (models.Iteration, error) {
 	if iteration.ID == uuid.Nil {
 		iteration.ID = uuid.New()
 	}
 
-	_, err := r.db.Exec(ctx, query,
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return models.Iteration{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended('iterations:' || $1::text, 0))`, iteration.ProjectID); err != nil {
+		return models.Iteration{}, err
+	}
+
+	conflicts, err := r.overlapping(ctx, tx, iteration)
+	if err != nil {
+		return models.Iteration{}, err
+	}
+	if len(conflicts) > 0 {
+		return models.Iteration{}, &models.IterationError{
+			Code:      models.IterationOverlap,
+			Message:   "iteration dates overlap other iterations of the project",
+			Conflicts: conflicts,
+		}
+	}
+
+	const query = `
+		INSERT INTO iterations (id, project_id, number, description, start_at, end_at)
+		SELECT $1, $2, COALESCE(MAX(number), 0) + 1, $3, $4, $5
+		FROM iterations
+		WHERE project_id = $2
+		RETURNING number, created_at, updated_at
+	`
+	err = tx.QueryRow(ctx, query,
 		iteration.ID,
 		iteration.ProjectID,
-		iteration.Number,
 		iteration.Description,
 		iteration.StartAt,
 		iteration.EndAt,
-	)
-	return err
+	).Scan(&iteration.Number, &iteration.CreatedAt, &iteration.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return models.Iteration{}, ErrProjectNotFound
+		}
+		return models.Iteration{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Iteration{}, err
+	}
+	iteration.Tasks = []models.Task{}
+	return iteration, nil
+}
+
+// overlapping returns the iterations of the project sharing time with the given one; an iteration
+// may start at the very instant the previous one ends
+func (r *Repository) overlapping(ctx context.Context, tx pgx.Tx, iteration models.Iteration) ([]models.Iteration, error) {
+	const query = `
+		SELECT id, project_id, number, description, start_at, end_at, created_at, updated_at
+		FROM iterations
+		WHERE project_id = $1 AND id <> $2 AND start_at < $4 AND end_at > $3
+		ORDER BY start_at ASC
+	`
+	rows, err := tx.Query(ctx, query, iteration.ProjectID, iteration.ID, iteration.StartAt, iteration.EndAt)
+	if err != nil {
+		return nil, err
+	}
+	return scanIterations(rows)
+}
+
+// GetLatest returns the iteration of the project that starts last
+func (r *Repository) GetLatest(ctx context.Context, projectID uuid.UUID) (models.Iteration, error) {
+	const query = `
+		SELECT id, project_id, number, description, start_at, end_at, created_at, updated_at
+		FROM iterations
+		WHERE project_id = $1
+		ORDER BY start_at DESC, number DESC
+		LIMIT 1
+	`
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return models.Iteration{}, err
+	}
+	iterations, err := scanIterations(rows)
+	if err != nil {
+		return models.Iteration{}, err
+	}
+	if len(iterations) == 0 {
+		return models.Iteration{}, ErrNotFound
+	}
+	return iterations[0], nil
+}
+
+// GetDuplicateNumbers lists the numbers held by more than one iteration of the same project, limited to
+// the projects managerID owns or maintains and to projectID when it is not nil
+func (r *Repository) GetDuplicateNumbers(ctx context.Context, projectID *uuid.UUID, managerID uuid.UUID) ([]models.DuplicateIterationNumber, error) {
+	const query = `
+		SELECT i.id, i.project_id, i.number, i.description, i.start_at, i.end_at, i.created_at, i.updated_at
+		FROM iterations i
+		WHERE ($1::uuid IS NULL OR i.project_id = $1)
+		  AND EXISTS (
+			SELECT 1 FROM project_members pm
+			WHERE pm.project_id = i.project_id AND pm.user_id = $2 AND pm.role IN ('owner', 'maintainer')
+		  )
+		  AND EXISTS (
+			SELECT 1 FROM iterations d
+			WHERE d.project_id = i.project_id AND d.number = i.number AND d.id <> i.id
+		  )
+		ORDER BY i.project_id ASC, i.number ASC, i.start_at ASC
+	`
+	rows, err := r.db.Query(ctx, query, projectID, managerID)
+	if err != nil {
+		return nil, err
+	}
+	iterations, err := scanIterations(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicates := []models.DuplicateIterationNumber{}
+	for _, it := range iterations {
+		last := len(duplicates) - 1
+		if last < 0 || duplicates[last].ProjectID != it.ProjectID || duplicates[last].Number != it.Number {
+			duplicates = append(duplicates, models.DuplicateIterationNumber{ProjectID: it.ProjectID, Number: it.Number})
+			last++
+		}
+		duplicates[last].Iterations = append(duplicates[last].Iterations, it)
+	}
+	return duplicates, nil
+}
+
+func scanIterations(rows pgx.Rows) ([]models.Iteration, error) {
+	defer rows.Close()
+
+	iterations := []models.Iteration{}
+	for rows.Next() {
+		var it models.Iteration
+		if err := rows.Scan(
+			&it.ID,
+			&it.ProjectID,
+			&it.Number,
+			&it.Description,
+			&it.StartAt,
+			&it.EndAt,
+			&it.CreatedAt,
+			&it.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		it.Tasks = []models.Task{}
+		iterations = append(iterations, it)
+	}
+	return iterations, rows.Err()
 }
 
 func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
@@ -0,0 +1,253 @@
+package label
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrNotFound          = errors.New("label not found")
+	ErrDuplicateName     = errors.New("a label with this name already exists in the project")
+	ErrLabelNotInProject = errors.New("label does not belong to the project of the item")
+)
+
+// link describes the join table between labels and one kind of item
+// and how to find the project of an item of that kind
+type link struct {
+	table        string
+	column       string
+	projectQuery string
+}
+
+var (
+	taskLink = link{
+		table:        "task_labels",
+		column:       "task_id",
+		projectQuery: `SELECT project_id FROM tasks WHERE id = $1`,
+	}
+	bugLink = link{
+		table:  "bug_labels",
+		column: "bug_id",
+		projectQuery: `SELECT t.project_id FROM bugs b
+			INNER JOIN tasks t ON b.task_id = t.id
+			WHERE b.id = $1`,
+	}
+	improvementLink = link{
+		table:  "improvement_labels",
+		column: "improvement_id",
+		projectQuery: `SELECT t.project_id FROM improvements im
+			INNER JOIN tasks t ON im.task_id = t.id
+			WHERE im.id = $1`,
+	}
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]models.Label, error) {
+	const query = `
+		SELECT id, project_id, name, COALESCE(color, ''), created_at, updated_at
+		FROM labels
+		WHERE project_id = $1
+		ORDER BY name ASC
+	`
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	labels := []models.Label{}
+	for rows.Next() {
+		var l models.Label
+		if err := rows.Scan(&l.ID, &l.ProjectID, &l.Name, &l.Color, &l.CreatedAt, &l.UpdatedAt); err != nil {
+			return nil, err
+		}
+		labels = append(labels, l)
+	}
+
+	return labels, rows.Err()
+}
+
+func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Label, error) {
+	const query = `
+		SELECT id, project_id, name, COALESCE(color, ''), created_at, updated_at
+		FROM labels
+		WHERE id = $1
+	`
+	var l models.Label
+	err := r.db.QueryRow(ctx, query, id).Scan(&l.ID, &l.ProjectID, &l.Name, &l.Color, &l.CreatedAt, &l.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Label{}, ErrNotFound
+		}
+		return models.Label{}, err
+	}
+	return l, nil
+}
+
+func (r *Repository) Create(ctx context.Context, l models.Label) error {
+	const query = `
+		INSERT INTO labels (id, project_id, name, color)
+		VALUES ($1, $2, $3, $4)
+	`
+	_, err := r.db.Exec(ctx, query, l.ID, l.ProjectID, l.Name, nullableColor(l.Color))
+	return translateError(err)
+}
+
+// Update renames or recolors the label; items keep pointing at it so the change shows everywhere
+func (r *Repository) Update(ctx context.Context, l models.Label) error {
+	const query = `
+		UPDATE labels
+		SET name = $2, color = $3
+		WHERE id = $1 AND project_id = $4
+	`
+	cmd, err := r.db.Exec(ctx, query, l.ID, l.Name, nullableColor(l.Color), l.ProjectID)
+	if err != nil {
+		return translateError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) Delete(ctx context.Context, projectID, id uuid.UUID) error {
+	const query = `DELETE FROM labels WHERE id = $1 AND project_id = $2`
+	cmd, err := r.db.Exec(ctx, query, id, projectID)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SetTaskLabels replaces the labels of a task
+func (r *Repository) SetTaskLabels(ctx context.Context, taskID uuid.UUID, labelIDs []uuid.UUID) error {
+	return r.setLabels(ctx, taskLink, taskID, labelIDs)
+}
+
+// SetBugLabels replaces the labels of a bug
+func (r *Repository) SetBugLabels(ctx context.Context, bugID uuid.UUID, labelIDs []uuid.UUID) error {
+	return r.setLabels(ctx, bugLink, bugID, labelIDs)
+}
+
+// SetImprovementLabels replaces the labels of an improvement
+func (r *Repository) SetImprovementLabels(ctx context.Context, improvementID uuid.UUID, labelIDs []uuid.UUID) error {
+	return r.setLabels(ctx, improvementLink, improvementID, labelIDs)
+}
+
+// setLabels replaces the labels of an item in one transaction
+// Only labels of the item's project are linked; any other ID fails with ErrLabelNotInProject
+func (r *Repository) setLabels(ctx context.Context, l link, itemID uuid.UUID, labelIDs []uuid.UUID) error {
+	unique := make([]uuid.UUID, 0, len(labelIDs))
+	seen := make(map[uuid.UUID]bool)
+	for _, id := range labelIDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM `+l.table+` WHERE `+l.column+` = $1`, itemID); err != nil {
+		return err
+	}
+
+	if len(unique) > 0 {
+		insert := `
+			INSERT INTO ` + l.table + ` (` + l.column + `, label_id)
+			SELECT $1::uuid, lb.id FROM labels lb
+			WHERE lb.id = ANY($2) AND lb.project_id = (` + l.projectQuery + `)
+		`
+		cmd, err := tx.Exec(ctx, insert, itemID, unique)
+		if err != nil {
+			return err
+		}
+		if cmd.RowsAffected() != int64(len(unique)) {
+			return ErrLabelNotInProject
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetByTaskIDs loads the labels of several tasks in one query, grouped by task
+func (r *Repository) GetByTaskIDs(ctx context.Context, taskIDs []uuid.UUID) (map[uuid.UUID][]models.Label, error) {
+	return r.getByItemIDs(ctx, taskLink, taskIDs)
+}
+
+// GetByBugIDs loads the labels of several bugs in one query, grouped by bug
+func (r *Repository) GetByBugIDs(ctx context.Context, bugIDs []uuid.UUID) (map[uuid.UUID][]models.Label, error) {
+	return r.getByItemIDs(ctx, bugLink, bugIDs)
+}
+
+// GetByImprovementIDs loads the labels of several improvements in one query, grouped by improvement
+func (r *Repository) GetByImprovementIDs(ctx context.Context, improvementIDs []uuid.UUID) (map[uuid.UUID][]models.Label, error) {
+	return r.getByItemIDs(ctx, improvementLink, improvementIDs)
+}
+
+func (r *Repository) getByItemIDs(ctx context.Context, l link, itemIDs []uuid.UUID) (map[uuid.UUID][]models.Label, error) {
+	labels := make(map[uuid.UUID][]models.Label)
+	if len(itemIDs) == 0 {
+		return labels, nil
+	}
+
+	query := `
+		SELECT il.` + l.column + `, lb.id, lb.project_id, lb.name, COALESCE(lb.color, ''), lb.created_at, lb.updated_at
+		FROM ` + l.table + ` il
+		INNER JOIN labels lb ON il.label_id = lb.id
+		WHERE il.` + l.column + ` = ANY($1)
+		ORDER BY lb.name ASC
+	`
+	rows, err := r.db.Query(ctx, query, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var itemID uuid.UUID
+		var lb models.Label
+		if err := rows.Scan(&itemID, &lb.ID, &lb.ProjectID, &lb.Name, &lb.Color, &lb.CreatedAt, &lb.UpdatedAt); err != nil {
+			return nil, err
+		}
+		labels[itemID] = append(labels[itemID], lb)
+	}
+
+	return labels, rows.Err()
+}
+
+func nullableColor(color string) interface{} {
+	if color == "" {
+		return nil
+	}
+	return color
+}
+
+// translateError maps the unique (project_id, name) violation to ErrDuplicateName
+func translateError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return ErrDuplicateName
+	}
+	return err
+}
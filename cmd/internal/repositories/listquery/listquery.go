@@ -0,0 +1,126 @@
+package listquery
+
+import (
+	"fmt"
+	"prodyo-backend/cmd/internal/models"
+	"strings"
+)
+
+// Columns maps the list fields accepted by an endpoint to SQL expressions
+// An expression containing %s is a template: the comparison ("= $1", "IN ($1, $2)", ...) replaces %s,
+// which lets a field filter through a subquery
+type Columns map[string]string
+
+// Builder collects the WHERE conditions of a list query and their arguments
+// Values never end up in the SQL text, only their placeholders
+type Builder struct {
+	conditions []string
+	args       []interface{}
+}
+
+// NewBuilder starts a builder whose first placeholders are taken by args
+func NewBuilder(args ...interface{}) *Builder {
+	return &Builder{args: args}
+}
+
+// Arg appends a query argument and returns its placeholder
+func (b *Builder) Arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+// Where adds a condition built by the caller
+func (b *Builder) Where(condition string) {
+	b.conditions = append(b.conditions, condition)
+}
+
+// Filters adds one condition per filter of the query
+func (b *Builder) Filters(q models.ListQuery, columns Columns) error {
+	for _, f := range q.Filters {
+		column, ok := columns[f.Field]
+		if !ok {
+			return fmt.Errorf("no column for filter field %s", f.Field)
+		}
+
+		var comparison string
+		switch f.Op {
+		case models.FilterEq:
+			comparison = "= " + b.Arg(f.Values[0])
+		case models.FilterIn:
+			placeholders := make([]string, len(f.Values))
+			for i, v := range f.Values {
+				placeholders[i] = b.Arg(v)
+			}
+			comparison = "IN (" + strings.Join(placeholders, ", ") + ")"
+		case models.FilterGte:
+			comparison = ">= " + b.Arg(f.Values[0])
+		case models.FilterLte:
+			comparison = "<= " + b.Arg(f.Values[0])
+		case models.FilterGt:
+			comparison = "> " + b.Arg(f.Values[0])
+		case models.FilterLt:
+			comparison = "< " + b.Arg(f.Values[0])
+		default:
+			return fmt.Errorf("unsupported filter operator %s", f.Op)
+		}
+
+		if strings.Contains(column, "%s") {
+			b.Where(fmt.Sprintf(column, comparison))
+		} else {
+			b.Where(column + " " + comparison)
+		}
+	}
+	return nil
+}
+
+// Search matches the term as a case-insensitive substring of any of the columns
+func (b *Builder) Search(term string, columns ...string) {
+	if term == "" || len(columns) == 0 {
+		return
+	}
+
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(term)
+	placeholder := b.Arg("%" + escaped + "%")
+
+	matches := make([]string, len(columns))
+	for i, c := range columns {
+		matches[i] = c + " ILIKE " + placeholder
+	}
+	b.Where("(" + strings.Join(matches, " OR ") + ")")
+}
+
+// WhereClause returns the conditions joined with AND, prefixed by WHERE, or an empty string
+func (b *Builder) WhereClause() string {
+	if len(b.conditions) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(b.conditions, " AND ")
+}
+
+func (b *Builder) Args() []interface{} {
+	return b.args
+}
+
+// OrderBy builds the ORDER BY clause of the query sort, falling back to defaultOrder when the query has none
+// tieBreaker is appended so rows with equal sort values keep a stable order
+func OrderBy(q models.ListQuery, columns Columns, defaultOrder, tieBreaker string) (string, error) {
+	if len(q.Sort) == 0 {
+		return "ORDER BY " + defaultOrder, nil
+	}
+
+	parts := make([]string, 0, len(q.Sort)+1)
+	for _, s := range q.Sort {
+		column, ok := columns[s.Field]
+		if !ok || strings.Contains(column, "%s") {
+			return "", fmt.Errorf("no column for sort field %s", s.Field)
+		}
+		if s.Desc {
+			parts = append(parts, column+" DESC")
+		} else {
+			parts = append(parts, column+" ASC")
+		}
+	}
+	parts = append(parts, tieBreaker)
+
+	return "ORDER BY " + strings.Join(parts, ", "), nil
+}
@@ -0,0 +1,167 @@
+package listquery
+
+import (
+	"reflect"
+	"testing"
+
+	"prodyo-backend/cmd/internal/models"
+)
+
+var testColumns = Columns{
+	"name":   "t.name",
+	"points": "t.points",
+	"status": "t.status",
+	"label":  "EXISTS (SELECT 1 FROM task_labels tl JOIN labels l ON l.id = tl.label_id WHERE tl.task_id = t.id AND l.name %s)",
+}
+
+func TestFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    []interface{}
+		filters []models.FilterCondition
+		where   string
+		args    []interface{}
+		wantErr bool
+	}{
+		{
+			name:  "no filters",
+			where: "",
+		},
+		{
+			name:    "equality after the base arguments",
+			base:    []interface{}{"iteration"},
+			filters: []models.FilterCondition{{Field: "name", Op: models.FilterEq, Values: []interface{}{"login"}}},
+			where:   "WHERE t.name = $2",
+			args:    []interface{}{"iteration", "login"},
+		},
+		{
+			name:    "in list",
+			filters: []models.FilterCondition{{Field: "status", Op: models.FilterIn, Values: []interface{}{"NotStarted", "InProgress"}}},
+			where:   "WHERE t.status IN ($1, $2)",
+			args:    []interface{}{"NotStarted", "InProgress"},
+		},
+		{
+			name: "ranges joined with AND",
+			filters: []models.FilterCondition{
+				{Field: "points", Op: models.FilterGte, Values: []interface{}{2}},
+				{Field: "points", Op: models.FilterLt, Values: []interface{}{8}},
+			},
+			where: "WHERE t.points >= $1 AND t.points < $2",
+			args:  []interface{}{2, 8},
+		},
+		{
+			name:    "template column",
+			filters: []models.FilterCondition{{Field: "label", Op: models.FilterEq, Values: []interface{}{"backend"}}},
+			where:   "WHERE EXISTS (SELECT 1 FROM task_labels tl JOIN labels l ON l.id = tl.label_id WHERE tl.task_id = t.id AND l.name = $1)",
+			args:    []interface{}{"backend"},
+		},
+		{
+			name:    "unknown field",
+			filters: []models.FilterCondition{{Field: "password", Op: models.FilterEq, Values: []interface{}{"x"}}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown operator",
+			filters: []models.FilterCondition{{Field: "name", Op: "like", Values: []interface{}{"x"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBuilder(tt.base...)
+			err := b.Filters(models.ListQuery{Filters: tt.filters}, testColumns)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := b.WhereClause(); got != tt.where {
+				t.Errorf("where = %q, want %q", got, tt.where)
+			}
+			if !reflect.DeepEqual(b.Args(), tt.args) && len(b.Args())+len(tt.args) > 0 {
+				t.Errorf("args = %v, want %v", b.Args(), tt.args)
+			}
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name    string
+		term    string
+		columns []string
+		where   string
+		args    []interface{}
+	}{
+		{name: "empty term", term: "", columns: []string{"t.name"}, where: ""},
+		{name: "no columns", term: "x", where: ""},
+		{
+			name:    "one placeholder for every column",
+			term:    "login",
+			columns: []string{"t.name", "t.description"},
+			where:   "WHERE (t.name ILIKE $1 OR t.description ILIKE $1)",
+			args:    []interface{}{"%login%"},
+		},
+		{
+			name:    "wildcards are escaped",
+			term:    `50%_off\`,
+			columns: []string{"t.name"},
+			where:   "WHERE (t.name ILIKE $1)",
+			args:    []interface{}{`%50\%\_off\\%`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBuilder()
+			b.Search(tt.term, tt.columns...)
+			if got := b.WhereClause(); got != tt.where {
+				t.Errorf("where = %q, want %q", got, tt.where)
+			}
+			if !reflect.DeepEqual(b.Args(), tt.args) && len(b.Args())+len(tt.args) > 0 {
+				t.Errorf("args = %v, want %v", b.Args(), tt.args)
+			}
+		})
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		sort    []models.SortField
+		want    string
+		wantErr bool
+	}{
+		{name: "default order", want: "ORDER BY t.rank ASC, t.id ASC"},
+		{
+			name: "sort fields then tie breaker",
+			sort: []models.SortField{{Field: "points", Desc: true}, {Field: "name"}},
+			want: "ORDER BY t.points DESC, t.name ASC, t.id ASC",
+		},
+		{name: "unknown field", sort: []models.SortField{{Field: "password"}}, wantErr: true},
+		{name: "template column", sort: []models.SortField{{Field: "label"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := OrderBy(models.ListQuery{Sort: tt.sort}, testColumns, "t.rank ASC, t.id ASC", "t.id ASC")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrNotFound = errors.New("notification not found")
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// GetByUserID returns the notifications of a user, newest first
+// unreadOnly skips the ones already marked as read
+func (r *Repository) GetByUserID(ctx context.Context, userID uuid.UUID, unreadOnly bool, pagination models.PaginationRequest) ([]models.Notification, models.PaginationResponse, error) {
+	where := `WHERE n.user_id = $1`
+	scope := models.CursorScope("notifications", "created_at")
+	if unreadOnly {
+		where += ` AND n.read_at IS NULL`
+		scope = models.CursorScope("unread-notifications", "created_at")
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM notifications n `+where, userID).Scan(&total); err != nil {
+		return nil, models.PaginationResponse{}, err
+	}
+
+	offset := pagination.GetOffset()
+	args := []interface{}{userID, pagination.PageSize + 1, offset}
+
+	cursor, err := pagination.DecodeCursor(scope)
+	if err != nil {
+		return nil, models.PaginationResponse{}, err
+	}
+	if cursor != nil {
+		at, err := cursor.Time()
+		if err != nil {
+			return nil, models.PaginationResponse{}, err
+		}
+		where += ` AND (n.created_at, n.id) < ($4::timestamptz, $5::uuid)`
+		args = append(args, at, cursor.ID)
+	}
+
+	query := `
+		SELECT n.id, n.user_id, n.type, n.read_at, n.created_at,
+			n.actor_id, COALESCE(a.name, ''), COALESCE(a.email, ''),
+			c.id, c.project_id, COALESCE(c.task_id, c.bug_id, c.improvement_id, c.action_id),
+			CASE
+				WHEN c.task_id IS NOT NULL THEN 'task'
+				WHEN c.bug_id IS NOT NULL THEN 'bug'
+				WHEN c.improvement_id IS NOT NULL THEN 'improvement'
+				WHEN c.action_id IS NOT NULL THEN 'action'
+			END,
+			c.body
+		FROM notifications n
+		LEFT JOIN users a ON n.actor_id = a.id
+		LEFT JOIN comments c ON n.comment_id = c.id
+		` + where + `
+		ORDER BY n.created_at DESC, n.id DESC
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, models.PaginationResponse{}, err
+	}
+	defer rows.Close()
+
+	notifications := []models.Notification{}
+	for rows.Next() {
+		var n models.Notification
+		var actorID, commentID, projectID, targetID *uuid.UUID
+		var actorName, actorEmail string
+		var targetType, body *string
+		if err := rows.Scan(
+			&n.ID,
+			&n.UserID,
+			&n.Type,
+			&n.ReadAt,
+			&n.CreatedAt,
+			&actorID,
+			&actorName,
+			&actorEmail,
+			&commentID,
+			&projectID,
+			&targetID,
+			&targetType,
+			&body,
+		); err != nil {
+			return nil, models.PaginationResponse{}, err
+		}
+
+		if actorID != nil {
+			n.Actor = &models.Member{ID: *actorID, Name: actorName, Email: actorEmail}
+		}
+		if commentID != nil {
+			n.Comment = &models.NotificationComment{
+				ID:         *commentID,
+				ProjectID:  *projectID,
+				TargetType: models.CommentTargetType(*targetType),
+				TargetID:   *targetID,
+				Body:       *body,
+			}
+		}
+
+		notifications = append(notifications, n)
+	}
+
+	if rows.Err() != nil {
+		return nil, models.PaginationResponse{}, rows.Err()
+	}
+
+	var nextCursor string
+	if len(notifications) > pagination.PageSize {
+		notifications = notifications[:pagination.PageSize]
+		last := notifications[len(notifications)-1]
+		nextCursor = models.EncodeTimeCursor(scope, last.CreatedAt, last.ID)
+	}
+
+	return notifications, models.NewPagePaginationResponse(pagination, total, nextCursor), nil
+}
+
+// CountUnread returns how many notifications of the user are not read yet
+func (r *Repository) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	const query = `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`
+	var count int64
+	err := r.db.QueryRow(ctx, query, userID).Scan(&count)
+	return count, err
+}
+
+// MarkRead marks one notification of the user as read; marking it again keeps the first read time
+func (r *Repository) MarkRead(ctx context.Context, userID, id uuid.UUID) error {
+	const query = `
+		UPDATE notifications
+		SET read_at = COALESCE(read_at, NOW())
+		WHERE id = $1 AND user_id = $2
+	`
+	cmd, err := r.db.Exec(ctx, query, id, userID)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// MarkAllRead marks every unread notification of the user as read and returns how many changed
+func (r *Repository) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	const query = `
+		UPDATE notifications
+		SET read_at = NOW()
+		WHERE user_id = $1 AND read_at IS NULL
+	`
+	cmd, err := r.db.Exec(ctx, query, userID)
+	if err != nil {
+		return 0, err
+	}
+	return cmd.RowsAffected(), nil
+}
@@ -3,15 +3,19 @@ package project
 import (
 	"context"
 	"errors"
+	"fmt"
 	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/listquery"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var (
-	ErrNotFound = errors.New("project not found")
+	ErrNotFound       = errors.New("project not found")
+	ErrMemberNotFound = errors.New("user is not a member of the project")
 )
 
 type Repository struct {
@@ -22,41 +26,68 @@ func New(db *pgxpool.Pool) *Repository {
 	return &Repository{db: db}
 }
 
-func (r *Repository) GetAll(ctx context.Context, pagination models.PaginationRequest) ([]models.Project, models.PaginationResponse, error) {
+// Keyset lists, so a cursor of one cannot page through the other
+var (
+	listCursor           = models.CursorScope("projects", "created_at")
+	memberProjectsCursor = models.CursorScope("member-projects", "created_at")
+)
+
+// listColumns maps the fields of models.ProjectListSpec to columns
+var listColumns = listquery.Columns{
+	"name":       "p.name",
+	"member_id":  "EXISTS (SELECT 1 FROM project_members fm WHERE fm.project_id = p.id AND fm.user_id %s)",
+	"created_at": "p.created_at",
+	"updated_at": "p.updated_at",
+}
+
+func (r *Repository) GetAll(ctx context.Context, pagination models.PaginationRequest, q models.ListQuery) ([]models.Project, models.PaginationResponse, error) {
+	b := listquery.NewBuilder()
+	if err := b.Filters(q, listColumns); err != nil {
+		return nil, models.PaginationResponse{}, err
+	}
+	b.Search(q.Search, "p.name", "COALESCE(p.description, '')")
+
 	// First, get total count
-	countQuery := `SELECT COUNT(DISTINCT p.id) FROM projects p`
+	countQuery := `SELECT COUNT(*) FROM projects p ` + b.WhereClause()
 	var total int64
-	err := r.db.QueryRow(ctx, countQuery).Scan(&total)
+	err := r.db.QueryRow(ctx, countQuery, b.Args()...).Scan(&total)
 	if err != nil {
 		return nil, models.PaginationResponse{}, err
 	}
 
-	// Then get paginated results
+	offset := pagination.GetOffset()
+	cursorAt, cursorID, err := timeKeyset(pagination, listCursor)
+	if err != nil {
+		return nil, models.PaginationResponse{}, err
+	}
+	if cursorAt != nil {
+		b.Where(fmt.Sprintf("(p.created_at, p.id) < (%s::timestamptz, %s::uuid)", b.Arg(cursorAt), b.Arg(cursorID)))
+	}
+
+	orderBy, err := listquery.OrderBy(q, listColumns, "p.created_at DESC, p.id DESC", "p.id DESC")
+	if err != nil {
+		return nil, models.PaginationResponse{}, err
+	}
+
+	// Then get the page of projects; members are loaded afterwards so LIMIT applies to projects, not member rows
+	// One extra row is read to know whether there is a next page
+	limitArg := b.Arg(pagination.PageSize + 1)
+	offsetArg := b.Arg(offset)
 	query := `
-		SELECT 
-			p.id, p.name, p.description, p.color, p.created_at, p.updated_at,
-			u.id as member_id, u.name as member_name, u.email as member_email, 
-			u.created_at as member_created_at, u.updated_at as member_updated_at
+		SELECT p.id, p.name, p.description, p.color, p.created_at, p.updated_at
 		FROM projects p
-		LEFT JOIN project_members pm ON p.id = pm.project_id
-		LEFT JOIN users u ON pm.user_id = u.id
-		ORDER BY p.created_at DESC, u.name ASC
-		LIMIT $1 OFFSET $2
-	`
-	rows, err := r.db.Query(ctx, query, pagination.PageSize, pagination.GetOffset())
+		` + b.WhereClause() + `
+		` + orderBy + `
+		LIMIT ` + limitArg + ` OFFSET ` + offsetArg
+	rows, err := r.db.Query(ctx, query, b.Args()...)
 	if err != nil {
 		return nil, models.PaginationResponse{}, err
 	}
 	defer rows.Close()
 
-	projectMap := make(map[uuid.UUID]*models.Project)
-
+	var projects []models.Project
 	for rows.Next() {
 		var pr models.Project
-		var memberID *uuid.UUID
-		var memberName, memberEmail *string
-		var memberCreatedAt, memberUpdatedAt *time.Time
-
 		if err := rows.Scan(
 			&pr.ID,
 			&pr.Name,
@@ -64,47 +95,45 @@ func (r *Repository) GetAll(ctx context.Context, pagination models.PaginationReq
 			&pr.Color,
 			&pr.CreatedAt,
 			&pr.UpdatedAt,
-			&memberID,
-			&memberName,
-			&memberEmail,
-			&memberCreatedAt,
-			&memberUpdatedAt,
 		); err != nil {
 			return nil, models.PaginationResponse{}, err
 		}
+		projects = append(projects, pr)
+	}
 
-		// Get or create project in map
-		if existingProject, exists := projectMap[pr.ID]; exists {
-			pr = *existingProject
-		} else {
-			pr.Members = []models.User{}
-			projectMap[pr.ID] = &pr
+	if rows.Err() != nil {
+		return nil, models.PaginationResponse{}, rows.Err()
+	}
+	rows.Close()
+
+	// Cursors follow the default order only
+	var nextCursor string
+	if len(projects) > pagination.PageSize {
+		projects = projects[:pagination.PageSize]
+		if len(q.Sort) == 0 {
+			last := projects[len(projects)-1]
+			nextCursor = models.EncodeTimeCursor(listCursor, last.CreatedAt, last.ID)
 		}
+	}
 
-		// Add member if exists
-		if memberID != nil {
-			member := models.User{
-				ID:        *memberID,
-				Name:      *memberName,
-				Email:     *memberEmail,
-				CreatedAt: *memberCreatedAt,
-				UpdatedAt: *memberUpdatedAt,
-			}
-			pr.Members = append(pr.Members, member)
-		}
+	projectIDs := make([]uuid.UUID, len(projects))
+	for i, pr := range projects {
+		projectIDs[i] = pr.ID
 	}
 
-	if rows.Err() != nil {
-		return nil, models.PaginationResponse{}, rows.Err()
+	members, err := r.getMembersByProjectIDs(ctx, projectIDs)
+	if err != nil {
+		return nil, models.PaginationResponse{}, err
 	}
 
-	// Convert map to slice
-	var projects []models.Project
-	for _, project := range projectMap {
-		projects = append(projects, *project)
+	for i := range projects {
+		projects[i].Members = members[projects[i].ID]
+		if projects[i].Members == nil {
+			projects[i].Members = []models.User{}
+		}
 	}
 
-	paginationResp := models.NewPaginationResponse(pagination.Page, pagination.PageSize, total)
+	paginationResp := models.NewPagePaginationResponse(pagination, total, nextCursor)
 	return projects, paginationResp, nil
 }
 
@@ -114,6 +143,7 @@ func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Project,
 			p.id, p.name, p.description, p.color, p.created_at, p.updated_at,
 			u.id as member_id, u.name as member_name, u.email as member_email, 
 			u.created_at as member_created_at, u.updated_at as member_updated_at,
+			pm.role as member_role,
 			COALESCE(iter_counts.iteration_count, 0) as iteration_count
 		FROM projects p
 		LEFT JOIN project_members pm ON p.id = pm.project_id
@@ -141,6 +171,7 @@ func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Project,
 			var memberID *uuid.UUID
 			var memberName, memberEmail *string
 			var memberCreatedAt, memberUpdatedAt *time.Time
+			var memberRole *models.ProjectRoleEnum
 
 			err := rows.Scan(
 				&pr.ID,
@@ -154,6 +185,7 @@ func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Project,
 				&memberEmail,
 				&memberCreatedAt,
 				&memberUpdatedAt,
+				&memberRole,
 				&iterationCount,
 			)
 			if err != nil {
@@ -172,12 +204,16 @@ func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Project,
 					CreatedAt: *memberCreatedAt,
 					UpdatedAt: *memberUpdatedAt,
 				}
+				if memberRole != nil {
+					member.Role = *memberRole
+				}
 				pr.Members = append(pr.Members, member)
 			}
 		} else {
 			var memberID *uuid.UUID
 			var memberName, memberEmail *string
 			var memberCreatedAt, memberUpdatedAt *time.Time
+			var memberRole *models.ProjectRoleEnum
 			var ignoredIterationCount int64
 
 			err := rows.Scan(
@@ -192,6 +228,7 @@ func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Project,
 				&memberEmail,
 				&memberCreatedAt,
 				&memberUpdatedAt,
+				&memberRole,
 				&ignoredIterationCount,
 			)
 			_ = ignoredIterationCount
@@ -207,6 +244,9 @@ func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Project,
 					CreatedAt: *memberCreatedAt,
 					UpdatedAt: *memberUpdatedAt,
 				}
+				if memberRole != nil {
+					member.Role = *memberRole
+				}
 				pr.Members = append(pr.Members, member)
 			}
 		}
@@ -284,7 +324,7 @@ func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
 // Helper methods for managing project members
 func (r *Repository) getProjectMembers(ctx context.Context, projectID uuid.UUID) ([]models.User, error) {
 	const query = `
-		SELECT u.id, u.name, u.email, u.created_at, u.updated_at
+		SELECT u.id, u.name, u.email, pm.role, u.created_at, u.updated_at
 		FROM users u
 		INNER JOIN project_members pm ON u.id = pm.user_id
 		WHERE pm.project_id = $1
@@ -303,6 +343,7 @@ func (r *Repository) getProjectMembers(ctx context.Context, projectID uuid.UUID)
 			&u.ID,
 			&u.Name,
 			&u.Email,
+			&u.Role,
 			&u.CreatedAt,
 			&u.UpdatedAt,
 		); err != nil {
@@ -314,6 +355,59 @@ func (r *Repository) getProjectMembers(ctx context.Context, projectID uuid.UUID)
 	return members, rows.Err()
 }
 
+// getMembersByProjectIDs loads the members of several projects in one query, grouped by project
+func (r *Repository) getMembersByProjectIDs(ctx context.Context, projectIDs []uuid.UUID) (map[uuid.UUID][]models.User, error) {
+	members := make(map[uuid.UUID][]models.User)
+	if len(projectIDs) == 0 {
+		return members, nil
+	}
+
+	const query = `
+		SELECT pm.project_id, u.id, u.name, u.email, pm.role, u.created_at, u.updated_at
+		FROM users u
+		INNER JOIN project_members pm ON u.id = pm.user_id
+		WHERE pm.project_id = ANY($1)
+		ORDER BY pm.project_id, u.name
+	`
+	rows, err := r.db.Query(ctx, query, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var projectID uuid.UUID
+		var u models.User
+		if err := rows.Scan(
+			&projectID,
+			&u.ID,
+			&u.Name,
+			&u.Email,
+			&u.Role,
+			&u.CreatedAt,
+			&u.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		members[projectID] = append(members[projectID], u)
+	}
+
+	return members, rows.Err()
+}
+
+// IsMember reports whether the user belongs to the project
+func (r *Repository) IsMember(ctx context.Context, projectID, userID uuid.UUID) (bool, error) {
+	const query = `
+		SELECT EXISTS (
+			SELECT 1 FROM project_members
+			WHERE project_id = $1 AND user_id = $2
+		)
+	`
+	var isMember bool
+	err := r.db.QueryRow(ctx, query, projectID, userID).Scan(&isMember)
+	return isMember, err
+}
+
 func (r *Repository) addProjectMembers(ctx context.Context, projectID uuid.UUID, members []models.User) error {
 	if len(members) == 0 {
 		return nil
@@ -335,10 +429,19 @@ func (r *Repository) addProjectMembers(ctx context.Context, projectID uuid.UUID,
 	return nil
 }
 
+// updateProjectMembers removes the members missing from the list and adds the new ones,
+// keeping the role of those who stay. Owners are never removed this way
 func (r *Repository) updateProjectMembers(ctx context.Context, projectID uuid.UUID, members []models.User) error {
-	// First, remove all existing members
-	const deleteQuery = `DELETE FROM project_members WHERE project_id = $1`
-	_, err := r.db.Exec(ctx, deleteQuery, projectID)
+	memberIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.ID)
+	}
+
+	const deleteQuery = `
+		DELETE FROM project_members
+		WHERE project_id = $1 AND role <> 'owner' AND NOT (user_id = ANY($2))
+	`
+	_, err := r.db.Exec(ctx, deleteQuery, projectID, memberIDs)
 	if err != nil {
 		return err
 	}
@@ -346,6 +449,45 @@ func (r *Repository) updateProjectMembers(ctx context.Context, projectID uuid.UU
 	return r.addProjectMembers(ctx, projectID, members)
 }
 
+// GetMemberRole returns the role of the user in the project, or ErrMemberNotFound when the user is not a member
+func (r *Repository) GetMemberRole(ctx context.Context, projectID, userID uuid.UUID) (models.ProjectRoleEnum, error) {
+	const query = `
+		SELECT role FROM project_members
+		WHERE project_id = $1 AND user_id = $2
+	`
+	var role models.ProjectRoleEnum
+	err := r.db.QueryRow(ctx, query, projectID, userID).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrMemberNotFound
+		}
+		return "", err
+	}
+	return role, nil
+}
+
+// SetMemberRole adds the user to the project with the given role, or changes the role of an existing member
+func (r *Repository) SetMemberRole(ctx context.Context, projectID, userID uuid.UUID, role models.ProjectRoleEnum) error {
+	const query = `
+		INSERT INTO project_members (project_id, user_id, role)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (project_id, user_id) DO UPDATE SET role = EXCLUDED.role
+	`
+	_, err := r.db.Exec(ctx, query, projectID, userID, role)
+	return err
+}
+
+// CountOwners returns how many owners the project has
+func (r *Repository) CountOwners(ctx context.Context, projectID uuid.UUID) (int, error) {
+	const query = `
+		SELECT COUNT(*) FROM project_members
+		WHERE project_id = $1 AND role = 'owner'
+	`
+	var count int
+	err := r.db.QueryRow(ctx, query, projectID).Scan(&count)
+	return count, err
+}
+
 func (r *Repository) GetByMemberID(ctx context.Context, userID uuid.UUID, pagination models.PaginationRequest) ([]models.Project, models.PaginationResponse, map[uuid.UUID]int64, error) {
 	countQuery := `
 		SELECT COUNT(DISTINCT p.id) 
@@ -359,71 +501,33 @@ func (r *Repository) GetByMemberID(ctx context.Context, userID uuid.UUID, pagina
 		return nil, models.PaginationResponse{}, nil, err
 	}
 
-	projectIDsQuery := `
-		SELECT DISTINCT p.id, p.created_at
-		FROM projects p
-		INNER JOIN project_members pm ON p.id = pm.project_id
-		WHERE pm.user_id = $1
-		ORDER BY p.created_at DESC
-		LIMIT $2 OFFSET $3
-	`
-
-	projectIDRows, err := r.db.Query(ctx, projectIDsQuery, userID, pagination.PageSize, pagination.GetOffset())
+	offset := pagination.GetOffset()
+	cursorAt, cursorID, err := timeKeyset(pagination, memberProjectsCursor)
 	if err != nil {
 		return nil, models.PaginationResponse{}, nil, err
 	}
-	defer projectIDRows.Close()
-
-	var projectIDs []uuid.UUID
-	for projectIDRows.Next() {
-		var projectID uuid.UUID
-		var createdAt time.Time
-		if err := projectIDRows.Scan(&projectID, &createdAt); err != nil {
-			projectIDRows.Close()
-			return nil, models.PaginationResponse{}, nil, err
-		}
-		projectIDs = append(projectIDs, projectID)
-	}
-	projectIDRows.Close()
-
-	if len(projectIDs) == 0 {
-		paginationResp := models.NewPaginationResponse(pagination.Page, pagination.PageSize, total)
-		return []models.Project{}, paginationResp, make(map[uuid.UUID]int64), nil
-	}
 
 	query := `
-		SELECT 
-			p.id, p.name, p.description, p.color, p.created_at, p.updated_at,
-			u.id as member_id, u.name as member_name, u.email as member_email, 
-			u.created_at as member_created_at, u.updated_at as member_updated_at,
-			COALESCE(iter_counts.iteration_count, 0) as iteration_count
+		SELECT p.id, p.name, p.description, p.color, p.created_at, p.updated_at,
+		       (SELECT COUNT(*) FROM iterations i WHERE i.project_id = p.id) as iteration_count
 		FROM projects p
-		LEFT JOIN project_members pm ON p.id = pm.project_id
-		LEFT JOIN users u ON pm.user_id = u.id
-		LEFT JOIN (
-			SELECT project_id, COUNT(*) as iteration_count
-			FROM iterations
-			GROUP BY project_id
-		) iter_counts ON p.id = iter_counts.project_id
-		WHERE p.id = ANY($1::uuid[])
-		ORDER BY p.created_at DESC, u.name ASC
+		INNER JOIN project_members pm ON p.id = pm.project_id
+		WHERE pm.user_id = $1
+		  AND ($4::timestamptz IS NULL OR (p.created_at, p.id) < ($4::timestamptz, $5::uuid))
+		ORDER BY p.created_at DESC, p.id DESC
+		LIMIT $2 OFFSET $3
 	`
-	rows, err := r.db.Query(ctx, query, projectIDs)
+	rows, err := r.db.Query(ctx, query, userID, pagination.PageSize+1, offset, cursorAt, cursorID)
 	if err != nil {
 		return nil, models.PaginationResponse{}, nil, err
 	}
 	defer rows.Close()
 
-	projectMap := make(map[uuid.UUID]*models.Project)
+	projects := []models.Project{}
 	iterationCounts := make(map[uuid.UUID]int64)
-
 	for rows.Next() {
 		var pr models.Project
-		var memberID *uuid.UUID
-		var memberName, memberEmail *string
-		var memberCreatedAt, memberUpdatedAt *time.Time
 		var iterationCount int64
-
 		if err := rows.Scan(
 			&pr.ID,
 			&pr.Name,
@@ -431,47 +535,58 @@ func (r *Repository) GetByMemberID(ctx context.Context, userID uuid.UUID, pagina
 			&pr.Color,
 			&pr.CreatedAt,
 			&pr.UpdatedAt,
-			&memberID,
-			&memberName,
-			&memberEmail,
-			&memberCreatedAt,
-			&memberUpdatedAt,
 			&iterationCount,
 		); err != nil {
 			return nil, models.PaginationResponse{}, nil, err
 		}
-
-		var currentProject *models.Project
-		if existingProject, exists := projectMap[pr.ID]; exists {
-			currentProject = existingProject
-		} else {
-			pr.Members = []models.User{}
-			projectMap[pr.ID] = &pr
-			iterationCounts[pr.ID] = iterationCount
-			currentProject = &pr
-		}
-
-		if memberID != nil {
-			member := models.User{
-				ID:        *memberID,
-				Name:      *memberName,
-				Email:     *memberEmail,
-				CreatedAt: *memberCreatedAt,
-				UpdatedAt: *memberUpdatedAt,
-			}
-			currentProject.Members = append(currentProject.Members, member)
-		}
+		projects = append(projects, pr)
+		iterationCounts[pr.ID] = iterationCount
 	}
 
 	if rows.Err() != nil {
 		return nil, models.PaginationResponse{}, nil, rows.Err()
 	}
+	rows.Close()
 
-	var projects []models.Project
-	for _, project := range projectMap {
-		projects = append(projects, *project)
+	var nextCursor string
+	if len(projects) > pagination.PageSize {
+		projects = projects[:pagination.PageSize]
+		last := projects[len(projects)-1]
+		nextCursor = models.EncodeTimeCursor(memberProjectsCursor, last.CreatedAt, last.ID)
+	}
+
+	projectIDs := make([]uuid.UUID, len(projects))
+	for i, pr := range projects {
+		projectIDs[i] = pr.ID
 	}
 
-	paginationResp := models.NewPaginationResponse(pagination.Page, pagination.PageSize, total)
+	members, err := r.getMembersByProjectIDs(ctx, projectIDs)
+	if err != nil {
+		return nil, models.PaginationResponse{}, nil, err
+	}
+
+	for i := range projects {
+		projects[i].Members = members[projects[i].ID]
+		if projects[i].Members == nil {
+			projects[i].Members = []models.User{}
+		}
+	}
+
+	paginationResp := models.NewPagePaginationResponse(pagination, total, nextCursor)
 	return projects, paginationResp, iterationCounts, nil
 }
+
+// timeKeyset returns the query arguments of a cursor of the given list on (created_at, id), both nil without a cursor
+func timeKeyset(pagination models.PaginationRequest, scope string) (interface{}, interface{}, error) {
+	cursor, err := pagination.DecodeCursor(scope)
+	if err != nil || cursor == nil {
+		return nil, nil, err
+	}
+
+	at, err := cursor.Time()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return at, cursor.ID, nil
+}
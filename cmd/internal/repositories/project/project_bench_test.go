@@ -0,0 +1,78 @@
+package project
+
+import (
+	"context"
+	"testing"
+
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/dbtest"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// BenchmarkGetAll lists a full page of seeded projects with ten members each; run it against
+// docker-compose Postgres with go test -run '^$' -bench GetAll ./cmd/internal/repositories/project
+func BenchmarkGetAll(b *testing.B) {
+	db := dbtest.Open(b)
+	repo := New(db)
+	seedProjects(b, db, 200, 10)
+
+	ctx := context.Background()
+	pagination := models.PaginationRequest{Page: 1, PageSize: 100}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		projects, _, err := repo.GetAll(ctx, pagination, models.ListQuery{})
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(projects) != pagination.PageSize {
+			b.Fatalf("got %d projects, want %d", len(projects), pagination.PageSize)
+		}
+	}
+}
+
+// seedProjects creates projects, each with its own members; they are deleted when b ends
+func seedProjects(b *testing.B, db *pgxpool.Pool, projects, members int) {
+	b.Helper()
+	ctx := context.Background()
+	tag := "benchmark-" + uuid.NewString()
+
+	b.Cleanup(func() {
+		ctx := context.Background()
+		if _, err := db.Exec(ctx, `DELETE FROM projects WHERE description = $1`, tag); err != nil {
+			b.Errorf("failed to delete seeded projects: %v", err)
+		}
+		if _, err := db.Exec(ctx, `DELETE FROM users WHERE email LIKE $1 || '%'`, tag); err != nil {
+			b.Errorf("failed to delete seeded users: %v", err)
+		}
+	})
+
+	if _, err := db.Exec(ctx, `
+		INSERT INTO projects (name, description)
+		SELECT 'project ' || n, $1
+		FROM generate_series(1, $2::int) AS n
+	`, tag, projects); err != nil {
+		b.Fatalf("failed to seed projects: %v", err)
+	}
+
+	if _, err := db.Exec(ctx, `
+		INSERT INTO users (name, email)
+		SELECT 'member ' || n, $1 || '-' || p.id || '-' || n || '@example.com'
+		FROM projects p, generate_series(1, $2::int) AS n
+		WHERE p.description = $1
+	`, tag, members); err != nil {
+		b.Fatalf("failed to seed users: %v", err)
+	}
+
+	if _, err := db.Exec(ctx, `
+		INSERT INTO project_members (project_id, user_id)
+		SELECT p.id, u.id
+		FROM projects p
+		JOIN users u ON u.email LIKE $1 || '-' || p.id || '-%'
+		WHERE p.description = $1
+	`, tag); err != nil {
+		b.Fatalf("failed to seed project members: %v", err)
+	}
+}
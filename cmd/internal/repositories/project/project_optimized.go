@@ -47,7 +47,7 @@ func (r *OptimizedRepository) GetAllOptimized(ctx context.Context, pagination mo
 		LEFT JOIN project_members pm ON p.id = pm.project_id
 		LEFT JOIN users u ON pm.user_id = u.id
 		GROUP BY p.id, p.name, p.description, p.color, p.created_at, p.updated_at
-		ORDER BY p.created_at DESC
+		ORDER BY p.created_at DESC, p.id DESC
 		LIMIT $1 OFFSET $2
 	`
 	rows, err := r.db.Query(ctx, query, pagination.PageSize, pagination.GetOffset())
@@ -2,46 +2,85 @@ package repositories
 
 import (
 	"prodyo-backend/cmd/internal/repositories/action"
+	"prodyo-backend/cmd/internal/repositories/attachment"
+	"prodyo-backend/cmd/internal/repositories/availability"
 	"prodyo-backend/cmd/internal/repositories/bug"
+	"prodyo-backend/cmd/internal/repositories/cadence"
+	"prodyo-backend/cmd/internal/repositories/calendar"
 	"prodyo-backend/cmd/internal/repositories/cause"
+	"prodyo-backend/cmd/internal/repositories/comment"
+	"prodyo-backend/cmd/internal/repositories/dashboard"
+	"prodyo-backend/cmd/internal/repositories/dependency"
 	"prodyo-backend/cmd/internal/repositories/improv"
 	"prodyo-backend/cmd/internal/repositories/indicator"
 	"prodyo-backend/cmd/internal/repositories/indicator_range"
 	"prodyo-backend/cmd/internal/repositories/iteration"
+	"prodyo-backend/cmd/internal/repositories/label"
+	"prodyo-backend/cmd/internal/repositories/notification"
 	"prodyo-backend/cmd/internal/repositories/project"
+	"prodyo-backend/cmd/internal/repositories/retrospective"
+	"prodyo-backend/cmd/internal/repositories/search"
 	"prodyo-backend/cmd/internal/repositories/session"
+	"prodyo-backend/cmd/internal/repositories/status_transition"
 	"prodyo-backend/cmd/internal/repositories/task"
 	"prodyo-backend/cmd/internal/repositories/user"
+	"prodyo-backend/cmd/internal/repositories/wip_limit"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type Repository struct {
-	Project        *project.Repository
-	User           *user.Repository
-	Session        *session.Repository
-	Iteration      *iteration.Repository
-	Task           *task.Repository
-	Improv         *improv.Repository
-	Bug            *bug.Repository
-	Indicator      *indicator.Repository
-	IndicatorRange *indicator_range.Repository
-	Cause          *cause.Repository
-	Action         *action.Repository
+	Project          *project.Repository
+	User             *user.Repository
+	Session          *session.Repository
+	Iteration        *iteration.Repository
+	Task             *task.Repository
+	Improv           *improv.Repository
+	Bug              *bug.Repository
+	Indicator        *indicator.Repository
+	IndicatorRange   *indicator_range.Repository
+	Cause            *cause.Repository
+	Action           *action.Repository
+	Retrospective    *retrospective.Repository
+	StatusTransition *status_transition.Repository
+	Dashboard        *dashboard.Repository
+	Search           *search.Repository
+	Label            *label.Repository
+	Comment          *comment.Repository
+	Notification     *notification.Repository
+	Attachment       *attachment.Repository
+	Dependency       *dependency.Repository
+	WIPLimit         *wip_limit.Repository
+	Availability     *availability.Repository
+	Calendar         *calendar.Repository
+	Cadence          *cadence.Repository
 }
 
 func New(db *pgxpool.Pool) *Repository {
 	return &Repository{
-		Project:        project.New(db),
-		User:           user.New(db),
-		Session:        session.New(db),
-		Iteration:      iteration.New(db),
-		Task:           task.New(db),
-		Improv:         improv.New(db),
-		Bug:            bug.New(db),
-		Indicator:      indicator.New(db),
-		IndicatorRange: indicator_range.New(db),
-		Cause:          cause.New(db),
-		Action:         action.New(db),
+		Project:          project.New(db),
+		User:             user.New(db),
+		Session:          session.New(db),
+		Iteration:        iteration.New(db),
+		Task:             task.New(db),
+		Improv:           improv.New(db),
+		Bug:              bug.New(db),
+		Indicator:        indicator.New(db),
+		IndicatorRange:   indicator_range.New(db),
+		Cause:            cause.New(db),
+		Action:           action.New(db),
+		Retrospective:    retrospective.New(db),
+		StatusTransition: status_transition.New(db),
+		Dashboard:        dashboard.New(db),
+		Search:           search.New(db),
+		Label:            label.New(db),
+		Comment:          comment.New(db),
+		Notification:     notification.New(db),
+		Attachment:       attachment.New(db),
+		Dependency:       dependency.New(db),
+		WIPLimit:         wip_limit.New(db),
+		Availability:     availability.New(db),
+		Calendar:         calendar.New(db),
+		Cadence:          cadence.New(db),
 	}
 }
@@ -0,0 +1,438 @@
+package retrospective
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+	actionRepo "prodyo-backend/cmd/internal/repositories/action"
+	causeRepo "prodyo-backend/cmd/internal/repositories/cause"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrNotFound       = errors.New("retrospective not found")
+	ErrColumnNotFound = errors.New("retrospective column not found")
+	ErrItemNotFound   = errors.New("retrospective item not found")
+	ErrNoVotesLeft    = errors.New("no votes left for this retrospective")
+	ErrVoteNotFound   = errors.New("vote not found")
+	ErrItemPromoted   = errors.New("retrospective item already promoted")
+)
+
+type Repository struct {
+	db         *pgxpool.Pool
+	causeRepo  *causeRepo.Repository
+	actionRepo *actionRepo.Repository
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db:         db,
+		causeRepo:  causeRepo.New(db),
+		actionRepo: actionRepo.New(db),
+	}
+}
+
+func (r *Repository) GetByIterationID(ctx context.Context, iterationID uuid.UUID) (models.Retrospective, error) {
+	const query = `
+		SELECT id, iteration_id, votes_per_member, created_at, updated_at
+		FROM retrospectives
+		WHERE iteration_id = $1
+	`
+	return r.get(ctx, query, iterationID)
+}
+
+func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Retrospective, error) {
+	const query = `
+		SELECT id, iteration_id, votes_per_member, created_at, updated_at
+		FROM retrospectives
+		WHERE id = $1
+	`
+	return r.get(ctx, query, id)
+}
+
+func (r *Repository) get(ctx context.Context, query string, arg uuid.UUID) (models.Retrospective, error) {
+	var retro models.Retrospective
+	err := r.db.QueryRow(ctx, query, arg).Scan(
+		&retro.ID,
+		&retro.IterationID,
+		&retro.VotesPerMember,
+		&retro.CreatedAt,
+		&retro.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Retrospective{}, ErrNotFound
+		}
+		return models.Retrospective{}, err
+	}
+
+	columns, err := r.getColumns(ctx, retro.ID)
+	if err != nil {
+		return models.Retrospective{}, err
+	}
+	retro.Columns = columns
+
+	return retro, nil
+}
+
+// getColumns loads all columns of a retrospective with their items and vote counts
+func (r *Repository) getColumns(ctx context.Context, retrospectiveID uuid.UUID) ([]models.RetrospectiveColumn, error) {
+	const columnsQuery = `
+		SELECT id, retrospective_id, title, position, created_at, updated_at
+		FROM retrospective_columns
+		WHERE retrospective_id = $1
+		ORDER BY position ASC, created_at ASC
+	`
+	rows, err := r.db.Query(ctx, columnsQuery, retrospectiveID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := []models.RetrospectiveColumn{}
+	columnIndex := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var c models.RetrospectiveColumn
+		if err := rows.Scan(
+			&c.ID,
+			&c.RetrospectiveID,
+			&c.Title,
+			&c.Position,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		c.Items = []models.RetrospectiveItem{}
+		columnIndex[c.ID] = len(columns)
+		columns = append(columns, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	const itemsQuery = `
+		SELECT i.id, i.column_id, i.description, i.cause_id, i.action_id, i.created_at, i.updated_at,
+		       COALESCE(v.votes, 0) as votes,
+		       u.id as author_id, u.name as author_name, u.email as author_email,
+		       u.created_at as author_created_at, u.updated_at as author_updated_at
+		FROM retrospective_items i
+		INNER JOIN retrospective_columns c ON i.column_id = c.id
+		LEFT JOIN users u ON i.author_id = u.id
+		LEFT JOIN (
+			SELECT item_id, COUNT(*) as votes
+			FROM retrospective_votes
+			GROUP BY item_id
+		) v ON i.id = v.item_id
+		WHERE c.retrospective_id = $1
+		ORDER BY votes DESC, i.created_at ASC
+	`
+	itemRows, err := r.db.Query(ctx, itemsQuery, retrospectiveID)
+	if err != nil {
+		return nil, err
+	}
+	defer itemRows.Close()
+
+	for itemRows.Next() {
+		item, err := scanItem(itemRows)
+		if err != nil {
+			return nil, err
+		}
+		if idx, ok := columnIndex[item.ColumnID]; ok {
+			columns[idx].Items = append(columns[idx].Items, item)
+		}
+	}
+
+	return columns, itemRows.Err()
+}
+
+func scanItem(row interface {
+	Scan(dest ...interface{}) error
+}) (models.RetrospectiveItem, error) {
+	var item models.RetrospectiveItem
+	var authorID *uuid.UUID
+	var authorName, authorEmail *string
+	var authorCreatedAt, authorUpdatedAt *time.Time
+
+	err := row.Scan(
+		&item.ID,
+		&item.ColumnID,
+		&item.Description,
+		&item.CauseID,
+		&item.ActionID,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+		&item.Votes,
+		&authorID,
+		&authorName,
+		&authorEmail,
+		&authorCreatedAt,
+		&authorUpdatedAt,
+	)
+	if err != nil {
+		return models.RetrospectiveItem{}, err
+	}
+
+	if authorID != nil {
+		item.Author = models.User{
+			ID:        *authorID,
+			Name:      *authorName,
+			Email:     *authorEmail,
+			CreatedAt: *authorCreatedAt,
+			UpdatedAt: *authorUpdatedAt,
+		}
+	}
+
+	return item, nil
+}
+
+// Create inserts the retrospective together with its initial columns
+func (r *Repository) Create(ctx context.Context, retro models.Retrospective) error {
+	const query = `
+		INSERT INTO retrospectives (id, iteration_id, votes_per_member)
+		VALUES ($1, $2, $3)
+	`
+	if retro.ID == uuid.Nil {
+		retro.ID = uuid.New()
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, query, retro.ID, retro.IterationID, retro.VotesPerMember); err != nil {
+		return err
+	}
+
+	const columnQuery = `
+		INSERT INTO retrospective_columns (id, retrospective_id, title, position)
+		VALUES ($1, $2, $3, $4)
+	`
+	for i, column := range retro.Columns {
+		if column.ID == uuid.Nil {
+			column.ID = uuid.New()
+		}
+		if _, err := tx.Exec(ctx, columnQuery, column.ID, retro.ID, column.Title, i); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// AddColumn appends a column after the existing ones
+func (r *Repository) AddColumn(ctx context.Context, column models.RetrospectiveColumn) error {
+	const query = `
+		INSERT INTO retrospective_columns (id, retrospective_id, title, position)
+		SELECT $1, $2, $3, COALESCE(MAX(position) + 1, 0)
+		FROM retrospective_columns
+		WHERE retrospective_id = $2
+	`
+	if column.ID == uuid.Nil {
+		column.ID = uuid.New()
+	}
+
+	_, err := r.db.Exec(ctx, query, column.ID, column.RetrospectiveID, column.Title)
+	return err
+}
+
+// GetIterationIDByColumnID returns the iteration the column's retrospective belongs to
+func (r *Repository) GetIterationIDByColumnID(ctx context.Context, columnID uuid.UUID) (uuid.UUID, error) {
+	const query = `
+		SELECT r.iteration_id
+		FROM retrospective_columns c
+		INNER JOIN retrospectives r ON c.retrospective_id = r.id
+		WHERE c.id = $1
+	`
+	var iterationID uuid.UUID
+	err := r.db.QueryRow(ctx, query, columnID).Scan(&iterationID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, ErrColumnNotFound
+		}
+		return uuid.Nil, err
+	}
+	return iterationID, nil
+}
+
+func (r *Repository) GetItemByID(ctx context.Context, id uuid.UUID) (models.RetrospectiveItem, error) {
+	const query = `
+		SELECT i.id, i.column_id, i.description, i.cause_id, i.action_id, i.created_at, i.updated_at,
+		       (SELECT COUNT(*) FROM retrospective_votes v WHERE v.item_id = i.id) as votes,
+		       u.id as author_id, u.name as author_name, u.email as author_email,
+		       u.created_at as author_created_at, u.updated_at as author_updated_at
+		FROM retrospective_items i
+		LEFT JOIN users u ON i.author_id = u.id
+		WHERE i.id = $1
+	`
+	item, err := scanItem(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.RetrospectiveItem{}, ErrItemNotFound
+		}
+		return models.RetrospectiveItem{}, err
+	}
+	return item, nil
+}
+
+func (r *Repository) CreateItem(ctx context.Context, item models.RetrospectiveItem) error {
+	const query = `
+		INSERT INTO retrospective_items (id, column_id, author_id, description)
+		VALUES ($1, $2, $3, $4)
+	`
+	if item.ID == uuid.Nil {
+		item.ID = uuid.New()
+	}
+
+	var authorID interface{}
+	if item.Author.ID != uuid.Nil {
+		authorID = item.Author.ID
+	} else {
+		authorID = nil
+	}
+
+	_, err := r.db.Exec(ctx, query,
+		item.ID,
+		item.ColumnID,
+		authorID,
+		item.Description,
+	)
+	return err
+}
+
+func (r *Repository) DeleteItem(ctx context.Context, id uuid.UUID) error {
+	const query = `DELETE FROM retrospective_items WHERE id = $1`
+	cmd, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrItemNotFound
+	}
+	return nil
+}
+
+// AddVote places one dot on an item, as long as the user still has votes left
+// in the retrospective the item belongs to; the votes of the user in that retrospective
+// are counted under a lock so concurrent votes cannot go over the limit
+func (r *Repository) AddVote(ctx context.Context, itemID, userID uuid.UUID) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	const retroQuery = `
+		SELECT c.retrospective_id
+		FROM retrospective_items i
+		INNER JOIN retrospective_columns c ON i.column_id = c.id
+		WHERE i.id = $1
+	`
+	var retroID uuid.UUID
+	if err := tx.QueryRow(ctx, retroQuery, itemID).Scan(&retroID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrItemNotFound
+		}
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended('retro-votes:' || $1::text || ':' || $2::text, 0))`, retroID, userID); err != nil {
+		return err
+	}
+
+	const query = `
+		INSERT INTO retrospective_votes (id, item_id, user_id)
+		SELECT $1, $2, $3
+		FROM retrospectives r
+		WHERE r.id = $4
+		  AND (
+			SELECT COUNT(*)
+			FROM retrospective_votes v
+			INNER JOIN retrospective_items vi ON v.item_id = vi.id
+			INNER JOIN retrospective_columns vc ON vi.column_id = vc.id
+			WHERE vc.retrospective_id = r.id AND v.user_id = $3
+		  ) < r.votes_per_member
+	`
+	cmd, err := tx.Exec(ctx, query, uuid.New(), itemID, userID, retroID)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrNoVotesLeft
+	}
+
+	return tx.Commit(ctx)
+}
+
+// RemoveVote takes back one of the user's dots from an item
+func (r *Repository) RemoveVote(ctx context.Context, itemID, userID uuid.UUID) error {
+	const query = `
+		DELETE FROM retrospective_votes
+		WHERE id = (
+			SELECT id FROM retrospective_votes
+			WHERE item_id = $1 AND user_id = $2
+			ORDER BY created_at DESC
+			LIMIT 1
+		)
+	`
+	cmd, err := r.db.Exec(ctx, query, itemID, userID)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrVoteNotFound
+	}
+	return nil
+}
+
+// Promote creates the cause, and the action when given, an item is promoted into and records them
+// on the item in one transaction; the item is locked so it is promoted once at most
+func (r *Repository) Promote(ctx context.Context, itemID uuid.UUID, cause models.Cause, action *models.Action) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var promoted bool
+	const lockQuery = `SELECT cause_id IS NOT NULL OR action_id IS NOT NULL FROM retrospective_items WHERE id = $1 FOR UPDATE`
+	if err := tx.QueryRow(ctx, lockQuery, itemID).Scan(&promoted); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrItemNotFound
+		}
+		return err
+	}
+	if promoted {
+		return ErrItemPromoted
+	}
+
+	if err := r.causeRepo.CreateTx(ctx, tx, cause); err != nil {
+		return err
+	}
+
+	var actionID *uuid.UUID
+	if action != nil {
+		if err := r.actionRepo.CreateTx(ctx, tx, *action); err != nil {
+			return err
+		}
+		actionID = &action.ID
+	}
+
+	const query = `
+		UPDATE retrospective_items
+		SET cause_id = $2, action_id = $3, updated_at = NOW()
+		WHERE id = $1
+	`
+	if _, err := tx.Exec(ctx, query, itemID, cause.ID, actionID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
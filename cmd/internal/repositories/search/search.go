@@ -0,0 +1,147 @@
+package search
+
+import (
+	"context"
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// htmlEscaped is the SQL escaping the HTML special characters of a text expression
+// ts_headline adds <mark> tags around matches, so the text is escaped first to keep the highlight safe to render
+func htmlEscaped(expr string) string {
+	return "replace(replace(replace(replace(replace(" + expr +
+		", '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '\"', '&quot;'), '''', '&#39;')"
+}
+
+// Search matches the search_vector columns kept by migration 026 against the query,
+// restricted to the projects userID is a member of, best ranked first
+func (r *Repository) Search(ctx context.Context, userID uuid.UUID, req models.SearchRequest) ([]models.SearchHit, error) {
+	query := `
+		WITH q AS (
+			SELECT websearch_to_tsquery('simple', $1) as query,
+			       'StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2' as options
+		), member_projects AS (
+			SELECT pm.project_id FROM project_members pm
+			WHERE pm.user_id = $2 AND ($3::uuid IS NULL OR pm.project_id = $3::uuid)
+		)
+		SELECT type, id, project_id, project_name, task_id, title, highlight, rank::float8
+		FROM (
+			SELECT 'project' as type, p.id, p.id as project_id, p.name as project_name, NULL::uuid as task_id,
+			       p.name as title,
+			       ts_headline('simple', ` + htmlEscaped("p.name || ' ' || coalesce(p.description, '')") + `, q.query, q.options) as highlight,
+			       ts_rank(p.search_vector, q.query) as rank
+			FROM projects p, q
+			WHERE p.id IN (SELECT project_id FROM member_projects) AND p.search_vector @@ q.query
+
+			UNION ALL
+
+			SELECT 'task', t.id, p.id, p.name, t.id,
+			       t.name,
+			       ts_headline('simple', ` + htmlEscaped("t.name || ' ' || coalesce(t.description, '')") + `, q.query, q.options),
+			       ts_rank(t.search_vector, q.query)
+			FROM tasks t
+			INNER JOIN projects p ON t.project_id = p.id, q
+			WHERE p.id IN (SELECT project_id FROM member_projects) AND t.search_vector @@ q.query
+
+			UNION ALL
+
+			SELECT 'bug', b.id, p.id, p.name, t.id,
+			       t.name,
+			       ts_headline('simple', ` + htmlEscaped("coalesce(b.description, '')") + `, q.query, q.options),
+			       ts_rank(b.search_vector, q.query)
+			FROM bugs b
+			INNER JOIN tasks t ON b.task_id = t.id
+			INNER JOIN projects p ON t.project_id = p.id, q
+			WHERE p.id IN (SELECT project_id FROM member_projects) AND b.search_vector @@ q.query
+
+			UNION ALL
+
+			SELECT 'improvement', im.id, p.id, p.name, t.id,
+			       t.name,
+			       ts_headline('simple', ` + htmlEscaped("coalesce(im.description, '')") + `, q.query, q.options),
+			       ts_rank(im.search_vector, q.query)
+			FROM improvements im
+			INNER JOIN tasks t ON im.task_id = t.id
+			INNER JOIN projects p ON t.project_id = p.id, q
+			WHERE p.id IN (SELECT project_id FROM member_projects) AND im.search_vector @@ q.query
+
+			UNION ALL
+
+			SELECT 'cause', c.id, p.id, p.name, NULL::uuid,
+			       c.metric,
+			       ts_headline('simple', ` + htmlEscaped("coalesce(c.description, '')") + `, q.query, q.options),
+			       ts_rank(c.search_vector, q.query)
+			FROM causes c
+			INNER JOIN indicator_ranges ir ON c.indicator_range_id = ir.id
+			INNER JOIN projects p ON ir.project_id = p.id, q
+			WHERE p.id IN (SELECT project_id FROM member_projects) AND c.search_vector @@ q.query
+
+			UNION ALL
+
+			SELECT 'action', a.id, p.id, p.name, NULL::uuid,
+			       ir.indicator_type,
+			       ts_headline('simple', ` + htmlEscaped("coalesce(a.description, '')") + `, q.query, q.options),
+			       ts_rank(a.search_vector, q.query)
+			FROM actions a
+			INNER JOIN indicator_ranges ir ON a.indicator_range_id = ir.id
+			INNER JOIN projects p ON ir.project_id = p.id, q
+			WHERE p.id IN (SELECT project_id FROM member_projects) AND a.search_vector @@ q.query
+		) hits
+		WHERE $4::text[] IS NULL OR type = ANY($4::text[])
+		ORDER BY rank DESC, type, id
+		LIMIT $5
+	`
+	var projectID interface{}
+	if req.ProjectID != nil {
+		projectID = *req.ProjectID
+	} else {
+		projectID = nil
+	}
+
+	var types interface{}
+	if len(req.Types) > 0 {
+		names := make([]string, len(req.Types))
+		for i, t := range req.Types {
+			names[i] = string(t)
+		}
+		types = names
+	} else {
+		types = nil
+	}
+
+	rows, err := r.db.Query(ctx, query, req.Query, userID, projectID, types, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := []models.SearchHit{}
+	for rows.Next() {
+		var h models.SearchHit
+		if err := rows.Scan(
+			&h.Type,
+			&h.ID,
+			&h.ProjectID,
+			&h.ProjectName,
+			&h.TaskID,
+			&h.Title,
+			&h.Highlight,
+			&h.Rank,
+		); err != nil {
+			return nil, err
+		}
+		hits = append(hits, h)
+	}
+
+	return hits, rows.Err()
+}
@@ -0,0 +1,70 @@
+package status_transition
+
+import (
+	"context"
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// GetByProjectID returns the allowed transitions of a project
+// An empty list means the project does not restrict transitions
+func (r *Repository) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]models.StatusTransition, error) {
+	const query = `
+		SELECT from_status, to_status
+		FROM project_status_transitions
+		WHERE project_id = $1
+		ORDER BY from_status ASC, to_status ASC
+	`
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transitions := []models.StatusTransition{}
+	for rows.Next() {
+		var t models.StatusTransition
+		if err := rows.Scan(&t.FromStatus, &t.ToStatus); err != nil {
+			return nil, err
+		}
+		transitions = append(transitions, t)
+	}
+
+	return transitions, rows.Err()
+}
+
+// Replace swaps the allowed transitions of a project for the given list
+func (r *Repository) Replace(ctx context.Context, projectID uuid.UUID, transitions []models.StatusTransition) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM project_status_transitions WHERE project_id = $1`, projectID); err != nil {
+		return err
+	}
+
+	const query = `
+		INSERT INTO project_status_transitions (id, project_id, from_status, to_status)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (project_id, from_status, to_status) DO NOTHING
+	`
+	for _, t := range transitions {
+		if _, err := tx.Exec(ctx, query, uuid.New(), projectID, t.FromStatus, t.ToStatus); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
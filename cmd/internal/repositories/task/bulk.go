@@ -0,0 +1,141 @@
+package task
+
+import (
+	"context"
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// BulkTx runs the changes of a bulk operation for one task inside its savepoint
+type BulkTx struct {
+	tx pgx.Tx
+}
+
+// Bulk calls apply for every task in a single transaction, each inside its own savepoint,
+// so a failing task is rolled back alone while the others are committed together
+// It returns the error of each task, in the order of ids
+func (r *Repository) Bulk(ctx context.Context, ids []uuid.UUID, apply func(ctx context.Context, btx BulkTx, id uuid.UUID) error) ([]error, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	errs := make([]error, len(ids))
+	for i, id := range ids {
+		savepoint, err := tx.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := apply(ctx, BulkTx{tx: savepoint}, id); err != nil {
+			if rbErr := savepoint.Rollback(ctx); rbErr != nil {
+				return nil, rbErr
+			}
+			errs[i] = err
+			continue
+		}
+
+		if err := savepoint.Commit(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return errs, tx.Commit(ctx)
+}
+
+// Tx returns the savepoint of the task, for the reads of other repositories
+func (b BulkTx) Tx() pgx.Tx {
+	return b.tx
+}
+
+// Lock locks the task until the end of the bulk operation and returns where it sits on the board
+func (b BulkTx) Lock(ctx context.Context, id uuid.UUID) (models.Task, error) {
+	return lockTask(ctx, b.tx, id)
+}
+
+// Patch writes the fields set in patch; a status change is recorded on behalf of actorID
+// and moves the task to the bottom of its new column
+// checkStatus runs with the board of the task locked when the status changes
+func (b BulkTx) Patch(ctx context.Context, current models.Task, patch models.TaskPatch, actorID uuid.UUID, checkStatus func(ctx context.Context, board Board, to models.StatusEnum) error) error {
+	const query = `
+		UPDATE tasks
+		SET status = $2,
+			assignee_id = CASE WHEN $3::boolean THEN $4::uuid ELSE assignee_id END,
+			points = COALESCE($5::integer, points),
+			expected_time = COALESCE($6::numeric, expected_time),
+			updated_at = NOW()
+		WHERE id = $1
+	`
+	status := current.Status
+	if patch.Status != nil {
+		status = *patch.Status
+	}
+	if status != current.Status && checkStatus != nil {
+		if err := lockBoard(ctx, b.tx, columnOf(current)); err != nil {
+			return err
+		}
+		if err := checkStatus(ctx, Board{tx: b.tx}, status); err != nil {
+			return err
+		}
+	}
+
+	var points *int
+	if patch.Points != nil {
+		p := *patch.Points
+		if p == 0 {
+			p = 1
+		}
+		points = &p
+	}
+
+	if _, err := b.tx.Exec(ctx, query, current.ID, status, patch.SetAssignee, patch.AssigneeID, points, patch.ExpectedTime); err != nil {
+		return err
+	}
+
+	if status == current.Status {
+		return nil
+	}
+
+	if err := rankInNewStatus(ctx, b.tx, current, status); err != nil {
+		return err
+	}
+	return recordStatusChange(ctx, b.tx, current.ID, &current.Status, status, actorID)
+}
+
+// MoveToIteration moves a top-level task with its sub-tasks to the bottom of its column in another iteration
+// checkColumn, when set, runs with the board of the iteration locked, before the task lands in it
+func (b BulkTx) MoveToIteration(ctx context.Context, current models.Task, iterationID uuid.UUID, checkColumn func(ctx context.Context, board Board) error) error {
+	if models.SameIteration(current.IterationID, &iterationID) {
+		return nil
+	}
+
+	col := columnOf(current)
+	col.iterationID = &iterationID
+	if err := lockBoard(ctx, b.tx, col); err != nil {
+		return err
+	}
+	if checkColumn != nil {
+		if err := checkColumn(ctx, Board{tx: b.tx}); err != nil {
+			return err
+		}
+	}
+	if err := moveTree(ctx, b.tx, current.ID, &iterationID); err != nil {
+		return err
+	}
+	return rankAtBottom(ctx, b.tx, col, current.ID)
+}
+
+// Delete removes the task with its sub-tasks, bugs and improvements
+func (b BulkTx) Delete(ctx context.Context, id uuid.UUID) error {
+	cmd, err := b.tx.Exec(ctx, `DELETE FROM tasks WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
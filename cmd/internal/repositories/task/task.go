@@ -3,24 +3,33 @@ package task
 import (
 	"context"
 	"errors"
+	"fmt"
 	"prodyo-backend/cmd/internal/models"
 	bugRepo "prodyo-backend/cmd/internal/repositories/bug"
+	dependencyRepo "prodyo-backend/cmd/internal/repositories/dependency"
 	improvRepo "prodyo-backend/cmd/internal/repositories/improv"
+	labelRepo "prodyo-backend/cmd/internal/repositories/label"
+	"prodyo-backend/cmd/internal/repositories/listquery"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var (
-	ErrNotFound = errors.New("task not found")
+	ErrNotFound        = errors.New("task not found")
+	ErrProjectNotFound = errors.New("project not found")
+	ErrInvalidPosition = errors.New("neighbour task must be another task of the same column")
 )
 
 type Repository struct {
 	db         *pgxpool.Pool
 	bugRepo    *bugRepo.Repository
 	improvRepo *improvRepo.Repository
+	labelRepo  *labelRepo.Repository
+	depRepo    *dependencyRepo.Repository
 }
 
 func New(db *pgxpool.Pool) *Repository {
@@ -28,56 +37,214 @@ func New(db *pgxpool.Pool) *Repository {
 		db:         db,
 		bugRepo:    bugRepo.New(db),
 		improvRepo: improvRepo.New(db),
+		labelRepo:  labelRepo.New(db),
+		depRepo:    dependencyRepo.New(db),
 	}
 }
 
+// listColumns maps the fields of models.TaskListSpec to columns
+var listColumns = listquery.Columns{
+	"status":      "t.status",
+	"assignee_id": "t.assignee_id",
+	"label":       "EXISTS (SELECT 1 FROM task_labels tl INNER JOIN labels lb ON tl.label_id = lb.id WHERE tl.task_id = t.id AND lb.name %s)",
+	"label_id":    "EXISTS (SELECT 1 FROM task_labels tl WHERE tl.task_id = t.id AND tl.label_id %s)",
+	"points":      "t.points",
+	"rank":        "t.rank",
+	"name":        "t.name",
+	"created_at":  "t.created_at",
+	"updated_at":  "t.updated_at",
+}
+
 func (r *Repository) GetAll(ctx context.Context, iterationID uuid.UUID) ([]models.Task, error) {
-	const query = `
-		SELECT t.id, t.iteration_id, t.name, t.description, t.status, t.timer, t.points, t.expected_time, t.parent_task_id,
+	return r.List(ctx, iterationID, models.ListQuery{})
+}
+
+// List returns the top-level tasks of the iteration matching the query, with their relations
+func (r *Repository) List(ctx context.Context, iterationID uuid.UUID, q models.ListQuery) ([]models.Task, error) {
+	b := listquery.NewBuilder(iterationID)
+	b.Where("t.iteration_id = $1")
+	return r.list(ctx, b, q)
+}
+
+// ListBacklog returns the top-level tasks of the project backlog matching the query, by priority
+func (r *Repository) ListBacklog(ctx context.Context, projectID uuid.UUID, q models.ListQuery) ([]models.Task, error) {
+	b := listquery.NewBuilder(projectID)
+	b.Where("t.project_id = $1")
+	b.Where("t.iteration_id IS NULL")
+	return r.list(ctx, b, q)
+}
+
+func (r *Repository) list(ctx context.Context, b *listquery.Builder, q models.ListQuery) ([]models.Task, error) {
+	b.Where("t.parent_task_id IS NULL")
+	if err := b.Filters(q, listColumns); err != nil {
+		return nil, err
+	}
+	b.Search(q.Search, "t.name", "t.description")
+
+	orderBy, err := listquery.OrderBy(q, listColumns, "t.rank ASC, t.id ASC", "t.id ASC")
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT t.id, t.project_id, t.iteration_id, t.name, t.description, t.status, t.timer, t.points, t.expected_time, t.parent_task_id, t.rank,
 		       t.created_at, t.updated_at,
+		       (SELECT MIN(sc.changed_at) FROM task_status_changes sc
+		        WHERE sc.task_id = t.id AND sc.to_status = 'InProgress') as started_at,
+		       (SELECT MAX(sc.changed_at) FROM task_status_changes sc
+		        WHERE sc.task_id = t.id AND sc.to_status = 'Completed') as completed_at,
 		       u.id as assignee_id, u.name as assignee_name, u.email as assignee_email,
 		       u.created_at as assignee_created_at, u.updated_at as assignee_updated_at
 		FROM tasks t
 		LEFT JOIN users u ON t.assignee_id = u.id
-		WHERE t.iteration_id = $1 AND t.parent_task_id IS NULL
-		ORDER BY t.created_at ASC
-	`
-	rows, err := r.db.Query(ctx, query, iterationID)
+		` + b.WhereClause() + `
+		` + orderBy
+
+	tasks, err := r.queryTasks(ctx, query, b.Args()...)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
-	var tasks []models.Task
-	for rows.Next() {
-		t, err := r.scanTask(rows)
-		if err != nil {
-			return nil, err
-		}
-		t.Tasks = []models.Task{}
+	if err := r.loadRelations(ctx, tasks); err != nil {
+		return nil, err
+	}
 
-		// Load bugs for this task
-		bugs, err := r.bugRepo.GetAll(ctx, t.ID)
-		if err != nil {
-			return nil, err
+	return tasks, nil
+}
+
+// loadRelations fills sub-tasks, bugs, improvements, labels and dependencies of the given tasks with one query each
+// instead of one query per task
+func (r *Repository) loadRelations(ctx context.Context, tasks []models.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	parentIDs := make([]uuid.UUID, len(tasks))
+	for i, t := range tasks {
+		parentIDs[i] = t.ID
+	}
+
+	const subTasksQuery = `
+		SELECT t.id, t.project_id, t.iteration_id, t.name, t.description, t.status, t.timer, t.points, t.expected_time, t.parent_task_id, t.rank,
+		       t.created_at, t.updated_at,
+		       (SELECT MIN(sc.changed_at) FROM task_status_changes sc
+		        WHERE sc.task_id = t.id AND sc.to_status = 'InProgress') as started_at,
+		       (SELECT MAX(sc.changed_at) FROM task_status_changes sc
+		        WHERE sc.task_id = t.id AND sc.to_status = 'Completed') as completed_at,
+		       u.id as assignee_id, u.name as assignee_name, u.email as assignee_email,
+		       u.created_at as assignee_created_at, u.updated_at as assignee_updated_at
+		FROM tasks t
+		LEFT JOIN users u ON t.assignee_id = u.id
+		WHERE t.parent_task_id = ANY($1)
+		ORDER BY t.rank ASC, t.id ASC
+	`
+	subTasks, err := r.queryTasks(ctx, subTasksQuery, parentIDs)
+	if err != nil {
+		return err
+	}
+
+	taskIDs := parentIDs
+	for _, st := range subTasks {
+		taskIDs = append(taskIDs, st.ID)
+	}
+
+	bugs, err := r.bugRepo.GetAllByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	improvements, err := r.improvRepo.GetAllByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	taskLabels, err := r.labelRepo.GetByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	blockedBy, blocks, err := r.depRepo.GetByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	var bugIDs, improvementIDs []uuid.UUID
+	for _, items := range bugs {
+		for _, bg := range items {
+			bugIDs = append(bugIDs, bg.ID)
 		}
-		if bugs == nil {
-			t.Bugs = []models.Bug{}
-		} else {
-			t.Bugs = bugs
+	}
+	for _, items := range improvements {
+		for _, imp := range items {
+			improvementIDs = append(improvementIDs, imp.ID)
 		}
+	}
 
-		// Load improvements for this task
-		improvements, err := r.improvRepo.GetAll(ctx, t.ID)
-		if err != nil {
-			return nil, err
+	bugLabels, err := r.labelRepo.GetByBugIDs(ctx, bugIDs)
+	if err != nil {
+		return err
+	}
+
+	improvementLabels, err := r.labelRepo.GetByImprovementIDs(ctx, improvementIDs)
+	if err != nil {
+		return err
+	}
+
+	attach := func(t *models.Task) {
+		t.Bugs = bugs[t.ID]
+		if t.Bugs == nil {
+			t.Bugs = []models.Bug{}
+		}
+		for i := range t.Bugs {
+			t.Bugs[i].Labels = bugLabels[t.Bugs[i].ID]
 		}
-		if improvements == nil {
+		t.Improvements = improvements[t.ID]
+		if t.Improvements == nil {
 			t.Improvements = []models.Improv{}
-		} else {
-			t.Improvements = improvements
 		}
+		for i := range t.Improvements {
+			t.Improvements[i].Labels = improvementLabels[t.Improvements[i].ID]
+		}
+		t.Labels = taskLabels[t.ID]
+		t.BlockedBy = blockedBy[t.ID]
+		t.Blocks = blocks[t.ID]
+		t.Blocked = models.HasUnfinished(t.BlockedBy)
+	}
+
+	indexByID := make(map[uuid.UUID]int, len(tasks))
+	for i := range tasks {
+		indexByID[tasks[i].ID] = i
+		tasks[i].Tasks = []models.Task{}
+		attach(&tasks[i])
+	}
+
+	for _, st := range subTasks {
+		st.Tasks = []models.Task{}
+		attach(&st)
+		if st.ParentTaskID == nil {
+			continue
+		}
+		if i, ok := indexByID[*st.ParentTaskID]; ok {
+			tasks[i].Tasks = append(tasks[i].Tasks, st)
+		}
+	}
 
+	return nil
+}
+
+func (r *Repository) queryTasks(ctx context.Context, query string, args ...interface{}) ([]models.Task, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []models.Task
+	for rows.Next() {
+		t, err := r.scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
 		tasks = append(tasks, t)
 	}
 
@@ -86,8 +253,12 @@ func (r *Repository) GetAll(ctx context.Context, iterationID uuid.UUID) ([]model
 
 func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Task, error) {
 	const query = `
-		SELECT t.id, t.iteration_id, t.name, t.description, t.status, t.timer, t.points, t.expected_time, t.parent_task_id,
+		SELECT t.id, t.project_id, t.iteration_id, t.name, t.description, t.status, t.timer, t.points, t.expected_time, t.parent_task_id, t.rank,
 		       t.created_at, t.updated_at,
+		       (SELECT MIN(sc.changed_at) FROM task_status_changes sc
+		        WHERE sc.task_id = t.id AND sc.to_status = 'InProgress') as started_at,
+		       (SELECT MAX(sc.changed_at) FROM task_status_changes sc
+		        WHERE sc.task_id = t.id AND sc.to_status = 'Completed') as completed_at,
 		       u.id as assignee_id, u.name as assignee_name, u.email as assignee_email,
 		       u.created_at as assignee_created_at, u.updated_at as assignee_updated_at
 		FROM tasks t
@@ -105,6 +276,21 @@ func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (models.Task, er
 	task.Tasks = []models.Task{}
 	task.Improvements = []models.Improv{}
 	task.Bugs = []models.Bug{}
+
+	labels, err := r.labelRepo.GetByTaskIDs(ctx, []uuid.UUID{task.ID})
+	if err != nil {
+		return models.Task{}, err
+	}
+	task.Labels = labels[task.ID]
+
+	blockedBy, blocks, err := r.depRepo.GetByTaskIDs(ctx, []uuid.UUID{task.ID})
+	if err != nil {
+		return models.Task{}, err
+	}
+	task.BlockedBy = blockedBy[task.ID]
+	task.Blocks = blocks[task.ID]
+	task.Blocked = models.HasUnfinished(task.BlockedBy)
+
 	return task, nil
 }
 
@@ -115,11 +301,12 @@ func (r *Repository) scanTask(row interface {
 	var assigneeID *uuid.UUID
 	var assigneeName, assigneeEmail *string
 	var assigneeCreatedAt, assigneeUpdatedAt *time.Time
-	var parentTaskID *uuid.UUID
 	var timer *int64
+	var completedAt *time.Time
 
 	err := row.Scan(
 		&t.ID,
+		&t.ProjectID,
 		&t.IterationID,
 		&t.Name,
 		&t.Description,
@@ -127,9 +314,12 @@ func (r *Repository) scanTask(row interface {
 		&timer,
 		&t.Points,
 		&t.ExpectedTime,
-		&parentTaskID,
+		&t.ParentTaskID,
+		&t.Rank,
 		&t.CreatedAt,
 		&t.UpdatedAt,
+		&t.StartedAt,
+		&completedAt,
 		&assigneeID,
 		&assigneeName,
 		&assigneeEmail,
@@ -154,13 +344,21 @@ func (r *Repository) scanTask(row interface {
 		t.Timer = *timer
 	}
 
+	// A task reopened after completion is no longer completed
+	if t.Status == models.StatusCompleted {
+		t.CompletedAt = completedAt
+	}
+
 	return t, nil
 }
 
-func (r *Repository) Create(ctx context.Context, task models.Task) error {
+// Create inserts the task, in the backlog of its project when it has no iteration,
+// and records its initial status on behalf of actorID
+// checkColumn, when set, runs with the board of the task locked, before the task is added to it
+func (r *Repository) Create(ctx context.Context, task models.Task, actorID uuid.UUID, checkColumn func(ctx context.Context, board Board) error) error {
 	const query = `
-		INSERT INTO tasks (id, iteration_id, name, description, assignee_id, status, timer, points, expected_time, parent_task_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		INSERT INTO tasks (id, project_id, iteration_id, name, description, assignee_id, status, timer, points, expected_time, parent_task_id, rank)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 	`
 	if task.ID == uuid.Nil {
 		task.ID = uuid.New()
@@ -190,22 +388,69 @@ func (r *Repository) Create(ctx context.Context, task models.Task) error {
 		points = 1
 	}
 
-	_, err := r.db.Exec(ctx, query,
+	status := task.Status
+	if status == "" {
+		status = models.StatusNotStarted
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// New tasks go to the bottom of their column
+	col := boardColumn{projectID: task.ProjectID, iterationID: task.IterationID, status: status}
+	if err := lockBoard(ctx, tx, col); err != nil {
+		return err
+	}
+	if checkColumn != nil {
+		if err := checkColumn(ctx, Board{tx: tx}); err != nil {
+			return err
+		}
+	}
+	rank, err := rankBetween(ctx, tx, col, task.ID, func() (string, string, error) {
+		last, err := lastRank(ctx, tx, col, task.ID)
+		return last, "", err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query,
 		task.ID,
+		task.ProjectID,
 		task.IterationID,
 		task.Name,
 		task.Description,
 		assigneeID,
-		task.Status,
+		status,
 		timer,
 		points,
 		task.ExpectedTime,
 		parentTaskID,
+		rank,
 	)
-	return err
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "tasks_project_id_fkey" {
+			return ErrProjectNotFound
+		}
+		return err
+	}
+
+	if err := recordStatusChange(ctx, tx, task.ID, nil, status, actorID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
-func (r *Repository) Update(ctx context.Context, task models.Task) error {
+// Update writes the task; an empty status keeps the current one
+// When the status changes, checkStatus runs with the row and its board locked, so it sees the status
+// being replaced, then the transition is recorded on behalf of actorID and the task moves to the
+// bottom of its new column
+func (r *Repository) Update(ctx context.Context, task models.Task, actorID uuid.UUID, checkStatus func(ctx context.Context, board Board, current models.Task, to models.StatusEnum) error) error {
 	const query = `
 		UPDATE tasks
 		SET name = $1, description = $2, assignee_id = $3, status = $4, timer = $5, points = $6, expected_time = $7, updated_at = NOW()
@@ -230,7 +475,31 @@ func (r *Repository) Update(ctx context.Context, task models.Task) error {
 		points = 1
 	}
 
-	cmd, err := r.db.Exec(ctx, query,
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the row so the recorded transition matches the status being replaced
+	current, err := lockTask(ctx, tx, task.ID)
+	if err != nil {
+		return err
+	}
+	previousStatus := current.Status
+	if task.Status == "" {
+		task.Status = previousStatus
+	}
+	if previousStatus != task.Status && checkStatus != nil {
+		if err := lockBoard(ctx, tx, columnOf(current)); err != nil {
+			return err
+		}
+		if err := checkStatus(ctx, Board{tx: tx}, current, task.Status); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(ctx, query,
 		task.Name,
 		task.Description,
 		assigneeID,
@@ -243,10 +512,352 @@ func (r *Repository) Update(ctx context.Context, task models.Task) error {
 	if err != nil {
 		return err
 	}
-	if cmd.RowsAffected() == 0 {
-		return ErrNotFound
+
+	if previousStatus != task.Status {
+		if err := rankInNewStatus(ctx, tx, current, task.Status); err != nil {
+			return err
+		}
+		if err := recordStatusChange(ctx, tx, task.ID, &previousStatus, task.Status, actorID); err != nil {
+			return err
+		}
 	}
-	return nil
+
+	return tx.Commit(ctx)
+}
+
+// Move puts the task in a column of the board of iterationID, or of the project backlog when it is nil,
+// right after afterID and/or right before beforeID, both tasks of that column; with neither it goes
+// to the bottom of the column
+// A task changing board takes its sub-tasks along; bugs, improvements and everything else hanging
+// from the task follow it. The iteration, status and rank are saved together and the status transition
+// is recorded on behalf of actorID
+// checkColumn, when set, runs with the row and the target board locked, before the task lands in its column
+func (r *Repository) Move(ctx context.Context, id uuid.UUID, iterationID *uuid.UUID, status models.StatusEnum, afterID, beforeID *uuid.UUID, actorID uuid.UUID, checkColumn func(ctx context.Context, board Board, current models.Task) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	current, err := lockTask(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	col := columnOf(current)
+	col.iterationID = iterationID
+	col.status = status
+	if err := lockBoard(ctx, tx, col); err != nil {
+		return err
+	}
+	if checkColumn != nil {
+		if err := checkColumn(ctx, Board{tx: tx}, current); err != nil {
+			return err
+		}
+	}
+
+	filter, args := col.filter(id)
+	neighbourRank := func(neighbourID uuid.UUID) (string, error) {
+		query := fmt.Sprintf(`SELECT rank FROM tasks WHERE %s AND id = $%d`, filter, len(args)+1)
+		var rank string
+		err := tx.QueryRow(ctx, query, append(args, neighbourID)...).Scan(&rank)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrInvalidPosition
+		}
+		return rank, err
+	}
+
+	// An empty prev or next stands for the top or the bottom of the column
+	neighbours := func() (prev, next string, err error) {
+		switch {
+		case afterID != nil && beforeID != nil:
+			if prev, err = neighbourRank(*afterID); err != nil {
+				return "", "", err
+			}
+			if next, err = neighbourRank(*beforeID); err != nil {
+				return "", "", err
+			}
+			if prev >= next {
+				return "", "", ErrInvalidPosition
+			}
+		case afterID != nil:
+			if prev, err = neighbourRank(*afterID); err != nil {
+				return "", "", err
+			}
+			query := fmt.Sprintf(`SELECT COALESCE(MIN(rank), '') FROM tasks WHERE %s AND rank > $%d`, filter, len(args)+1)
+			err = tx.QueryRow(ctx, query, append(args, prev)...).Scan(&next)
+		case beforeID != nil:
+			if next, err = neighbourRank(*beforeID); err != nil {
+				return "", "", err
+			}
+			query := fmt.Sprintf(`SELECT COALESCE(MAX(rank), '') FROM tasks WHERE %s AND rank < $%d`, filter, len(args)+1)
+			err = tx.QueryRow(ctx, query, append(args, next)...).Scan(&prev)
+		default:
+			prev, err = lastRank(ctx, tx, col, id)
+		}
+		return prev, next, err
+	}
+
+	rank, err := rankBetween(ctx, tx, col, id, neighbours)
+	if err != nil {
+		return err
+	}
+	if !models.SameIteration(iterationID, current.IterationID) {
+		if err := moveTree(ctx, tx, id, iterationID); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.Exec(ctx, `UPDATE tasks SET status = $1, rank = $2, updated_at = NOW() WHERE id = $3`, status, rank, id); err != nil {
+		return err
+	}
+
+	if current.Status != status {
+		if err := recordStatusChange(ctx, tx, id, &current.Status, status, actorID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Board is a board locked by the transaction of a write; the checks of the write read through it,
+// so they see the board as it is until the write commits, earlier changes of the same transaction included
+type Board struct {
+	tx pgx.Tx
+}
+
+// Tx returns the transaction, for the reads of other repositories
+func (b Board) Tx() pgx.Tx {
+	return b.tx
+}
+
+// CountInColumn returns how many top-level tasks of the iteration are in the status, leaving out excludeID
+func (b Board) CountInColumn(ctx context.Context, iterationID uuid.UUID, status models.StatusEnum, excludeID uuid.UUID) (int, error) {
+	const query = `
+		SELECT COUNT(*) FROM tasks
+		WHERE iteration_id = $1 AND parent_task_id IS NULL AND status = $2 AND id <> $3
+	`
+	var count int
+	err := b.tx.QueryRow(ctx, query, iterationID, status, excludeID).Scan(&count)
+	return count, err
+}
+
+// lockTask locks the row of a task for the rest of the transaction and returns where it sits
+func lockTask(ctx context.Context, tx pgx.Tx, id uuid.UUID) (models.Task, error) {
+	var t models.Task
+	const query = `SELECT id, project_id, iteration_id, parent_task_id, status, rank FROM tasks WHERE id = $1 FOR UPDATE`
+	err := tx.QueryRow(ctx, query, id).Scan(&t.ID, &t.ProjectID, &t.IterationID, &t.ParentTaskID, &t.Status, &t.Rank)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Task{}, ErrNotFound
+		}
+		return models.Task{}, err
+	}
+	return t, nil
+}
+
+// boardColumn is the set of tasks ranked together: the tasks of an iteration with the same parent and status,
+// or the tasks of the project backlog with the same parent, which keeps one priority order whatever their status
+type boardColumn struct {
+	projectID    uuid.UUID
+	iterationID  *uuid.UUID
+	parentTaskID *uuid.UUID
+	status       models.StatusEnum
+}
+
+func columnOf(t models.Task) boardColumn {
+	return boardColumn{projectID: t.ProjectID, iterationID: t.IterationID, parentTaskID: t.ParentTaskID, status: t.Status}
+}
+
+// filter returns the condition selecting the tasks of the column but excludeID, with its arguments
+func (c boardColumn) filter(excludeID uuid.UUID) (string, []interface{}) {
+	if c.iterationID == nil {
+		return `project_id = $1 AND iteration_id IS NULL AND parent_task_id IS NOT DISTINCT FROM $2 AND id <> $3`,
+			[]interface{}{c.projectID, c.parentTaskID, excludeID}
+	}
+	return `iteration_id = $1 AND parent_task_id IS NOT DISTINCT FROM $2 AND status = $3 AND id <> $4`,
+		[]interface{}{*c.iterationID, c.parentTaskID, c.status, excludeID}
+}
+
+// lockBoard serializes rank changes on the board holding the column so two tasks never take the same rank
+func lockBoard(ctx context.Context, tx pgx.Tx, c boardColumn) error {
+	board := c.projectID
+	if c.iterationID != nil {
+		board = *c.iterationID
+	}
+	_, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended('board:' || $1::text, 0))`, board)
+	return err
+}
+
+// rankBetween returns a rank between the neighbours found in the column, the task excludeID left out
+// When repeated insertions at the same spot made that rank too long, the column is respaced
+// and the neighbours are looked up again
+func rankBetween(ctx context.Context, tx pgx.Tx, c boardColumn, excludeID uuid.UUID, neighbours func() (prev, next string, err error)) (string, error) {
+	prev, next, err := neighbours()
+	if err != nil {
+		return "", err
+	}
+	rank, err := models.RankBetween(prev, next)
+	if !errors.Is(err, models.ErrRankTooLong) {
+		return rank, err
+	}
+
+	if err := respaceColumn(ctx, tx, c, excludeID); err != nil {
+		return "", err
+	}
+	if prev, next, err = neighbours(); err != nil {
+		return "", err
+	}
+	return models.RankBetween(prev, next)
+}
+
+// respaceColumn gives the tasks of a column short, evenly spaced ranks, keeping their order
+func respaceColumn(ctx context.Context, tx pgx.Tx, c boardColumn, excludeID uuid.UUID) error {
+	filter, args := c.filter(excludeID)
+	rows, err := tx.Query(ctx, `SELECT id FROM tasks WHERE `+filter+` ORDER BY rank ASC, id ASC`, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	const query = `
+		UPDATE tasks
+		SET rank = r.rank
+		FROM unnest($1::uuid[], $2::text[]) AS r(id, rank)
+		WHERE tasks.id = r.id
+	`
+	_, err = tx.Exec(ctx, query, ids, models.SpreadRanks(len(ids)))
+	return err
+}
+
+// lastRank returns the rank at the bottom of a column, or an empty string when the column is empty
+func lastRank(ctx context.Context, tx pgx.Tx, c boardColumn, excludeID uuid.UUID) (string, error) {
+	filter, args := c.filter(excludeID)
+	var rank string
+	err := tx.QueryRow(ctx, `SELECT COALESCE(MAX(rank), '') FROM tasks WHERE `+filter, args...).Scan(&rank)
+	return rank, err
+}
+
+// moveTree puts a task and its sub-tasks at any depth in another iteration, or in the backlog when iterationID is nil
+func moveTree(ctx context.Context, tx pgx.Tx, id uuid.UUID, iterationID *uuid.UUID) error {
+	const query = `
+		WITH RECURSIVE tree AS (
+			SELECT id FROM tasks WHERE id = $1
+			UNION ALL
+			SELECT t.id FROM tasks t INNER JOIN tree ON t.parent_task_id = tree.id
+		)
+		UPDATE tasks
+		SET iteration_id = $2, updated_at = NOW()
+		WHERE id IN (SELECT id FROM tree)
+	`
+	_, err := tx.Exec(ctx, query, id, iterationID)
+	return err
+}
+
+// rankInNewStatus puts a task changing status at the bottom of its new column
+// The backlog keeps its priority order whatever the status, so backlog tasks stay where they are
+func rankInNewStatus(ctx context.Context, tx pgx.Tx, current models.Task, status models.StatusEnum) error {
+	if current.IterationID == nil {
+		return nil
+	}
+
+	col := columnOf(current)
+	col.status = status
+	if err := lockBoard(ctx, tx, col); err != nil {
+		return err
+	}
+	return rankAtBottom(ctx, tx, col, current.ID)
+}
+
+// rankAtBottom puts the task at the bottom of the column
+func rankAtBottom(ctx context.Context, tx pgx.Tx, c boardColumn, id uuid.UUID) error {
+	rank, err := rankBetween(ctx, tx, c, id, func() (string, string, error) {
+		last, err := lastRank(ctx, tx, c, id)
+		return last, "", err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `UPDATE tasks SET rank = $1 WHERE id = $2`, rank, id)
+	return err
+}
+
+func recordStatusChange(ctx context.Context, tx pgx.Tx, taskID uuid.UUID, from *models.StatusEnum, to models.StatusEnum, actorID uuid.UUID) error {
+	const query = `
+		INSERT INTO task_status_changes (id, task_id, from_status, to_status, actor_id)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	var actor interface{}
+	if actorID != uuid.Nil {
+		actor = actorID
+	} else {
+		actor = nil
+	}
+
+	_, err := tx.Exec(ctx, query, uuid.New(), taskID, from, to, actor)
+	return err
+}
+
+// GetStatusChangesByIterationID returns the status history of every task in the iteration,
+// ordered chronologically
+func (r *Repository) GetStatusChangesByIterationID(ctx context.Context, iterationID uuid.UUID) ([]models.TaskStatusChange, error) {
+	const query = `
+		SELECT sc.id, sc.task_id, sc.from_status, sc.to_status, sc.actor_id, sc.changed_at
+		FROM task_status_changes sc
+		INNER JOIN tasks t ON sc.task_id = t.id
+		WHERE t.iteration_id = $1
+		ORDER BY sc.changed_at ASC
+	`
+	return r.queryStatusChanges(ctx, query, iterationID)
+}
+
+// GetStatusChangesByTaskID returns the status history of a task, ordered chronologically
+func (r *Repository) GetStatusChangesByTaskID(ctx context.Context, taskID uuid.UUID) ([]models.TaskStatusChange, error) {
+	const query = `
+		SELECT sc.id, sc.task_id, sc.from_status, sc.to_status, sc.actor_id, sc.changed_at
+		FROM task_status_changes sc
+		WHERE sc.task_id = $1
+		ORDER BY sc.changed_at ASC
+	`
+	return r.queryStatusChanges(ctx, query, taskID)
+}
+
+func (r *Repository) queryStatusChanges(ctx context.Context, query string, arg uuid.UUID) ([]models.TaskStatusChange, error) {
+	rows, err := r.db.Query(ctx, query, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []models.TaskStatusChange{}
+	for rows.Next() {
+		var sc models.TaskStatusChange
+		if err := rows.Scan(
+			&sc.ID,
+			&sc.TaskID,
+			&sc.FromStatus,
+			&sc.ToStatus,
+			&sc.ActorID,
+			&sc.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		changes = append(changes, sc)
+	}
+
+	return changes, rows.Err()
 }
 
 func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
@@ -260,3 +871,33 @@ func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 	return nil
 }
+
+// GetCompletionsByProjectID returns when each currently completed top-level task of the project
+// was last moved to Completed, oldest first
+func (r *Repository) GetCompletionsByProjectID(ctx context.Context, projectID uuid.UUID) ([]models.TaskCompletion, error) {
+	const query = `
+		SELECT t.id, t.iteration_id, t.points, MAX(sc.changed_at) as completed_at
+		FROM tasks t
+		INNER JOIN iterations i ON t.iteration_id = i.id
+		INNER JOIN task_status_changes sc ON sc.task_id = t.id AND sc.to_status = 'Completed'
+		WHERE i.project_id = $1 AND t.status = 'Completed' AND t.parent_task_id IS NULL
+		GROUP BY t.id, t.iteration_id, t.points
+		ORDER BY completed_at ASC
+	`
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	completions := []models.TaskCompletion{}
+	for rows.Next() {
+		var c models.TaskCompletion
+		if err := rows.Scan(&c.TaskID, &c.IterationID, &c.Points, &c.CompletedAt); err != nil {
+			return nil, err
+		}
+		completions = append(completions, c)
+	}
+
+	return completions, rows.Err()
+}
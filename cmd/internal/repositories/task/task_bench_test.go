@@ -0,0 +1,96 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"prodyo-backend/cmd/internal/repositories/dbtest"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// BenchmarkGetAll lists iterations seeded with top-level tasks that each have two sub-tasks,
+// two bugs and two improvements; run it against docker-compose Postgres with
+// go test -run '^$' -bench GetAll ./cmd/internal/repositories/task
+func BenchmarkGetAll(b *testing.B) {
+	db := dbtest.Open(b)
+	repo := New(db)
+
+	for _, size := range []int{10, 100, 500} {
+		b.Run(fmt.Sprintf("tasks=%d", size), func(b *testing.B) {
+			iterationID := seedIteration(b, db, size)
+			ctx := context.Background()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				tasks, err := repo.GetAll(ctx, iterationID)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(tasks) != size {
+					b.Fatalf("got %d tasks, want %d", len(tasks), size)
+				}
+			}
+		})
+	}
+}
+
+// seedIteration creates a project with one iteration holding the given number of top-level tasks
+// and their relations; the project and everything in it is deleted when b ends
+func seedIteration(b *testing.B, db *pgxpool.Pool, tasks int) uuid.UUID {
+	b.Helper()
+	ctx := context.Background()
+
+	var projectID, iterationID uuid.UUID
+	if err := db.QueryRow(ctx, `
+		INSERT INTO projects (name, description) VALUES ('benchmark', 'seeded by BenchmarkGetAll')
+		RETURNING id
+	`).Scan(&projectID); err != nil {
+		b.Fatalf("failed to seed project: %v", err)
+	}
+	b.Cleanup(func() {
+		if _, err := db.Exec(context.Background(), `DELETE FROM projects WHERE id = $1`, projectID); err != nil {
+			b.Errorf("failed to delete seeded project: %v", err)
+		}
+	})
+
+	if err := db.QueryRow(ctx, `
+		INSERT INTO iterations (project_id, number, start_at, end_at)
+		VALUES ($1, 1, NOW(), NOW() + INTERVAL '14 days')
+		RETURNING id
+	`, projectID).Scan(&iterationID); err != nil {
+		b.Fatalf("failed to seed iteration: %v", err)
+	}
+
+	if _, err := db.Exec(ctx, `
+		INSERT INTO tasks (project_id, iteration_id, name, description, rank)
+		SELECT $1, $2, 'task ' || n, 'seeded task', LPAD(n::text, 10, '0') || 'V'
+		FROM generate_series(1, $3::int) AS n
+	`, projectID, iterationID, tasks); err != nil {
+		b.Fatalf("failed to seed tasks: %v", err)
+	}
+
+	relations := []string{
+		`INSERT INTO tasks (project_id, iteration_id, name, parent_task_id, rank)
+		 SELECT t.project_id, t.iteration_id, 'sub-task ' || n, t.id, LPAD(n::text, 10, '0') || 'V'
+		 FROM tasks t, generate_series(1, 2) AS n
+		 WHERE t.iteration_id = $1 AND t.parent_task_id IS NULL`,
+		`INSERT INTO bugs (task_id, number, description)
+		 SELECT t.id, n, 'seeded bug'
+		 FROM tasks t, generate_series(1, 2) AS n
+		 WHERE t.iteration_id = $1 AND t.parent_task_id IS NULL`,
+		`INSERT INTO improvements (task_id, number, description)
+		 SELECT t.id, n, 'seeded improvement'
+		 FROM tasks t, generate_series(1, 2) AS n
+		 WHERE t.iteration_id = $1 AND t.parent_task_id IS NULL`,
+	}
+	for _, query := range relations {
+		if _, err := db.Exec(ctx, query, iterationID); err != nil {
+			b.Fatalf("failed to seed task relations: %v", err)
+		}
+	}
+
+	return iterationID
+}
@@ -3,7 +3,9 @@ package user
 import (
 	"context"
 	"errors"
+	"fmt"
 	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/listquery"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -22,21 +24,62 @@ func New(db *pgxpool.Pool) *Repository {
 	return &Repository{db: db}
 }
 
-func (r *Repository) GetAll(ctx context.Context, pagination models.PaginationRequest) ([]models.User, models.PaginationResponse, error) {
-	countQuery := `SELECT COUNT(*) FROM users`
+// Keyset lists, so a cursor of one cannot page through the other
+var (
+	listCursor    = models.CursorScope("users", "created_at")
+	membersCursor = models.CursorScope("project-users", "name")
+)
+
+// listColumns maps the fields of models.UserListSpec to columns
+var listColumns = listquery.Columns{
+	"email":      "email",
+	"name":       "name",
+	"created_at": "created_at",
+}
+
+func (r *Repository) GetAll(ctx context.Context, pagination models.PaginationRequest, q models.ListQuery) ([]models.User, models.PaginationResponse, error) {
+	b := listquery.NewBuilder()
+	if err := b.Filters(q, listColumns); err != nil {
+		return nil, models.PaginationResponse{}, err
+	}
+	b.Search(q.Search, "name", "email")
+
+	countQuery := `SELECT COUNT(*) FROM users ` + b.WhereClause()
 	var total int64
-	err := r.db.QueryRow(ctx, countQuery).Scan(&total)
+	err := r.db.QueryRow(ctx, countQuery, b.Args()...).Scan(&total)
+	if err != nil {
+		return nil, models.PaginationResponse{}, err
+	}
+
+	offset := pagination.GetOffset()
+	cursor, err := pagination.DecodeCursor(listCursor)
+	if err != nil {
+		return nil, models.PaginationResponse{}, err
+	}
+
+	if cursor != nil {
+		at, err := cursor.Time()
+		if err != nil {
+			return nil, models.PaginationResponse{}, err
+		}
+		b.Where(fmt.Sprintf("(created_at, id) < (%s::timestamptz, %s::uuid)", b.Arg(at), b.Arg(cursor.ID)))
+	}
+
+	orderBy, err := listquery.OrderBy(q, listColumns, "created_at DESC, id DESC", "id DESC")
 	if err != nil {
 		return nil, models.PaginationResponse{}, err
 	}
 
+	// One extra row is read to know whether there is a next page
+	limitArg := b.Arg(pagination.PageSize + 1)
+	offsetArg := b.Arg(offset)
 	query := `
 		SELECT id, name, email, password_hash, created_at, updated_at
 		FROM users
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
-	`
-	rows, err := r.db.Query(ctx, query, pagination.PageSize, pagination.GetOffset())
+		` + b.WhereClause() + `
+		` + orderBy + `
+		LIMIT ` + limitArg + ` OFFSET ` + offsetArg
+	rows, err := r.db.Query(ctx, query, b.Args()...)
 	if err != nil {
 		return nil, models.PaginationResponse{}, err
 	}
@@ -59,10 +102,20 @@ func (r *Repository) GetAll(ctx context.Context, pagination models.PaginationReq
 	}
 
 	if rows.Err() != nil {
-		return nil, models.PaginationResponse{}, err
+		return nil, models.PaginationResponse{}, rows.Err()
+	}
+
+	// Cursors follow the default order only
+	var nextCursor string
+	if len(users) > pagination.PageSize {
+		users = users[:pagination.PageSize]
+		if len(q.Sort) == 0 {
+			last := users[len(users)-1]
+			nextCursor = models.EncodeTimeCursor(listCursor, last.CreatedAt, last.ID)
+		}
 	}
 
-	paginationResp := models.NewPaginationResponse(pagination.Page, pagination.PageSize, total)
+	paginationResp := models.NewPagePaginationResponse(pagination, total, nextCursor)
 	return users, paginationResp, nil
 }
 
@@ -179,16 +232,28 @@ func (r *Repository) GetByProjectID(ctx context.Context, projectID uuid.UUID, pa
 		return nil, models.PaginationResponse{}, err
 	}
 
-	// Then get paginated results
+	offset := pagination.GetOffset()
+	cursor, err := pagination.DecodeCursor(membersCursor)
+	if err != nil {
+		return nil, models.PaginationResponse{}, err
+	}
+
+	var cursorName, cursorID interface{}
+	if cursor != nil {
+		cursorName, cursorID = cursor.Key, cursor.ID
+	}
+
+	// Then get paginated results, with one extra row to know whether there is a next page
 	query := `
 		SELECT u.id, u.name, u.email, u.created_at, u.updated_at
 		FROM users u
 		INNER JOIN project_members pm ON u.id = pm.user_id
 		WHERE pm.project_id = $1
-		ORDER BY u.name ASC
+		  AND ($4::text IS NULL OR (u.name, u.id) > ($4::text, $5::uuid))
+		ORDER BY u.name ASC, u.id ASC
 		LIMIT $2 OFFSET $3
 	`
-	rows, err := r.db.Query(ctx, query, projectID, pagination.PageSize, pagination.GetOffset())
+	rows, err := r.db.Query(ctx, query, projectID, pagination.PageSize+1, offset, cursorName, cursorID)
 	if err != nil {
 		return nil, models.PaginationResponse{}, err
 	}
@@ -213,6 +278,13 @@ func (r *Repository) GetByProjectID(ctx context.Context, projectID uuid.UUID, pa
 		return nil, models.PaginationResponse{}, rows.Err()
 	}
 
-	paginationResp := models.NewPaginationResponse(pagination.Page, pagination.PageSize, total)
+	var nextCursor string
+	if len(users) > pagination.PageSize {
+		users = users[:pagination.PageSize]
+		last := users[len(users)-1]
+		nextCursor = models.EncodeCursor(membersCursor, last.Name, last.ID)
+	}
+
+	paginationResp := models.NewPagePaginationResponse(pagination, total, nextCursor)
 	return users, paginationResp, nil
 }
\ No newline at end of file
@@ -0,0 +1,85 @@
+package wip_limit
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// GetByProjectID returns the WIP limits of a project; statuses without a limit are left out
+func (r *Repository) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]models.WIPLimit, error) {
+	const query = `
+		SELECT status, max_tasks
+		FROM project_wip_limits
+		WHERE project_id = $1
+		ORDER BY status ASC
+	`
+	rows, err := r.db.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	limits := []models.WIPLimit{}
+	for rows.Next() {
+		var l models.WIPLimit
+		if err := rows.Scan(&l.Status, &l.MaxTasks); err != nil {
+			return nil, err
+		}
+		limits = append(limits, l)
+	}
+
+	return limits, rows.Err()
+}
+
+// GetByStatus returns the limit of one status, or 0 when the project does not limit it
+func (r *Repository) GetByStatus(ctx context.Context, projectID uuid.UUID, status models.StatusEnum) (int, error) {
+	const query = `SELECT max_tasks FROM project_wip_limits WHERE project_id = $1 AND status = $2`
+	var maxTasks int
+	err := r.db.QueryRow(ctx, query, projectID, status).Scan(&maxTasks)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return maxTasks, nil
+}
+
+// Replace swaps the WIP limits of a project for the given list
+func (r *Repository) Replace(ctx context.Context, projectID uuid.UUID, limits []models.WIPLimit) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM project_wip_limits WHERE project_id = $1`, projectID); err != nil {
+		return err
+	}
+
+	const query = `
+		INSERT INTO project_wip_limits (project_id, status, max_tasks)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (project_id, status) DO UPDATE SET max_tasks = EXCLUDED.max_tasks
+	`
+	for _, l := range limits {
+		if _, err := tx.Exec(ctx, query, projectID, l.Status, l.MaxTasks); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
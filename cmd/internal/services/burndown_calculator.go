@@ -0,0 +1,154 @@
+package services
+
+import (
+	"time"
+
+	"prodyo-backend/cmd/internal/models"
+)
+
+const dateLayout = "2006-01-02"
+
+type BurndownCalculator struct {
+	iteration models.Iteration
+	tasks     []models.Task
+	timeline  *statusTimeline
+	calendar  models.WorkingCalendar
+	now       time.Time
+}
+
+// NewBurndownCalculator builds the series over the project days of the iteration; the ideal line
+// only burns on working days
+func NewBurndownCalculator(iteration models.Iteration, tasks []models.Task, changes []models.TaskStatusChange, calendar models.WorkingCalendar) *BurndownCalculator {
+	return &BurndownCalculator{
+		iteration: iteration,
+		tasks:     tasks,
+		timeline:  newStatusTimeline(changes),
+		calendar:  calendar,
+		now:       time.Now(),
+	}
+}
+
+type dailyProgress struct {
+	scopePoints     float64
+	completedPoints float64
+	remainingHours  float64
+}
+
+func (bc *BurndownCalculator) Calculate() models.BurndownResponse {
+	days := bc.calendar.Days(bc.iteration.StartAt, bc.iteration.EndAt)
+
+	response := models.BurndownResponse{
+		IterationID: bc.iteration.ID,
+		StartAt:     bc.iteration.StartAt.In(bc.calendar.Location()),
+		EndAt:       bc.iteration.EndAt.In(bc.calendar.Location()),
+		XAxis: models.AxisDefinition{
+			Type:  "DATE",
+			Label: "Dia",
+		},
+		Burndown: models.BurndownSeries{
+			YAxis: models.AxisDefinition{
+				Label: "Trabalho restante",
+			},
+			RemainingPoints: []models.DataPoint{},
+			RemainingHours:  []models.DataPoint{},
+			IdealPoints:     []models.DataPoint{},
+			IdealHours:      []models.DataPoint{},
+		},
+		Burnup: models.BurnupSeries{
+			YAxis: models.AxisDefinition{
+				Label: "Pontos",
+			},
+			CompletedPoints: []models.DataPoint{},
+			ScopePoints:     []models.DataPoint{},
+		},
+	}
+
+	if len(days) == 0 {
+		return response
+	}
+
+	// The ideal line starts from the work planned on the first day and reaches zero on the last one,
+	// staying flat over the days off
+	initial := bc.progressAt(days[0].AddDate(0, 0, 1))
+	initialRemainingPoints := initial.scopePoints - initial.completedPoints
+
+	burnDays := 0
+	for _, day := range days[1:] {
+		if bc.calendar.IsWorkingDay(day) {
+			burnDays++
+		}
+	}
+
+	burned := 0
+	for i, day := range days {
+		label := day.Format(dateLayout)
+
+		if i > 0 && bc.calendar.IsWorkingDay(day) {
+			burned++
+		}
+		remainingRatio := 1.0
+		switch {
+		case burnDays > 0:
+			remainingRatio = 1 - float64(burned)/float64(burnDays)
+		case len(days) > 1:
+			// Without working days the line burns evenly rather than never reaching zero
+			remainingRatio = 1 - float64(i)/float64(len(days)-1)
+		}
+		response.Burndown.IdealPoints = append(response.Burndown.IdealPoints, models.DataPoint{
+			X: label,
+			Y: initialRemainingPoints * remainingRatio,
+		})
+		response.Burndown.IdealHours = append(response.Burndown.IdealHours, models.DataPoint{
+			X: label,
+			Y: initial.remainingHours * remainingRatio,
+		})
+
+		// Days that have not started yet have no actual values
+		if day.After(bc.now) {
+			continue
+		}
+
+		at := day.AddDate(0, 0, 1)
+		if at.After(bc.now) {
+			at = bc.now
+		}
+		progress := bc.progressAt(at)
+
+		response.Burndown.RemainingPoints = append(response.Burndown.RemainingPoints, models.DataPoint{
+			X: label,
+			Y: progress.scopePoints - progress.completedPoints,
+		})
+		response.Burndown.RemainingHours = append(response.Burndown.RemainingHours, models.DataPoint{
+			X: label,
+			Y: progress.remainingHours,
+		})
+		response.Burnup.CompletedPoints = append(response.Burnup.CompletedPoints, models.DataPoint{
+			X: label,
+			Y: progress.completedPoints,
+		})
+		response.Burnup.ScopePoints = append(response.Burnup.ScopePoints, models.DataPoint{
+			X: label,
+			Y: progress.scopePoints,
+		})
+	}
+
+	return response
+}
+
+func (bc *BurndownCalculator) progressAt(t time.Time) dailyProgress {
+	var progress dailyProgress
+	for _, task := range bc.tasks {
+		status, exists := bc.timeline.statusAt(task, t)
+		if !exists {
+			continue
+		}
+
+		progress.scopePoints += float64(task.Points)
+		if status == models.StatusCompleted {
+			progress.completedPoints += float64(task.Points)
+		} else {
+			progress.remainingHours += task.ExpectedTime
+		}
+	}
+	return progress
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"time"
+
+	"prodyo-backend/cmd/internal/models"
+)
+
+// maxCadenceSearchDays bounds the search for working days in a calendar without any left
+const maxCadenceSearchDays = 366
+
+// CadenceGenerator lays out iterations following a project cadence on the project calendar
+type CadenceGenerator struct {
+	cadence  models.IterationCadence
+	calendar models.WorkingCalendar
+}
+
+func NewCadenceGenerator(cadence models.IterationCadence, calendar models.WorkingCalendar) *CadenceGenerator {
+	return &CadenceGenerator{
+		cadence:  cadence,
+		calendar: calendar,
+	}
+}
+
+// Next returns the dates of the iteration after previous, nil for the first one of the project
+// It starts at midnight of the first StartWeekday that is GapDays after previous ends, and never
+// before tomorrow so generated iterations are always upcoming
+// It ends at the last second of its LengthDays-th working day, holidays not counting
+// ok is false when the calendar has no working day left to end it on
+func (g *CadenceGenerator) Next(previous *models.Iteration, now time.Time) (startAt, endAt time.Time, ok bool) {
+	earliest := g.calendar.StartOfDay(now).AddDate(0, 0, 1)
+	if previous != nil {
+		afterPrevious := g.calendar.StartOfDay(previous.EndAt).AddDate(0, 0, 1+g.cadence.GapDays)
+		if afterPrevious.After(earliest) {
+			earliest = afterPrevious
+		}
+	}
+
+	start := earliest
+	for start.Weekday() != g.cadence.StartWeekday {
+		start = start.AddDate(0, 0, 1)
+	}
+
+	remaining := g.cadence.LengthDays
+	day := start
+	for skipped := 0; skipped < maxCadenceSearchDays; day = day.AddDate(0, 0, 1) {
+		if !g.calendar.IsWorkingDay(day) {
+			skipped++
+			continue
+		}
+		skipped = 0
+		remaining--
+		if remaining == 0 {
+			return start, day.AddDate(0, 0, 1).Add(-time.Second), true
+		}
+	}
+	return time.Time{}, time.Time{}, false
+}
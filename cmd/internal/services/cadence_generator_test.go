@@ -0,0 +1,135 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+)
+
+func TestCadenceGeneratorNext(t *testing.T) {
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	calendar := func(t *testing.T, timezone string, holidays ...string) models.WorkingCalendar {
+		t.Helper()
+		list := make([]models.Holiday, 0, len(holidays))
+		for _, date := range holidays {
+			list = append(list, models.Holiday{Date: date, Name: "Feriado"})
+		}
+		c, err := models.NewWorkingCalendar(uuid.New(), timezone, weekdays, list)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	// Iterations end at the last second of their last working day
+	endOf := func(d time.Time) time.Time { return d.AddDate(0, 0, 1).Add(-time.Second) }
+	ending := func(end time.Time) *models.Iteration { return &models.Iteration{EndAt: end} }
+	day := func(n int) time.Time { return monday.AddDate(0, 0, n) }
+
+	saoPaulo := calendar(t, "America/Sao_Paulo")
+	local := func(n int) time.Time {
+		return time.Date(2026, time.January, 5+n, 0, 0, 0, 0, saoPaulo.Location())
+	}
+
+	tests := []struct {
+		name     string
+		cadence  models.IterationCadence
+		calendar models.WorkingCalendar
+		previous *models.Iteration
+		now      time.Time
+		start    time.Time
+		end      time.Time
+	}{
+		{
+			name:     "first iteration starts on the next start weekday after today",
+			cadence:  models.IterationCadence{LengthDays: 10, StartWeekday: time.Monday},
+			calendar: calendar(t, "UTC"),
+			now:      monday.Add(10 * time.Hour),
+			start:    day(7),
+			end:      endOf(day(18)),
+		},
+		{
+			name:     "right after the previous one",
+			cadence:  models.IterationCadence{LengthDays: 10, StartWeekday: time.Monday},
+			calendar: calendar(t, "UTC"),
+			previous: ending(endOf(day(18))),
+			now:      monday,
+			start:    day(21),
+			end:      endOf(day(32)),
+		},
+		{
+			name:     "gap after the previous one",
+			cadence:  models.IterationCadence{LengthDays: 5, StartWeekday: time.Monday, GapDays: 7},
+			calendar: calendar(t, "UTC"),
+			previous: ending(endOf(day(18))),
+			now:      monday,
+			start:    day(28),
+			end:      endOf(day(32)),
+		},
+		{
+			name:     "previous one long over",
+			cadence:  models.IterationCadence{LengthDays: 5, StartWeekday: time.Wednesday},
+			calendar: calendar(t, "UTC"),
+			previous: ending(endOf(day(-60))),
+			now:      monday,
+			start:    day(2),
+			end:      endOf(day(8)),
+		},
+		{
+			name:     "holidays push the end",
+			cadence:  models.IterationCadence{LengthDays: 5, StartWeekday: time.Monday},
+			calendar: calendar(t, "UTC", "2026-01-14", "2026-01-16"),
+			now:      monday,
+			start:    day(7),
+			end:      endOf(day(15)),
+		},
+		{
+			name:     "starting on a holiday counts from the next working day",
+			cadence:  models.IterationCadence{LengthDays: 1, StartWeekday: time.Monday},
+			calendar: calendar(t, "UTC", "2026-01-12"),
+			now:      monday,
+			start:    day(7),
+			end:      endOf(day(8)),
+		},
+		{
+			name:     "days are those of the project timezone",
+			cadence:  models.IterationCadence{LengthDays: 5, StartWeekday: time.Monday},
+			calendar: saoPaulo,
+			// Still Sunday evening in São Paulo
+			now:   monday.Add(2 * time.Hour),
+			start: local(0),
+			end:   endOf(local(4)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, ok := NewCadenceGenerator(tt.cadence, tt.calendar).Next(tt.previous, tt.now)
+			if !ok {
+				t.Fatal("no working day found")
+			}
+			if !start.Equal(tt.start) || !end.Equal(tt.end) {
+				t.Errorf("got %v to %v, want %v to %v", start, end, tt.start, tt.end)
+			}
+		})
+	}
+}
+
+func TestCadenceGeneratorNextWithoutWorkingDays(t *testing.T) {
+	// Every Saturday, the only working day, is a holiday for longer than the search goes
+	holidays := []models.Holiday{}
+	for saturday := monday.AddDate(0, 0, 5); saturday.Before(monday.AddDate(2, 0, 0)); saturday = saturday.AddDate(0, 0, 7) {
+		holidays = append(holidays, models.Holiday{Date: saturday.Format(models.DateLayout), Name: "Sábado"})
+	}
+	calendar, err := models.NewWorkingCalendar(uuid.New(), "UTC", []time.Weekday{time.Saturday}, holidays)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cadence := models.IterationCadence{LengthDays: 1, StartWeekday: time.Monday}
+	if start, end, ok := NewCadenceGenerator(cadence, calendar).Next(nil, monday); ok {
+		t.Errorf("got %v to %v, want no iteration", start, end)
+	}
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type CapacityCalculator struct {
+	iteration     models.Iteration
+	tasks         []models.Task
+	availability  []models.MemberAvailability
+	pointsPerHour float64
+	calendar      models.WorkingCalendar
+}
+
+// NewCapacityCalculator compares the top-level tasks of the iteration with the member availability
+// pointsPerHour is the historical speed used to express capacity in points
+// Members work on the working days of the project calendar
+func NewCapacityCalculator(iteration models.Iteration, tasks []models.Task, availability []models.MemberAvailability, pointsPerHour float64, calendar models.WorkingCalendar) *CapacityCalculator {
+	return &CapacityCalculator{
+		iteration:     iteration,
+		tasks:         tasks,
+		availability:  availability,
+		pointsPerHour: pointsPerHour,
+		calendar:      calendar,
+	}
+}
+
+func (cc *CapacityCalculator) Calculate() models.IterationCapacity {
+	workingDays := cc.calendar.WorkingDaysBetween(cc.iteration.StartAt, cc.iteration.EndAt)
+
+	response := models.IterationCapacity{
+		IterationID:   cc.iteration.ID,
+		WorkingDays:   len(workingDays),
+		PointsPerHour: cc.pointsPerHour,
+		Members:       []models.MemberCapacity{},
+		Warnings:      []models.CapacityWarning{},
+	}
+
+	members := make(map[uuid.UUID]*models.MemberCapacity)
+	recorded := make(map[uuid.UUID]bool)
+	var order []uuid.UUID
+	for _, a := range cc.availability {
+		m := &models.MemberCapacity{
+			Member:        a.Member,
+			HoursPerDay:   a.HoursPerDay,
+			DaysOff:       a.DaysOff,
+			AvailableDays: len(workingDays) - countDaysOff(workingDays, a.DaysOff),
+		}
+		m.CapacityHours = a.HoursPerDay * float64(m.AvailableDays)
+		m.CapacityPoints = m.CapacityHours * cc.pointsPerHour
+		members[a.Member.ID] = m
+		recorded[a.Member.ID] = true
+		order = append(order, a.Member.ID)
+	}
+
+	for _, task := range cc.tasks {
+		if task.Assignee.ID == uuid.Nil {
+			addPlanned(&response.Unassigned, task)
+			continue
+		}
+
+		m, ok := members[task.Assignee.ID]
+		if !ok {
+			m = &models.MemberCapacity{Member: task.Assignee.ToMember(), DaysOff: []time.Time{}}
+			members[task.Assignee.ID] = m
+			order = append(order, task.Assignee.ID)
+		}
+		addPlanned(&m.CapacityPlanned, task)
+	}
+
+	team := &response.Team
+	team.CapacityPlanned = response.Unassigned
+	for _, id := range order {
+		m := members[id]
+		cc.checkOvercommitment(&m.CapacitySummary)
+		if m.Overcommitted {
+			response.Warnings = append(response.Warnings, cc.memberWarning(*m, recorded[id]))
+		}
+
+		team.Tasks += m.Tasks
+		team.PlannedHours += m.PlannedHours
+		team.PlannedPoints += m.PlannedPoints
+		team.CapacityHours += m.CapacityHours
+		team.CapacityPoints += m.CapacityPoints
+		response.Members = append(response.Members, *m)
+	}
+
+	cc.checkOvercommitment(team)
+	if team.Overcommitted {
+		response.Warnings = append(response.Warnings, models.CapacityWarning{
+			Message: fmt.Sprintf("The team is planned for %s against a capacity of %s", cc.describePlanned(*team), cc.describeCapacity(*team)),
+		})
+	}
+
+	return response
+}
+
+// checkOvercommitment flags planned hours above the capacity, and planned points above it
+// when the historical speed is known
+func (cc *CapacityCalculator) checkOvercommitment(s *models.CapacitySummary) {
+	s.Overcommitted = s.PlannedHours > s.CapacityHours ||
+		(cc.pointsPerHour > 0 && float64(s.PlannedPoints) > s.CapacityPoints)
+}
+
+func (cc *CapacityCalculator) memberWarning(m models.MemberCapacity, recorded bool) models.CapacityWarning {
+	id := m.Member.ID
+	if !recorded {
+		return models.CapacityWarning{
+			MemberID: &id,
+			Message:  fmt.Sprintf("%s is planned for %s but has no availability recorded for the iteration", m.Member.Name, cc.describePlanned(m.CapacitySummary)),
+		}
+	}
+	return models.CapacityWarning{
+		MemberID: &id,
+		Message:  fmt.Sprintf("%s is planned for %s against a capacity of %s", m.Member.Name, cc.describePlanned(m.CapacitySummary), cc.describeCapacity(m.CapacitySummary)),
+	}
+}
+
+func (cc *CapacityCalculator) describePlanned(s models.CapacitySummary) string {
+	return fmt.Sprintf("%.1fh and %d points", s.PlannedHours, s.PlannedPoints)
+}
+
+func (cc *CapacityCalculator) describeCapacity(s models.CapacitySummary) string {
+	if cc.pointsPerHour == 0 {
+		return fmt.Sprintf("%.1fh", s.CapacityHours)
+	}
+	return fmt.Sprintf("%.1fh and %.1f points", s.CapacityHours, s.CapacityPoints)
+}
+
+func addPlanned(p *models.CapacityPlanned, task models.Task) {
+	p.Tasks++
+	p.PlannedHours += task.ExpectedTime
+	p.PlannedPoints += task.Points
+}
+
+// countDaysOff counts the working days that are also days off
+func countDaysOff(workingDays, daysOff []time.Time) int {
+	off := make(map[string]bool, len(daysOff))
+	for _, day := range daysOff {
+		off[day.Format("2006-01-02")] = true
+	}
+
+	var count int
+	for _, day := range workingDays {
+		if off[day.Format("2006-01-02")] {
+			count++
+		}
+	}
+	return count
+}
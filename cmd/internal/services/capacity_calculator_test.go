@@ -0,0 +1,189 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+)
+
+func TestCapacityCalculator(t *testing.T) {
+	// Two weeks from monday, ten working days with the default calendar
+	iteration := models.Iteration{ID: uuid.New(), StartAt: monday, EndAt: monday.AddDate(0, 0, 11)}
+	day := func(n int) time.Time { return monday.AddDate(0, 0, n) }
+
+	ana := models.User{ID: uuid.New(), Name: "Ana"}
+	bob := models.User{ID: uuid.New(), Name: "Bob"}
+	available := func(u models.User, hours float64, daysOff ...time.Time) models.MemberAvailability {
+		return models.MemberAvailability{Member: u.ToMember(), HoursPerDay: hours, DaysOff: daysOff}
+	}
+	task := func(assignee models.User, hours float64, points int) models.Task {
+		return models.Task{ID: uuid.New(), Assignee: assignee, ExpectedTime: hours, Points: points}
+	}
+
+	withHoliday, err := models.NewWorkingCalendar(uuid.New(), "UTC",
+		[]time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		[]models.Holiday{{Date: day(7).Format(models.DateLayout), Name: "Founders day"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type member struct {
+		name          string
+		availableDays int
+		capacityHours float64
+		plannedHours  float64
+		overcommitted bool
+	}
+	tests := []struct {
+		name          string
+		calendar      models.WorkingCalendar
+		availability  []models.MemberAvailability
+		tasks         []models.Task
+		pointsPerHour float64
+		workingDays   int
+		members       []member
+		team          models.CapacitySummary
+		warnings      []string
+	}{
+		{
+			name:          "within capacity",
+			availability:  []models.MemberAvailability{available(ana, 8)},
+			tasks:         []models.Task{task(ana, 20, 5), task(ana, 10, 3)},
+			pointsPerHour: 0.5,
+			workingDays:   10,
+			members:       []member{{name: "Ana", availableDays: 10, capacityHours: 80, plannedHours: 30}},
+			team: models.CapacitySummary{
+				CapacityPlanned: models.CapacityPlanned{Tasks: 2, PlannedHours: 30, PlannedPoints: 8},
+				CapacityHours:   80,
+				CapacityPoints:  40,
+			},
+			warnings: []string{},
+		},
+		{
+			name:     "days off on weekends and holidays are not counted twice",
+			calendar: withHoliday,
+			availability: []models.MemberAvailability{
+				available(ana, 6, day(1), day(5), day(7)),
+			},
+			workingDays: 9,
+			members:     []member{{name: "Ana", availableDays: 8, capacityHours: 48}},
+			team:        models.CapacitySummary{CapacityHours: 48},
+			warnings:    []string{},
+		},
+		{
+			name:          "overcommitted in hours",
+			availability:  []models.MemberAvailability{available(ana, 4), available(bob, 8)},
+			tasks:         []models.Task{task(ana, 50, 2), task(bob, 10, 2)},
+			pointsPerHour: 1,
+			workingDays:   10,
+			members: []member{
+				{name: "Ana", availableDays: 10, capacityHours: 40, plannedHours: 50, overcommitted: true},
+				{name: "Bob", availableDays: 10, capacityHours: 80, plannedHours: 10},
+			},
+			team: models.CapacitySummary{
+				CapacityPlanned: models.CapacityPlanned{Tasks: 2, PlannedHours: 60, PlannedPoints: 4},
+				CapacityHours:   120,
+				CapacityPoints:  120,
+			},
+			warnings: []string{"Ana is planned for 50.0h and 2 points against a capacity of 40.0h and 40.0 points"},
+		},
+		{
+			name:          "overcommitted in points",
+			availability:  []models.MemberAvailability{available(ana, 1)},
+			tasks:         []models.Task{task(ana, 5, 13)},
+			pointsPerHour: 1,
+			workingDays:   10,
+			members:       []member{{name: "Ana", availableDays: 10, capacityHours: 10, plannedHours: 5, overcommitted: true}},
+			team: models.CapacitySummary{
+				CapacityPlanned: models.CapacityPlanned{Tasks: 1, PlannedHours: 5, PlannedPoints: 13},
+				CapacityHours:   10,
+				CapacityPoints:  10,
+				Overcommitted:   true,
+			},
+			warnings: []string{
+				"Ana is planned for 5.0h and 13 points against a capacity of 10.0h and 10.0 points",
+				"The team is planned for 5.0h and 13 points against a capacity of 10.0h and 10.0 points",
+			},
+		},
+		{
+			name:         "points are ignored without a historical speed",
+			availability: []models.MemberAvailability{available(ana, 1)},
+			tasks:        []models.Task{task(ana, 5, 13)},
+			workingDays:  10,
+			members:      []member{{name: "Ana", availableDays: 10, capacityHours: 10, plannedHours: 5}},
+			team: models.CapacitySummary{
+				CapacityPlanned: models.CapacityPlanned{Tasks: 1, PlannedHours: 5, PlannedPoints: 13},
+				CapacityHours:   10,
+			},
+			warnings: []string{},
+		},
+		{
+			name:         "planned without availability",
+			availability: []models.MemberAvailability{available(ana, 8)},
+			tasks:        []models.Task{task(bob, 4, 1)},
+			workingDays:  10,
+			members: []member{
+				{name: "Ana", availableDays: 10, capacityHours: 80},
+				{name: "Bob", plannedHours: 4, overcommitted: true},
+			},
+			team: models.CapacitySummary{
+				CapacityPlanned: models.CapacityPlanned{Tasks: 1, PlannedHours: 4, PlannedPoints: 1},
+				CapacityHours:   80,
+			},
+			warnings: []string{"Bob is planned for 4.0h and 1 points but has no availability recorded for the iteration"},
+		},
+		{
+			name:         "unassigned work counts for the team only",
+			availability: []models.MemberAvailability{available(ana, 2)},
+			tasks:        []models.Task{task(models.User{}, 30, 3)},
+			workingDays:  10,
+			members:      []member{{name: "Ana", availableDays: 10, capacityHours: 20}},
+			team: models.CapacitySummary{
+				CapacityPlanned: models.CapacityPlanned{Tasks: 1, PlannedHours: 30, PlannedPoints: 3},
+				CapacityHours:   20,
+				Overcommitted:   true,
+			},
+			warnings: []string{"The team is planned for 30.0h and 3 points against a capacity of 20.0h"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calendar := tt.calendar
+			if calendar.Timezone == "" {
+				calendar = models.DefaultWorkingCalendar(uuid.Nil)
+			}
+			got := NewCapacityCalculator(iteration, tt.tasks, tt.availability, tt.pointsPerHour, calendar).Calculate()
+
+			if got.IterationID != iteration.ID || got.WorkingDays != tt.workingDays {
+				t.Errorf("iteration %v with %d working days, want %v with %d", got.IterationID, got.WorkingDays, iteration.ID, tt.workingDays)
+			}
+			if len(got.Members) != len(tt.members) {
+				t.Fatalf("got %d members, want %d", len(got.Members), len(tt.members))
+			}
+			for i, want := range tt.members {
+				m := got.Members[i]
+				if m.Member.Name != want.name || m.AvailableDays != want.availableDays || m.CapacityHours != want.capacityHours ||
+					m.PlannedHours != want.plannedHours || m.Overcommitted != want.overcommitted {
+					t.Errorf("member %d = %s: %d days, %.1fh of %.1fh, overcommitted %v; want %+v",
+						i, m.Member.Name, m.AvailableDays, m.PlannedHours, m.CapacityHours, m.Overcommitted, want)
+				}
+			}
+			if got.Team != tt.team {
+				t.Errorf("team = %+v, want %+v", got.Team, tt.team)
+			}
+
+			messages := []string{}
+			for _, w := range got.Warnings {
+				messages = append(messages, w.Message)
+			}
+			if !reflect.DeepEqual(messages, tt.warnings) {
+				t.Errorf("warnings = %q, want %q", messages, tt.warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"time"
+
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// FlowCalculator builds the Kanban flow views (cumulative flow and throughput) from the task status history
+// Days and weeks are those of the project calendar
+type FlowCalculator struct {
+	calendar models.WorkingCalendar
+	now      time.Time
+}
+
+func NewFlowCalculator(calendar models.WorkingCalendar) *FlowCalculator {
+	return &FlowCalculator{calendar: calendar, now: time.Now()}
+}
+
+// CalculateCumulativeFlow counts, at the end of every elapsed day of the iteration,
+// the tasks and points in each status
+func (fc *FlowCalculator) CalculateCumulativeFlow(iteration models.Iteration, tasks []models.Task, changes []models.TaskStatusChange) models.CumulativeFlowResponse {
+	timeline := newStatusTimeline(changes)
+	statuses := models.AllStatuses()
+
+	series := make([]models.StatusSeries, len(statuses))
+	index := make(map[models.StatusEnum]int, len(statuses))
+	for i, status := range statuses {
+		series[i] = models.StatusSeries{
+			Status: status,
+			Counts: []models.DataPoint{},
+			Points: []models.DataPoint{},
+		}
+		index[status] = i
+	}
+
+	for _, day := range fc.calendar.Days(iteration.StartAt, iteration.EndAt) {
+		if day.After(fc.now) {
+			break
+		}
+
+		at := day.AddDate(0, 0, 1)
+		if at.After(fc.now) {
+			at = fc.now
+		}
+
+		counts := make([]int, len(statuses))
+		points := make([]int, len(statuses))
+		for _, task := range tasks {
+			status, exists := timeline.statusAt(task, at)
+			if !exists {
+				continue
+			}
+			if i, ok := index[status]; ok {
+				counts[i]++
+				points[i] += task.Points
+			}
+		}
+
+		label := day.Format(dateLayout)
+		for i := range series {
+			series[i].Counts = append(series[i].Counts, models.DataPoint{X: label, Y: float64(counts[i])})
+			series[i].Points = append(series[i].Points, models.DataPoint{X: label, Y: float64(points[i])})
+		}
+	}
+
+	return models.CumulativeFlowResponse{
+		IterationID: iteration.ID,
+		XAxis: models.AxisDefinition{
+			Type:  "DATE",
+			Label: "Dia",
+		},
+		YAxis: models.AxisDefinition{
+			Label: "Tasks",
+		},
+		Series: series,
+	}
+}
+
+// CalculateThroughput groups the completions by day or week (starting on Monday), filling
+// the periods without completions with zero so the series is continuous
+func (fc *FlowCalculator) CalculateThroughput(projectID uuid.UUID, completions []models.TaskCompletion, interval models.ThroughputInterval) models.ThroughputResponse {
+	response := models.ThroughputResponse{
+		ProjectID: projectID,
+		Interval:  interval,
+		XAxis: models.AxisDefinition{
+			Type:  "DATE",
+			Label: "Período",
+		},
+		YAxis: models.AxisDefinition{
+			Label: "Tasks concluídas",
+		},
+		CompletedTasks:  []models.DataPoint{},
+		CompletedPoints: []models.DataPoint{},
+	}
+
+	if len(completions) == 0 {
+		return response
+	}
+
+	bucketOf := fc.calendar.StartOfDay
+	next := func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	if interval == models.ThroughputWeekly {
+		bucketOf = fc.startOfWeek
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+	}
+
+	// Buckets are keyed by their label, which does not depend on the time location
+	tasksPerBucket := make(map[string]int)
+	pointsPerBucket := make(map[string]int)
+	first := bucketOf(completions[0].CompletedAt)
+	last := first
+	for _, c := range completions {
+		bucket := bucketOf(c.CompletedAt)
+		tasksPerBucket[bucket.Format(dateLayout)]++
+		pointsPerBucket[bucket.Format(dateLayout)] += c.Points
+		if bucket.Before(first) {
+			first = bucket
+		}
+		if bucket.After(last) {
+			last = bucket
+		}
+	}
+
+	for bucket := first; !bucket.After(last); bucket = next(bucket) {
+		label := bucket.Format(dateLayout)
+		response.CompletedTasks = append(response.CompletedTasks, models.DataPoint{
+			X: label,
+			Y: float64(tasksPerBucket[label]),
+		})
+		response.CompletedPoints = append(response.CompletedPoints, models.DataPoint{
+			X: label,
+			Y: float64(pointsPerBucket[label]),
+		})
+	}
+
+	return response
+}
+
+func (fc *FlowCalculator) startOfWeek(t time.Time) time.Time {
+	day := fc.calendar.StartOfDay(t)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
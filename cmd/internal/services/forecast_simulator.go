@@ -0,0 +1,133 @@
+package services
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+
+	"prodyo-backend/cmd/internal/models"
+)
+
+// maxSimulatedIterations bounds a single simulation when the history delivers very little
+// Beyond it the backlog is reported as not reached
+const maxSimulatedIterations = 200
+
+// ForecastSimulator runs Monte Carlo simulations by drawing, with replacement, the delivery
+// and length of past iterations; lengths are working days of the project calendar
+type ForecastSimulator struct {
+	samples  []models.ForecastSample
+	calendar models.WorkingCalendar
+	rng      *rand.Rand
+}
+
+func NewForecastSimulator(samples []models.ForecastSample, calendar models.WorkingCalendar, seed int64) *ForecastSimulator {
+	return &ForecastSimulator{
+		samples:  samples,
+		calendar: calendar,
+		rng:      rand.New(rand.NewSource(seed)),
+	}
+}
+
+// ForecastCompletion simulates how long the backlog takes starting at start and returns, for each
+// confidence level, the date by which that share of the simulations had finished
+func (fs *ForecastSimulator) ForecastCompletion(backlog float64, start time.Time, simulations int) []models.ForecastDate {
+	type outcome struct {
+		days       float64
+		iterations float64
+		reached    bool
+	}
+
+	outcomes := make([]outcome, simulations)
+	for i := range outcomes {
+		var done, days float64
+		reached := false
+		iterations := 0.0
+		for n := 0; n < maxSimulatedIterations; n++ {
+			sample := fs.draw()
+			if done+sample.Delivered >= backlog && sample.Delivered > 0 {
+				// Only the needed part of the last iteration counts
+				fraction := (backlog - done) / sample.Delivered
+				days += sample.Days * fraction
+				iterations += fraction
+				reached = true
+				break
+			}
+			done += sample.Delivered
+			days += sample.Days
+			iterations++
+		}
+		outcomes[i] = outcome{days: days, iterations: iterations, reached: reached}
+	}
+
+	sort.Slice(outcomes, func(i, j int) bool {
+		return outcomes[i].days < outcomes[j].days
+	})
+
+	dates := make([]models.ForecastDate, 0, len(models.ForecastConfidenceLevels()))
+	for _, confidence := range models.ForecastConfidenceLevels() {
+		o := outcomes[rankIndex(len(outcomes), float64(confidence))]
+		dates = append(dates, models.ForecastDate{
+			Confidence: confidence,
+			Date:       fs.calendar.AddWorkingDays(start, o.days),
+			Iterations: o.iterations,
+			Reached:    o.reached,
+		})
+	}
+	return dates
+}
+
+// ForecastScope simulates how much work is done between start and target and returns, for each
+// confidence level, the amount delivered in at least that share of the simulations
+func (fs *ForecastSimulator) ForecastScope(start, target time.Time, simulations int) []models.ForecastScope {
+	available := float64(fs.calendar.CountWorkingDays(start, target))
+
+	outcomes := make([]float64, simulations)
+	for i := range outcomes {
+		var done, days float64
+		for n := 0; n < maxSimulatedIterations && days < available; n++ {
+			sample := fs.draw()
+			if sample.Days <= 0 {
+				continue
+			}
+			if days+sample.Days > available {
+				// Only the part of the last iteration before the target counts
+				done += sample.Delivered * (available - days) / sample.Days
+				break
+			}
+			done += sample.Delivered
+			days += sample.Days
+		}
+		outcomes[i] = done
+	}
+
+	sort.Float64s(outcomes)
+
+	scope := make([]models.ForecastScope, 0, len(models.ForecastConfidenceLevels()))
+	for _, confidence := range models.ForecastConfidenceLevels() {
+		// Being confident in a scope means most simulations delivered at least that much
+		scope = append(scope, models.ForecastScope{
+			Confidence: confidence,
+			Value:      outcomes[rankIndex(len(outcomes), float64(100-confidence))],
+		})
+	}
+	return scope
+}
+
+func (fs *ForecastSimulator) draw() models.ForecastSample {
+	return fs.samples[fs.rng.Intn(len(fs.samples))]
+}
+
+// rankIndex returns the index of the p-th percentile (nearest rank) in a sorted slice of size n
+func rankIndex(n int, p float64) int {
+	rank := int(p / 100 * float64(n))
+	if float64(rank) < p/100*float64(n) {
+		rank++
+	}
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > n {
+		rank = n
+	}
+	return rank - 1
+}
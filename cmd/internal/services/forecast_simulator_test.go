@@ -0,0 +1,157 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// monday is the start of every forecast below, so working days are easy to count
+var monday = time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+func TestForecastCompletion(t *testing.T) {
+	tests := []struct {
+		name       string
+		samples    []models.ForecastSample
+		backlog    float64
+		date       time.Time
+		iterations float64
+		reached    bool
+	}{
+		{
+			name:       "whole iterations",
+			samples:    []models.ForecastSample{{Delivered: 10, Days: 5}},
+			backlog:    30,
+			date:       time.Date(2026, time.January, 24, 0, 0, 0, 0, time.UTC),
+			iterations: 3,
+			reached:    true,
+		},
+		{
+			name:       "only the needed part of the last iteration",
+			samples:    []models.ForecastSample{{Delivered: 10, Days: 5}},
+			backlog:    25,
+			date:       time.Date(2026, time.January, 21, 12, 0, 0, 0, time.UTC),
+			iterations: 2.5,
+			reached:    true,
+		},
+		{
+			name:       "history without delivery never reaches the backlog",
+			samples:    []models.ForecastSample{{Delivered: 0, Days: 1}},
+			backlog:    5,
+			iterations: maxSimulatedIterations,
+			reached:    false,
+		},
+	}
+
+	calendar := models.DefaultWorkingCalendar(uuid.New())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dates := NewForecastSimulator(tt.samples, calendar, 1).ForecastCompletion(tt.backlog, monday, 100)
+			if len(dates) != len(models.ForecastConfidenceLevels()) {
+				t.Fatalf("got %d dates, want one per confidence level", len(dates))
+			}
+			for _, d := range dates {
+				if d.Iterations != tt.iterations || d.Reached != tt.reached {
+					t.Errorf("confidence %d: got %v iterations (reached %v), want %v (reached %v)",
+						d.Confidence, d.Iterations, d.Reached, tt.iterations, tt.reached)
+				}
+				if tt.reached && !d.Date.Equal(tt.date) {
+					t.Errorf("confidence %d: got date %v, want %v", d.Confidence, d.Date, tt.date)
+				}
+			}
+		})
+	}
+}
+
+func TestForecastScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		samples []models.ForecastSample
+		target  time.Time
+		value   float64
+	}{
+		{
+			name:    "whole iterations",
+			samples: []models.ForecastSample{{Delivered: 10, Days: 5}},
+			target:  time.Date(2026, time.January, 16, 0, 0, 0, 0, time.UTC),
+			value:   20,
+		},
+		{
+			name:    "part of the last iteration before the target",
+			samples: []models.ForecastSample{{Delivered: 10, Days: 5}},
+			target:  time.Date(2026, time.January, 14, 0, 0, 0, 0, time.UTC),
+			value:   16,
+		},
+		{
+			name:    "iterations without days are skipped",
+			samples: []models.ForecastSample{{Delivered: 10, Days: 0}},
+			target:  time.Date(2026, time.January, 16, 0, 0, 0, 0, time.UTC),
+			value:   0,
+		},
+	}
+
+	calendar := models.DefaultWorkingCalendar(uuid.New())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope := NewForecastSimulator(tt.samples, calendar, 1).ForecastScope(monday, tt.target, 100)
+			for _, s := range scope {
+				if s.Value != tt.value {
+					t.Errorf("confidence %d: got %v, want %v", s.Confidence, s.Value, tt.value)
+				}
+			}
+		})
+	}
+}
+
+func TestForecastSeedAndConfidenceOrder(t *testing.T) {
+	samples := []models.ForecastSample{
+		{Delivered: 4, Days: 5},
+		{Delivered: 10, Days: 5},
+		{Delivered: 16, Days: 5},
+	}
+	calendar := models.DefaultWorkingCalendar(uuid.New())
+	target := monday.AddDate(0, 0, 42)
+
+	first := NewForecastSimulator(samples, calendar, 42).ForecastCompletion(60, monday, 1000)
+	second := NewForecastSimulator(samples, calendar, 42).ForecastCompletion(60, monday, 1000)
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("same seed gave %+v and %+v", first[i], second[i])
+		}
+		if i > 0 && first[i].Date.Before(first[i-1].Date) {
+			t.Errorf("confidence %d finishes before confidence %d", first[i].Confidence, first[i-1].Confidence)
+		}
+	}
+
+	scope := NewForecastSimulator(samples, calendar, 42).ForecastScope(monday, target, 1000)
+	for i := 1; i < len(scope); i++ {
+		if scope[i].Value > scope[i-1].Value {
+			t.Errorf("confidence %d promises more than confidence %d", scope[i].Confidence, scope[i-1].Confidence)
+		}
+	}
+}
+
+func TestRankIndex(t *testing.T) {
+	tests := []struct {
+		n    int
+		p    float64
+		want int
+	}{
+		{n: 100, p: 50, want: 49},
+		{n: 100, p: 85, want: 84},
+		{n: 10, p: 95, want: 9},
+		{n: 3, p: 50, want: 1},
+		{n: 1, p: 5, want: 0},
+		{n: 10, p: 0, want: 0},
+		{n: 10, p: 100, want: 9},
+	}
+
+	for _, tt := range tests {
+		if got := rankIndex(tt.n, tt.p); got != tt.want {
+			t.Errorf("rankIndex(%d, %v) = %d, want %d", tt.n, tt.p, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"prodyo-backend/cmd/internal/models"
+)
+
+// maxHolidayDays bounds the days a single event can mark as holidays
+const maxHolidayDays = 366
+
+var ErrInvalidICalendar = errors.New("invalid iCalendar file")
+
+// ParseICalendarHolidays reads the events of an iCalendar (RFC 5545) file as holidays named after
+// their summary; an event spanning several days marks each of them
+// Times are read in their own timezone and dated in loc; recurrence rules are not expanded
+func ParseICalendarHolidays(r io.Reader, loc *time.Location) ([]models.Holiday, error) {
+	lines, err := unfoldICalendar(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var holidays []models.Holiday
+	seen := make(map[string]bool)
+	var inCalendar, inEvent bool
+	var start, end *icalTime
+	var summary string
+
+	for n, line := range lines {
+		name, params, value, ok := splitICalendarLine(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VCALENDAR"):
+			inCalendar = true
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			inEvent, start, end, summary = true, nil, nil, ""
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if !inEvent || start == nil {
+				return nil, fmt.Errorf("%w: event ending on line %d has no DTSTART", ErrInvalidICalendar, n+1)
+			}
+			for _, day := range start.daysUntil(end, loc) {
+				if !seen[day] {
+					seen[day] = true
+					holidays = append(holidays, models.Holiday{Date: day, Name: summary})
+				}
+			}
+			inEvent = false
+		case !inEvent:
+		case name == "DTSTART" || name == "DTEND":
+			t, err := parseICalendarTime(params, value, loc)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidICalendar, n+1, err)
+			}
+			if name == "DTSTART" {
+				start = &t
+			} else {
+				end = &t
+			}
+		case name == "SUMMARY":
+			summary = unescapeICalendarText(value)
+		}
+	}
+
+	if !inCalendar {
+		return nil, fmt.Errorf("%w: missing BEGIN:VCALENDAR", ErrInvalidICalendar)
+	}
+	return holidays, nil
+}
+
+// icalTime is a DTSTART or DTEND value; dates have no time of day
+type icalTime struct {
+	at     time.Time
+	isDate bool
+}
+
+// daysUntil returns the dates covered from t to the exclusive end, just the day of t without an end
+func (t icalTime) daysUntil(end *icalTime, loc *time.Location) []string {
+	first := t.at.In(loc)
+	first = time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc)
+	days := []string{first.Format(models.DateLayout)}
+	if end == nil {
+		return days
+	}
+
+	// The last covered instant is right before the end
+	last := end.at.In(loc)
+	if end.isDate {
+		last = time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, loc)
+	}
+	last = last.Add(-time.Nanosecond)
+
+	for day := first.AddDate(0, 0, 1); !day.After(last) && len(days) < maxHolidayDays; day = day.AddDate(0, 0, 1) {
+		days = append(days, day.Format(models.DateLayout))
+	}
+	return days
+}
+
+func parseICalendarTime(params map[string]string, value string, loc *time.Location) (icalTime, error) {
+	if params["VALUE"] == "DATE" || len(value) == 8 {
+		t, err := time.ParseInLocation("20060102", value, loc)
+		return icalTime{at: t, isDate: true}, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return icalTime{at: t}, err
+	}
+
+	in := loc
+	if tzid := params["TZID"]; tzid != "" {
+		l, err := time.LoadLocation(tzid)
+		if err != nil {
+			return icalTime{}, fmt.Errorf("unknown TZID %q", tzid)
+		}
+		in = l
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, in)
+	return icalTime{at: t}, err
+}
+
+// unfoldICalendar joins the lines folded with a leading space or tab
+func unfoldICalendar(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1<<20)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidICalendar, err)
+	}
+	return lines, nil
+}
+
+// splitICalendarLine splits NAME;PARAM=VALUE:value into its upper-cased name, params and value
+func splitICalendarLine(line string) (string, map[string]string, string, bool) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return "", nil, "", false
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	params := make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		if k, v, ok := strings.Cut(p, "="); ok {
+			params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return strings.ToUpper(parts[0]), params, strings.TrimSpace(line[colon+1:]), true
+}
+
+func unescapeICalendarText(s string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(s)
+}
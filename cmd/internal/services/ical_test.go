@@ -0,0 +1,137 @@
+package services
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"prodyo-backend/cmd/internal/models"
+)
+
+// ics wraps the lines of events in a calendar, with the CRLF line endings of RFC 5545
+func ics(lines ...string) string {
+	all := append([]string{"BEGIN:VCALENDAR", "VERSION:2.0"}, lines...)
+	all = append(all, "END:VCALENDAR")
+	return strings.Join(all, "\r\n") + "\r\n"
+}
+
+func TestParseICalendarHolidays(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		file string
+		loc  *time.Location
+		want []models.Holiday
+	}{
+		{
+			name: "date value",
+			file: ics("BEGIN:VEVENT", "DTSTART;VALUE=DATE:20260907", "SUMMARY:Independência", "END:VEVENT"),
+			want: []models.Holiday{{Date: "2026-09-07", Name: "Independência"}},
+		},
+		{
+			name: "date without the value parameter",
+			file: ics("BEGIN:VEVENT", "DTSTART:20261012", "SUMMARY:Aparecida", "END:VEVENT"),
+			want: []models.Holiday{{Date: "2026-10-12", Name: "Aparecida"}},
+		},
+		{
+			name: "folded lines",
+			file: ics("BEGIN:VEVENT", "DTSTART;VALUE=DATE:20261115", "SUMMARY:Proclamação da", "  República", "END:VEVENT"),
+			want: []models.Holiday{{Date: "2026-11-15", Name: "Proclamação da República"}},
+		},
+		{
+			name: "escaped text",
+			file: ics("BEGIN:VEVENT", `DTSTART;VALUE=DATE:20261225`, `SUMMARY:Natal\, família\; descanso`, "END:VEVENT"),
+			want: []models.Holiday{{Date: "2026-12-25", Name: "Natal, família; descanso"}},
+		},
+		{
+			name: "multi-day event with an exclusive date end",
+			file: ics("BEGIN:VEVENT", "DTSTART;VALUE=DATE:20261224", "DTEND;VALUE=DATE:20261227", "SUMMARY:Recesso", "END:VEVENT"),
+			want: []models.Holiday{
+				{Date: "2026-12-24", Name: "Recesso"},
+				{Date: "2026-12-25", Name: "Recesso"},
+				{Date: "2026-12-26", Name: "Recesso"},
+			},
+		},
+		{
+			name: "date-time ending at midnight leaves the next day out",
+			file: ics("BEGIN:VEVENT", "DTSTART:20260301T000000", "DTEND:20260302T000000", "SUMMARY:Offsite", "END:VEVENT"),
+			want: []models.Holiday{{Date: "2026-03-01", Name: "Offsite"}},
+		},
+		{
+			name: "date-time ending during the next day covers it",
+			file: ics("BEGIN:VEVENT", "DTSTART:20260301T090000", "DTEND:20260302T120000", "SUMMARY:Offsite", "END:VEVENT"),
+			want: []models.Holiday{{Date: "2026-03-01", Name: "Offsite"}, {Date: "2026-03-02", Name: "Offsite"}},
+		},
+		{
+			name: "UTC time dated in the project timezone",
+			file: ics("BEGIN:VEVENT", "DTSTART:20260101T020000Z", "SUMMARY:Ano novo", "END:VEVENT"),
+			loc:  saoPaulo,
+			want: []models.Holiday{{Date: "2025-12-31", Name: "Ano novo"}},
+		},
+		{
+			name: "TZID time dated in the project timezone",
+			file: ics("BEGIN:VEVENT", "DTSTART;TZID=Asia/Tokyo:20260101T080000", "SUMMARY:Shōgatsu", "END:VEVENT"),
+			want: []models.Holiday{{Date: "2025-12-31", Name: "Shōgatsu"}},
+		},
+		{
+			name: "quoted TZID",
+			file: ics("BEGIN:VEVENT", `DTSTART;TZID="Europe/Lisbon":20260610T100000`, "SUMMARY:Dia de Portugal", "END:VEVENT"),
+			want: []models.Holiday{{Date: "2026-06-10", Name: "Dia de Portugal"}},
+		},
+		{
+			name: "days shared by events are kept once",
+			file: ics(
+				"BEGIN:VEVENT", "DTSTART;VALUE=DATE:20260220", "DTEND;VALUE=DATE:20260222", "SUMMARY:Carnaval", "END:VEVENT",
+				"BEGIN:VEVENT", "DTSTART;VALUE=DATE:20260221", "SUMMARY:Sábado", "END:VEVENT",
+			),
+			want: []models.Holiday{{Date: "2026-02-20", Name: "Carnaval"}, {Date: "2026-02-21", Name: "Carnaval"}},
+		},
+		{
+			name: "properties outside events are ignored",
+			file: ics("PRODID:-//Example//Holidays//EN", "DTSTART:20260101", "X-WR-CALNAME:Feriados"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := tt.loc
+			if loc == nil {
+				loc = time.UTC
+			}
+			got, err := ParseICalendarHolidays(strings.NewReader(tt.file), loc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseICalendarHolidaysErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+	}{
+		{name: "not a calendar", file: "BEGIN:VEVENT\r\nDTSTART:20260101\r\nEND:VEVENT\r\n"},
+		{name: "event without start", file: ics("BEGIN:VEVENT", "SUMMARY:Sem data", "END:VEVENT")},
+		{name: "unknown TZID", file: ics("BEGIN:VEVENT", "DTSTART;TZID=Mars/Olympus:20260101T080000", "END:VEVENT")},
+		{name: "invalid date", file: ics("BEGIN:VEVENT", "DTSTART;VALUE=DATE:20261340", "END:VEVENT")},
+		{name: "invalid date-time", file: ics("BEGIN:VEVENT", "DTSTART:2026-01-01T08:00", "END:VEVENT")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseICalendarHolidays(strings.NewReader(tt.file), time.UTC); !errors.Is(err, ErrInvalidICalendar) {
+				t.Errorf("got %v, want ErrInvalidICalendar", err)
+			}
+		})
+	}
+}
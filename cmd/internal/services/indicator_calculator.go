@@ -1,7 +1,9 @@
 package services
 
 import (
+	"math"
 	"sort"
+	"strconv"
 	"time"
 
 	"prodyo-backend/cmd/internal/models"
@@ -26,7 +28,8 @@ func NewIndicatorCalculator(tasks []models.Task, ranges []models.IndicatorRange)
 	}
 }
 
-func (ic *IndicatorCalculator) CalculateIterationAnalysis(iterationID uuid.UUID) models.IterationAnalysisResponse {
+// CalculateIterationAnalysis measures lead and cycle times in working hours of the project calendar
+func (ic *IndicatorCalculator) CalculateIterationAnalysis(iterationID uuid.UUID, calendar models.WorkingCalendar) models.IterationAnalysisResponse {
 	completedTasks := ic.getCompletedTasksSorted()
 
 	analysis := models.IterationAnalysisResponse{
@@ -37,10 +40,19 @@ func (ic *IndicatorCalculator) CalculateIterationAnalysis(iterationID uuid.UUID)
 	analysis.Analysis["SpeedPerIteration"] = ic.calculateSpeedAnalysis(completedTasks)
 	analysis.Analysis["ReworkPerIteration"] = ic.calculateReworkAnalysis(completedTasks)
 	analysis.Analysis["InstabilityIndex"] = ic.calculateInstabilityAnalysis(completedTasks)
+	analysis.Analysis["LeadTime"] = ic.calculateLeadTimeAnalysis(completedTasks, calendar)
+	analysis.Analysis["CycleTime"] = ic.calculateCycleTimeAnalysis(completedTasks, calendar)
+	analysis.Analysis["EstimateAccuracy"] = ic.calculateEstimateAccuracyAnalysis(completedTasks)
 
 	return analysis
 }
 
+// CalculateSpeed returns the expected and actual points per hour of the completed tasks,
+// the same values as the speed indicator of the iteration analysis
+func (ic *IndicatorCalculator) CalculateSpeed() models.SpeedValues {
+	return *ic.calculateSpeedAnalysis(ic.getCompletedTasksSorted()).Values
+}
+
 func (ic *IndicatorCalculator) getCompletedTasksSorted() []models.Task {
 	var completed []models.Task
 	for _, task := range ic.tasks {
@@ -49,13 +61,22 @@ func (ic *IndicatorCalculator) getCompletedTasksSorted() []models.Task {
 		}
 	}
 
-	sort.Slice(completed, func(i, j int) bool {
-		return completed[i].UpdatedAt.Before(completed[j].UpdatedAt)
+	sort.SliceStable(completed, func(i, j int) bool {
+		return completionTime(completed[i]).Before(completionTime(completed[j]))
 	})
 
 	return completed
 }
 
+// completionTime is when the task was last moved to Completed, falling back to
+// UpdatedAt for tasks without status history
+func completionTime(task models.Task) time.Time {
+	if task.CompletedAt != nil {
+		return *task.CompletedAt
+	}
+	return task.UpdatedAt
+}
+
 func (ic *IndicatorCalculator) calculateSpeedAnalysis(completedTasks []models.Task) models.IndicatorAnalysisData {
 	indicatorRange := ic.ranges[models.IndicatorSpeedPerIteration]
 
@@ -189,6 +210,223 @@ func (ic *IndicatorCalculator) calculateInstabilityAnalysis(completedTasks []mod
 	}
 }
 
+// calculateLeadTimeAnalysis measures the working hours between the creation and the completion of each task
+func (ic *IndicatorCalculator) calculateLeadTimeAnalysis(completedTasks []models.Task, calendar models.WorkingCalendar) models.IndicatorAnalysisData {
+	return ic.calculateTimeAnalysis(models.IndicatorLeadTime, "Lead time (horas úteis)", completedTasks, calendar, func(task models.Task) (time.Time, bool) {
+		return task.CreatedAt, true
+	})
+}
+
+// calculateCycleTimeAnalysis measures the working hours between the first InProgress and the completion of each task
+// Tasks completed without ever being InProgress are left out
+func (ic *IndicatorCalculator) calculateCycleTimeAnalysis(completedTasks []models.Task, calendar models.WorkingCalendar) models.IndicatorAnalysisData {
+	return ic.calculateTimeAnalysis(models.IndicatorCycleTime, "Cycle time (horas úteis)", completedTasks, calendar, func(task models.Task) (time.Time, bool) {
+		if task.StartedAt == nil {
+			return time.Time{}, false
+		}
+		return *task.StartedAt, true
+	})
+}
+
+// calculateTimeAnalysis builds a scatterplot of working hours per task, placed at the completion time
+// in the project timezone, and the percentiles of the iteration
+// Only the hours spent on working days of the calendar count, so weekends and holidays add nothing
+func (ic *IndicatorCalculator) calculateTimeAnalysis(
+	indicatorType models.IndicatorEnum,
+	label string,
+	completedTasks []models.Task,
+	calendar models.WorkingCalendar,
+	startOf func(models.Task) (time.Time, bool),
+) models.IndicatorAnalysisData {
+	indicatorRange, hasRange := ic.ranges[indicatorType]
+
+	points := make([]models.DataPoint, 0, len(completedTasks))
+	hours := make([]float64, 0, len(completedTasks))
+
+	for _, task := range completedTasks {
+		start, ok := startOf(task)
+		if !ok {
+			continue
+		}
+
+		completedAt := completionTime(task).In(calendar.Location())
+		value := calendar.WorkingHoursBetween(start, completedAt)
+
+		var status models.ProductivityEnum
+		if hasRange {
+			status = ic.determineStatus(value, indicatorRange, false)
+		}
+
+		points = append(points, models.DataPoint{
+			X:      completedAt,
+			Y:      value,
+			Status: status,
+		})
+		hours = append(hours, value)
+	}
+
+	percentiles := &models.TimePercentiles{
+		Count:   len(hours),
+		Average: average(hours),
+		P50:     percentile(hours, 50),
+		P85:     percentile(hours, 85),
+		P95:     percentile(hours, 95),
+	}
+	if hasRange && len(hours) > 0 {
+		percentiles.Status = ic.determineStatus(percentiles.P85, indicatorRange, false)
+	}
+
+	return models.IndicatorAnalysisData{
+		IndicatorType: string(indicatorType),
+		XAxis: models.AxisDefinition{
+			Type:  "DATETIME",
+			Label: "Conclusão",
+		},
+		YAxis: models.AxisDefinition{
+			Type:  "DURATION",
+			Label: label,
+		},
+		Points:      points,
+		Percentiles: percentiles,
+	}
+}
+
+// estimateBiasTolerance is the bias (in percent) under which estimates are considered accurate
+const estimateBiasTolerance = 10.0
+
+// estimateError is the comparison of the logged and expected time of one task
+type estimateError struct {
+	ratio      float64
+	percentage float64
+}
+
+// calculateEstimateAccuracyAnalysis compares logged and expected time of the completed tasks
+// that have both, with breakdowns per assignee and per points size
+// The iteration is classified by its MAPE, each task by its absolute percentage error
+func (ic *IndicatorCalculator) calculateEstimateAccuracyAnalysis(completedTasks []models.Task) models.IndicatorAnalysisData {
+	indicatorRange, hasRange := ic.ranges[models.IndicatorEstimateAccuracy]
+
+	points := make([]models.DataPoint, 0, len(completedTasks))
+	var all []estimateError
+	byAssignee := make(map[string][]estimateError)
+	assigneeLabels := make(map[string]string)
+	var assigneeKeys []string
+	byPoints := make(map[int][]estimateError)
+	var pointsKeys []int
+
+	for _, task := range completedTasks {
+		if task.Timer <= 0 || task.ExpectedTime <= 0 {
+			continue
+		}
+
+		actual := float64(task.Timer) / 3600.0
+		e := estimateError{
+			ratio:      actual / task.ExpectedTime,
+			percentage: (actual - task.ExpectedTime) / task.ExpectedTime * 100,
+		}
+		all = append(all, e)
+
+		var status models.ProductivityEnum
+		if hasRange {
+			status = ic.determineStatus(math.Abs(e.percentage), indicatorRange, false)
+		}
+		points = append(points, models.DataPoint{
+			X:      len(all),
+			Y:      e.ratio,
+			Status: status,
+		})
+
+		assigneeKey := "unassigned"
+		assigneeLabel := "Sem responsável"
+		if task.Assignee.ID != uuid.Nil {
+			assigneeKey = task.Assignee.ID.String()
+			assigneeLabel = task.Assignee.Name
+		}
+		if _, ok := byAssignee[assigneeKey]; !ok {
+			assigneeKeys = append(assigneeKeys, assigneeKey)
+			assigneeLabels[assigneeKey] = assigneeLabel
+		}
+		byAssignee[assigneeKey] = append(byAssignee[assigneeKey], e)
+
+		if _, ok := byPoints[task.Points]; !ok {
+			pointsKeys = append(pointsKeys, task.Points)
+		}
+		byPoints[task.Points] = append(byPoints[task.Points], e)
+	}
+
+	overall := summarizeEstimates("all", "Todas", all)
+	accuracy := &models.EstimateAccuracy{
+		Count:      overall.Count,
+		MeanRatio:  overall.MeanRatio,
+		MAPE:       overall.MAPE,
+		Bias:       overall.Bias,
+		Direction:  overall.Direction,
+		ByAssignee: make([]models.EstimateAccuracyGroup, 0, len(assigneeKeys)),
+		ByPoints:   make([]models.EstimateAccuracyGroup, 0, len(pointsKeys)),
+	}
+	if hasRange && overall.Count > 0 {
+		accuracy.Status = ic.determineStatus(overall.MAPE, indicatorRange, false)
+	}
+
+	for _, key := range assigneeKeys {
+		accuracy.ByAssignee = append(accuracy.ByAssignee, summarizeEstimates(key, assigneeLabels[key], byAssignee[key]))
+	}
+
+	sort.Ints(pointsKeys)
+	for _, p := range pointsKeys {
+		key := strconv.Itoa(p)
+		accuracy.ByPoints = append(accuracy.ByPoints, summarizeEstimates(key, key+" pontos", byPoints[p]))
+	}
+
+	return models.IndicatorAnalysisData{
+		IndicatorType: string(models.IndicatorEstimateAccuracy),
+		XAxis: models.AxisDefinition{
+			Type:  "TASK_SEQUENCE",
+			Label: "Tasks concluídas",
+		},
+		YAxis: models.AxisDefinition{
+			Type:  "RATIO",
+			Label: "Tempo real / tempo estimado",
+		},
+		Points:   points,
+		Accuracy: accuracy,
+	}
+}
+
+func summarizeEstimates(key, label string, errs []estimateError) models.EstimateAccuracyGroup {
+	group := models.EstimateAccuracyGroup{
+		Key:       key,
+		Label:     label,
+		Count:     len(errs),
+		Direction: models.EstimateAccurate,
+	}
+	if len(errs) == 0 {
+		return group
+	}
+
+	ratios := make([]float64, 0, len(errs))
+	absolute := make([]float64, 0, len(errs))
+	signed := make([]float64, 0, len(errs))
+	for _, e := range errs {
+		ratios = append(ratios, e.ratio)
+		absolute = append(absolute, math.Abs(e.percentage))
+		signed = append(signed, e.percentage)
+	}
+
+	group.MeanRatio = average(ratios)
+	group.MAPE = average(absolute)
+	group.Bias = average(signed)
+
+	switch {
+	case group.Bias > estimateBiasTolerance:
+		group.Direction = models.EstimateUnder
+	case group.Bias < -estimateBiasTolerance:
+		group.Direction = models.EstimateOver
+	}
+
+	return group
+}
+
 func (ic *IndicatorCalculator) determineStatus(value float64, indicatorRange models.IndicatorRange, higherIsBetter bool) models.ProductivityEnum {
 	r := indicatorRange.Range
 
@@ -210,7 +448,237 @@ func (ic *IndicatorCalculator) determineStatus(value float64, indicatorRange mod
 	return models.ProductivityCritical
 }
 
-func startOfDay(t time.Time) time.Time {
-	year, month, day := t.Date()
-	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+// CalculateEstimateAccuracy returns only the estimate accuracy of the completed tasks
+func (ic *IndicatorCalculator) CalculateEstimateAccuracy() models.EstimateAccuracy {
+	return *ic.calculateEstimateAccuracyAnalysis(ic.getCompletedTasksSorted()).Accuracy
+}
+
+// memberTotals accumulates the work of one assignee
+type memberTotals struct {
+	member          models.Member
+	completedTasks  int
+	completedPoints int
+	loggedHours     float64
+	timedPoints     int
+	estimatedHours  float64
+	timedHours      float64
+	bugPoints       float64
+	improvPoints    float64
+}
+
+// CalculateMemberAnalysis computes speed, rework and instability per task assignee,
+// with each member's share of the completed points and of the logged time
+// Tasks without assignee are grouped under an empty member
+func (ic *IndicatorCalculator) CalculateMemberAnalysis(iterationID uuid.UUID) models.MemberAnalysisResponse {
+	totals := make(map[uuid.UUID]*memberTotals)
+	var order []uuid.UUID
+	var teamPoints int
+	var teamHours float64
+
+	for _, task := range ic.tasks {
+		t, ok := totals[task.Assignee.ID]
+		if !ok {
+			t = &memberTotals{member: task.Assignee.ToMember()}
+			totals[task.Assignee.ID] = t
+			order = append(order, task.Assignee.ID)
+		}
+
+		hours := float64(task.Timer) / 3600.0
+		t.loggedHours += hours
+		teamHours += hours
+
+		if task.Status != models.StatusCompleted {
+			continue
+		}
+
+		t.completedTasks++
+		t.completedPoints += task.Points
+		teamPoints += task.Points
+
+		// Same rule as calculateSpeedAnalysis: only tasks with logged time count for speed
+		if task.Timer > 0 {
+			t.timedPoints += task.Points
+			t.estimatedHours += task.ExpectedTime
+			t.timedHours += hours
+		}
+		for _, bug := range task.Bugs {
+			t.bugPoints += float64(bug.Points)
+		}
+		for _, improvement := range task.Improvements {
+			t.improvPoints += float64(improvement.Points)
+		}
+	}
+
+	response := models.MemberAnalysisResponse{
+		IterationID: iterationID,
+		GroupBy:     "assignee",
+		Members:     make([]models.MemberAnalysis, 0, len(order)),
+	}
+
+	for _, id := range order {
+		t := totals[id]
+		analysis := models.MemberAnalysis{
+			Assignee:        t.member,
+			CompletedTasks:  t.completedTasks,
+			CompletedPoints: t.completedPoints,
+			LoggedHours:     t.loggedHours,
+		}
+
+		if teamPoints > 0 {
+			analysis.PointsShare = float64(t.completedPoints) / float64(teamPoints) * 100
+		}
+		if teamHours > 0 {
+			analysis.TimeShare = t.loggedHours / teamHours * 100
+		}
+
+		if t.estimatedHours > 0 {
+			analysis.Speed.ExpectedSpeed = float64(t.timedPoints) / t.estimatedHours
+		}
+		if t.timedHours > 0 {
+			analysis.Speed.ActualSpeed = float64(t.timedPoints) / t.timedHours
+			if r, ok := ic.ranges[models.IndicatorSpeedPerIteration]; ok {
+				analysis.SpeedStatus = ic.determineStatus(analysis.Speed.ActualSpeed, r, true)
+			}
+		}
+
+		if t.completedTasks > 0 {
+			analysis.Rework = t.bugPoints / float64(t.completedTasks)
+			analysis.Instability = t.improvPoints / float64(t.completedTasks)
+			if r, ok := ic.ranges[models.IndicatorReworkPerIteration]; ok {
+				analysis.ReworkStatus = ic.determineStatus(analysis.Rework, r, false)
+			}
+			if r, ok := ic.ranges[models.IndicatorInstabilityIndex]; ok {
+				analysis.InstabilityStatus = ic.determineStatus(analysis.Instability, r, false)
+			}
+		}
+
+		response.Members = append(response.Members, analysis)
+	}
+
+	sort.SliceStable(response.Members, func(i, j int) bool {
+		return response.Members[i].CompletedPoints > response.Members[j].CompletedPoints
+	})
+
+	return response
+}
+
+// labelTotals accumulates the work of the tasks carrying one label
+type labelTotals struct {
+	label           *models.Label
+	tasks           int
+	completedTasks  int
+	completedPoints int
+	bugPoints       float64
+	improvPoints    float64
+}
+
+// CalculateLabelAnalysis computes rework and instability per label
+// Rework and instability use the completed tasks of the label as denominator, like the team indicators
+func (ic *IndicatorCalculator) CalculateLabelAnalysis(iterationID uuid.UUID) models.LabelAnalysisResponse {
+	totals := make(map[uuid.UUID]*labelTotals)
+	var order []uuid.UUID
+	var teamPoints int
+
+	// uuid.Nil groups the unlabeled tasks
+	get := func(l *models.Label) *labelTotals {
+		id := uuid.Nil
+		if l != nil {
+			id = l.ID
+		}
+		t, ok := totals[id]
+		if !ok {
+			t = &labelTotals{label: l}
+			totals[id] = t
+			order = append(order, id)
+		}
+		return t
+	}
+
+	labelsOf := func(labels []models.Label) []*labelTotals {
+		if len(labels) == 0 {
+			return []*labelTotals{get(nil)}
+		}
+		result := make([]*labelTotals, 0, len(labels))
+		for i := range labels {
+			result = append(result, get(&labels[i]))
+		}
+		return result
+	}
+
+	for _, task := range ic.tasks {
+		taskTotals := labelsOf(task.Labels)
+		for _, t := range taskTotals {
+			t.tasks++
+		}
+
+		if task.Status != models.StatusCompleted {
+			continue
+		}
+
+		teamPoints += task.Points
+		for _, t := range taskTotals {
+			t.completedTasks++
+			t.completedPoints += task.Points
+		}
+
+		for _, bug := range task.Bugs {
+			targets := taskTotals
+			if len(bug.Labels) > 0 {
+				targets = labelsOf(bug.Labels)
+			}
+			for _, t := range targets {
+				t.bugPoints += float64(bug.Points)
+			}
+		}
+		for _, improvement := range task.Improvements {
+			targets := taskTotals
+			if len(improvement.Labels) > 0 {
+				targets = labelsOf(improvement.Labels)
+			}
+			for _, t := range targets {
+				t.improvPoints += float64(improvement.Points)
+			}
+		}
+	}
+
+	response := models.LabelAnalysisResponse{
+		IterationID: iterationID,
+		GroupBy:     "label",
+		Labels:      make([]models.LabelAnalysis, 0, len(order)),
+	}
+
+	for _, id := range order {
+		t := totals[id]
+		analysis := models.LabelAnalysis{
+			Label:             t.label,
+			Tasks:             t.tasks,
+			CompletedTasks:    t.completedTasks,
+			CompletedPoints:   t.completedPoints,
+			BugPoints:         t.bugPoints,
+			ImprovementPoints: t.improvPoints,
+		}
+
+		if teamPoints > 0 {
+			analysis.PointsShare = float64(t.completedPoints) / float64(teamPoints) * 100
+		}
+
+		if t.completedTasks > 0 {
+			analysis.Rework = t.bugPoints / float64(t.completedTasks)
+			analysis.Instability = t.improvPoints / float64(t.completedTasks)
+			if r, ok := ic.ranges[models.IndicatorReworkPerIteration]; ok {
+				analysis.ReworkStatus = ic.determineStatus(analysis.Rework, r, false)
+			}
+			if r, ok := ic.ranges[models.IndicatorInstabilityIndex]; ok {
+				analysis.InstabilityStatus = ic.determineStatus(analysis.Instability, r, false)
+			}
+		}
+
+		response.Labels = append(response.Labels, analysis)
+	}
+
+	sort.SliceStable(response.Labels, func(i, j int) bool {
+		return response.Labels[i].CompletedPoints > response.Labels[j].CompletedPoints
+	})
+
+	return response
 }
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+)
+
+func TestLeadAndCycleTimeInWorkingHours(t *testing.T) {
+	calendar, err := models.NewWorkingCalendar(uuid.New(), "America/Sao_Paulo",
+		[]time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Created on Friday at 18:00 in São Paulo, started on Monday at 09:00 and completed at 21:00,
+	// already Tuesday in UTC
+	created := time.Date(2026, time.January, 9, 21, 0, 0, 0, time.UTC)
+	started := time.Date(2026, time.January, 12, 12, 0, 0, 0, time.UTC)
+	completed := time.Date(2026, time.January, 13, 0, 0, 0, 0, time.UTC)
+	tasks := []models.Task{{
+		ID:          uuid.New(),
+		Status:      models.StatusCompleted,
+		CreatedAt:   created,
+		StartedAt:   &started,
+		CompletedAt: &completed,
+	}}
+
+	analysis := NewIndicatorCalculator(tasks, nil).CalculateIterationAnalysis(uuid.New(), calendar)
+
+	tests := []struct {
+		indicator string
+		hours     float64
+	}{
+		// Six hours left on Friday and 21 on Monday; the weekend does not count
+		{indicator: "LeadTime", hours: 27},
+		{indicator: "CycleTime", hours: 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.indicator, func(t *testing.T) {
+			data := analysis.Analysis[tt.indicator]
+			if len(data.Points) != 1 {
+				t.Fatalf("got %d points", len(data.Points))
+			}
+			if data.Points[0].Y != tt.hours || data.Percentiles.P50 != tt.hours {
+				t.Errorf("got %v hours (p50 %v), want %v", data.Points[0].Y, data.Percentiles.P50, tt.hours)
+			}
+			at, ok := data.Points[0].X.(time.Time)
+			if !ok || at.Location() != calendar.Location() || at.Day() != 12 || at.Hour() != 21 {
+				t.Errorf("completion placed at %v, want Monday 21:00 in São Paulo", data.Points[0].X)
+			}
+		})
+	}
+}
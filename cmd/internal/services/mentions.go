@@ -0,0 +1,30 @@
+package services
+
+import (
+	"regexp"
+	"strings"
+)
+
+// mentionPattern matches @handle or @name@domain when the @ starts a word, so plain emails in the text are skipped
+var mentionPattern = regexp.MustCompile(`(^|[^\w@])@([A-Za-z0-9][A-Za-z0-9._+\-]*(?:@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)+)?)`)
+
+// codePattern matches fenced code blocks and inline code spans of a markdown body
+var codePattern = regexp.MustCompile("(?s)```.*?```|`[^`\n]*`")
+
+// ExtractMentions returns the lowercased handles mentioned in a markdown body, without duplicates, in order of appearance
+// A handle is a user ID, a full email or the part of the email before the @; mentions inside code are ignored
+func ExtractMentions(body string) []string {
+	text := codePattern.ReplaceAllString(body, " ")
+
+	var handles []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		handle := strings.ToLower(strings.TrimRight(match[2], ".-_"))
+		if handle == "" || seen[handle] {
+			continue
+		}
+		seen[handle] = true
+		handles = append(handles, handle)
+	}
+	return handles
+}
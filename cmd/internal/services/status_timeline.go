@@ -0,0 +1,52 @@
+package services
+
+import (
+	"time"
+
+	"prodyo-backend/cmd/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// statusTimeline replays the recorded status changes of tasks to know
+// which status each task had at a given moment
+type statusTimeline struct {
+	byTask map[uuid.UUID][]models.TaskStatusChange
+}
+
+// newStatusTimeline expects the changes in chronological order
+func newStatusTimeline(changes []models.TaskStatusChange) *statusTimeline {
+	byTask := make(map[uuid.UUID][]models.TaskStatusChange)
+	for _, change := range changes {
+		byTask[change.TaskID] = append(byTask[change.TaskID], change)
+	}
+	return &statusTimeline{byTask: byTask}
+}
+
+// statusAt returns the status of the task at time t and whether the task existed at that time
+func (st *statusTimeline) statusAt(task models.Task, t time.Time) (models.StatusEnum, bool) {
+	if task.CreatedAt.After(t) {
+		return "", false
+	}
+
+	history := st.byTask[task.ID]
+	if len(history) == 0 {
+		// Without history the best guess is that the current status was set on the last update
+		if task.Status != models.StatusNotStarted && task.UpdatedAt.After(t) {
+			return models.StatusNotStarted, true
+		}
+		return task.Status, true
+	}
+
+	status := models.StatusNotStarted
+	if history[0].FromStatus != nil {
+		status = *history[0].FromStatus
+	}
+	for _, change := range history {
+		if change.ChangedAt.After(t) {
+			break
+		}
+		status = change.ToStatus
+	}
+	return status, true
+}
@@ -0,0 +1,195 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+var signedAt = time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+func newTestS3(t *testing.T, endpoint string) *S3 {
+	t.Helper()
+	s, err := NewS3(S3Options{
+		Endpoint:        endpoint,
+		Region:          "us-east-1",
+		Bucket:          "prodyo",
+		AccessKeyID:     "test-key",
+		SecretAccessKey: "test-secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.now = func() time.Time { return signedAt }
+	return s
+}
+
+// The expected signatures were computed independently from the Signature Version 4 specification
+func TestS3Sign(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		key       string
+		path      string
+		signature string
+	}{
+		{
+			name:      "put with escaped key",
+			method:    http.MethodPut,
+			key:       "attachments/a b+c.txt",
+			path:      "/prodyo/attachments/a%20b%2Bc.txt",
+			signature: "a53f2a87cb7568136d6655400d2177a8c40f3a32d3e493a8c0ea56d3b5a90b31",
+		},
+		{
+			name:      "get",
+			method:    http.MethodGet,
+			key:       "attachments/report.pdf",
+			path:      "/prodyo/attachments/report.pdf",
+			signature: "34cca82ad35c47ef224acbddcb3053816934313de11fdcabd0bd9eca9acdb352",
+		},
+	}
+
+	s := newTestS3(t, "http://minio:9000")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := s.newRequest(context.Background(), tt.method, tt.key, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s.sign(req)
+
+			if got := req.URL.EscapedPath(); got != tt.path {
+				t.Errorf("path = %s, want %s", got, tt.path)
+			}
+			if got := req.Header.Get("X-Amz-Date"); got != "20260102T030405Z" {
+				t.Errorf("X-Amz-Date = %s", got)
+			}
+			want := "AWS4-HMAC-SHA256 Credential=test-key/20260102/us-east-1/s3/aws4_request, " +
+				"SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=" + tt.signature
+			if got := req.Header.Get("Authorization"); got != want {
+				t.Errorf("Authorization = %s\nwant %s", got, want)
+			}
+		})
+	}
+}
+
+func TestS3InvalidKey(t *testing.T) {
+	s := newTestS3(t, "http://minio:9000")
+	for _, key := range []string{"", "/absolute"} {
+		if _, err := s.newRequest(context.Background(), http.MethodGet, key, nil); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("key %q: got %v, want ErrInvalidKey", key, err)
+		}
+	}
+}
+
+// fakeBucket is an in-memory S3 endpoint that only serves signed requests for the prodyo bucket
+type fakeBucket struct {
+	mu           sync.Mutex
+	objects      map[string]string
+	contentTypes map[string]string
+}
+
+func (b *fakeBucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=test-key/20260102/us-east-1/s3/aws4_request") ||
+		r.Header.Get("X-Amz-Content-Sha256") != unsignedPayload {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+		return
+	}
+	key, ok := strings.CutPrefix(r.URL.Path, "/prodyo/")
+	if !ok {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		b.objects[key] = string(body)
+		b.contentTypes[key] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		body, ok := b.objects[key]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		io.WriteString(w, body)
+	case http.MethodDelete:
+		delete(b.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3PutGetDelete(t *testing.T) {
+	bucket := &fakeBucket{objects: map[string]string{}, contentTypes: map[string]string{}}
+	server := httptest.NewServer(bucket)
+	defer server.Close()
+
+	s := newTestS3(t, server.URL)
+	ctx := context.Background()
+	const key = "attachments/notes v1.txt"
+
+	if err := s.Put(ctx, key, strings.NewReader("hello world"), 5, "text/plain"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if got := bucket.objects["attachments/notes v1.txt"]; got != "hello" {
+		t.Errorf("stored %q, want the first size bytes", got)
+	}
+	if got := bucket.contentTypes[key]; got != "text/plain" {
+		t.Errorf("content type %q", got)
+	}
+
+	if err := s.Put(ctx, "attachments/empty", strings.NewReader(""), 0, ""); err != nil {
+		t.Fatalf("Put empty: %v", err)
+	}
+
+	body, err := s.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	content, _ := io.ReadAll(body)
+	body.Close()
+	if string(content) != "hello" {
+		t.Errorf("Get read %q", content)
+	}
+
+	if err := s.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := s.Get(ctx, key); !errors.Is(err, ErrObjectNotFound) {
+		t.Errorf("Get after delete: got %v, want ErrObjectNotFound", err)
+	}
+	if err := s.Delete(ctx, key); err != nil {
+		t.Errorf("Delete of a missing object: %v", err)
+	}
+}
+
+func TestS3ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "SignatureDoesNotMatch", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	s := newTestS3(t, server.URL)
+	ctx := context.Background()
+
+	if err := s.Put(ctx, "a", strings.NewReader("x"), 1, ""); err == nil || !strings.Contains(err.Error(), "SignatureDoesNotMatch") {
+		t.Errorf("Put: got %v", err)
+	}
+	if _, err := s.Get(ctx, "a"); err == nil || errors.Is(err, ErrObjectNotFound) {
+		t.Errorf("Get: got %v", err)
+	}
+	if err := s.Delete(ctx, "a"); err == nil {
+		t.Error("Delete: expected an error")
+	}
+}
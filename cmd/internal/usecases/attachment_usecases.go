@@ -0,0 +1,236 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/attachment"
+	"prodyo-backend/cmd/internal/repositories/project"
+	"prodyo-backend/cmd/internal/storage"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const maxAttachmentFileNameLength = 255
+
+var (
+	ErrAttachmentTooLarge       = errors.New("file is larger than the project allows")
+	ErrAttachmentTypeNotAllowed = errors.New("file type is not allowed in this project")
+	ErrInvalidAttachmentName    = errors.New("file name is required and must have at most 255 characters")
+	ErrInvalidAttachmentLimits  = errors.New("max_size_bytes must be between 1 byte and 100 MB and allowed_types must list media types such as image/png or image/*")
+)
+
+// Upload is a file received from a client
+// Content is read from the start, sniffed for its type and then stored
+type Upload struct {
+	FileName string
+	Size     int64
+	Content  io.ReadSeeker
+}
+
+type AttachmentUseCase struct {
+	repo        *attachment.Repository
+	projectRepo *project.Repository
+	storage     storage.Storage
+}
+
+func NewAttachmentUseCase(repo *attachment.Repository, projectRepo *project.Repository, storage storage.Storage) *AttachmentUseCase {
+	return &AttachmentUseCase{
+		repo:        repo,
+		projectRepo: projectRepo,
+		storage:     storage,
+	}
+}
+
+// GetByTarget lists the files attached to a task, bug or improvement for a project member
+func (u *AttachmentUseCase) GetByTarget(ctx context.Context, targetType models.AttachmentTargetType, targetID, requesterID uuid.UUID) ([]models.Attachment, error) {
+	projectID, err := u.repo.GetProjectID(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireProjectMember(ctx, u.projectRepo, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	return u.repo.GetByTarget(ctx, targetType, targetID)
+}
+
+// Upload stores a file on a task, bug or improvement on behalf of a project member
+// The media type is detected from the content, not trusted from the client, and checked against the project limits
+func (u *AttachmentUseCase) Upload(ctx context.Context, targetType models.AttachmentTargetType, targetID, uploaderID uuid.UUID, file Upload) (models.Attachment, error) {
+	fileName := strings.TrimSpace(filepath.Base(strings.ReplaceAll(file.FileName, "\\", "/")))
+	if fileName == "" || fileName == "." || fileName == "/" || utf8.RuneCountInString(fileName) > maxAttachmentFileNameLength {
+		return models.Attachment{}, ErrInvalidAttachmentName
+	}
+
+	projectID, err := u.repo.GetProjectID(ctx, targetType, targetID)
+	if err != nil {
+		return models.Attachment{}, err
+	}
+
+	if err := requireProjectMember(ctx, u.projectRepo, projectID, uploaderID); err != nil {
+		return models.Attachment{}, err
+	}
+
+	settings, err := u.repo.GetSettings(ctx, projectID)
+	if err != nil {
+		return models.Attachment{}, err
+	}
+	if file.Size > settings.MaxSizeBytes {
+		return models.Attachment{}, ErrAttachmentTooLarge
+	}
+
+	contentType, err := detectContentType(file.Content)
+	if err != nil {
+		return models.Attachment{}, err
+	}
+	if !settings.Allows(contentType) {
+		return models.Attachment{}, ErrAttachmentTypeNotAllowed
+	}
+
+	a := models.Attachment{
+		ID:          uuid.New(),
+		ProjectID:   projectID,
+		TargetType:  targetType,
+		TargetID:    targetID,
+		FileName:    fileName,
+		ContentType: contentType,
+		SizeBytes:   file.Size,
+		UploadedBy:  &models.Member{ID: uploaderID},
+	}
+	a.StorageKey = "projects/" + projectID.String() + "/attachments/" + a.ID.String()
+
+	if err := u.storage.Put(ctx, a.StorageKey, file.Content, a.SizeBytes, a.ContentType); err != nil {
+		return models.Attachment{}, err
+	}
+
+	if err := u.repo.Create(ctx, a); err != nil {
+		deleteStoredObjects(ctx, u.storage, a.StorageKey)
+		return models.Attachment{}, err
+	}
+
+	return u.repo.GetByID(ctx, a.ID)
+}
+
+// Open returns the metadata of an attachment and a reader over its content for a project member;
+// the caller closes the reader
+func (u *AttachmentUseCase) Open(ctx context.Context, id, requesterID uuid.UUID) (models.Attachment, io.ReadCloser, error) {
+	a, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+	if err := requireProjectMember(ctx, u.projectRepo, a.ProjectID, requesterID); err != nil {
+		return models.Attachment{}, nil, err
+	}
+
+	content, err := u.storage.Get(ctx, a.StorageKey)
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+	return a, content, nil
+}
+
+// Delete removes an attachment; the uploader, owners and maintainers may do it
+func (u *AttachmentUseCase) Delete(ctx context.Context, id, requesterID uuid.UUID) error {
+	a, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if a.UploadedBy == nil || a.UploadedBy.ID != requesterID {
+		role, err := u.projectRepo.GetMemberRole(ctx, a.ProjectID, requesterID)
+		if err != nil {
+			if errors.Is(err, project.ErrMemberNotFound) {
+				return ErrNotProjectMember
+			}
+			return err
+		}
+		if !role.CanManageProject() {
+			return ErrInsufficientRole
+		}
+	}
+
+	if err := u.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	deleteStoredObjects(ctx, u.storage, a.StorageKey)
+	return nil
+}
+
+func (u *AttachmentUseCase) GetSettings(ctx context.Context, projectID uuid.UUID) (models.AttachmentSettings, error) {
+	if _, _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return models.AttachmentSettings{}, err
+	}
+	return u.repo.GetSettings(ctx, projectID)
+}
+
+// UpdateSettings changes the upload limits of a project; only owners and maintainers can do it
+// Files already uploaded are kept even when they no longer fit the new limits
+func (u *AttachmentUseCase) UpdateSettings(ctx context.Context, s models.AttachmentSettings, requesterID uuid.UUID) (models.AttachmentSettings, error) {
+	if s.MaxSizeBytes <= 0 || s.MaxSizeBytes > models.MaxAttachmentSizeBytes || len(s.AllowedTypes) == 0 {
+		return models.AttachmentSettings{}, ErrInvalidAttachmentLimits
+	}
+
+	allowed := make([]string, 0, len(s.AllowedTypes))
+	for _, t := range s.AllowedTypes {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t != "*/*" {
+			if _, _, err := mime.ParseMediaType(t); err != nil || !strings.Contains(t, "/") {
+				return models.AttachmentSettings{}, ErrInvalidAttachmentLimits
+			}
+		}
+		allowed = append(allowed, t)
+	}
+	s.AllowedTypes = allowed
+
+	role, err := u.projectRepo.GetMemberRole(ctx, s.ProjectID, requesterID)
+	if err != nil {
+		if errors.Is(err, project.ErrMemberNotFound) {
+			return models.AttachmentSettings{}, ErrNotProjectMember
+		}
+		return models.AttachmentSettings{}, err
+	}
+	if !role.CanManageProject() {
+		return models.AttachmentSettings{}, ErrInsufficientRole
+	}
+
+	if err := u.repo.SaveSettings(ctx, s); err != nil {
+		return models.AttachmentSettings{}, err
+	}
+	return u.repo.GetSettings(ctx, s.ProjectID)
+}
+
+// deleteStoredObjects removes stored files whose metadata is already gone
+// Failures are only logged: the rows are deleted and an orphan file does no harm
+func deleteStoredObjects(ctx context.Context, store storage.Storage, keys ...string) {
+	for _, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			log.Printf("Failed to delete stored file %s: %v", key, err)
+		}
+	}
+}
+
+// detectContentType sniffs the media type from the first bytes and rewinds the content
+func detectContentType(content io.ReadSeeker) (string, error) {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if err != nil {
+		return "application/octet-stream", nil
+	}
+	return mediaType, nil
+}
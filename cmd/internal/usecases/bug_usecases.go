@@ -16,8 +16,8 @@ func NewBugUseCase(repo *bug.Repository) *BugUseCase {
 	return &BugUseCase{repo: repo}
 }
 
-func (u *BugUseCase) GetAll(ctx context.Context, taskID uuid.UUID) ([]models.Bug, error) {
-	return u.repo.GetAll(ctx, taskID)
+func (u *BugUseCase) GetAll(ctx context.Context, taskID uuid.UUID, q models.ListQuery) ([]models.Bug, error) {
+	return u.repo.GetAll(ctx, taskID, q)
 }
 
 func (u *BugUseCase) GetByID(ctx context.Context, id uuid.UUID) (models.Bug, error) {
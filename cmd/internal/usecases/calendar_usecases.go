@@ -0,0 +1,70 @@
+package usecases
+
+import (
+	"context"
+	"io"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/calendar"
+	"prodyo-backend/cmd/internal/repositories/project"
+	"prodyo-backend/cmd/internal/services"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CalendarUseCase struct {
+	repo        *calendar.Repository
+	projectRepo *project.Repository
+}
+
+func NewCalendarUseCase(repo *calendar.Repository, projectRepo *project.Repository) *CalendarUseCase {
+	return &CalendarUseCase{
+		repo:        repo,
+		projectRepo: projectRepo,
+	}
+}
+
+// GetCalendar returns the working calendar of a project, the default one when it never set its own
+func (u *CalendarUseCase) GetCalendar(ctx context.Context, projectID uuid.UUID) (models.WorkingCalendar, error) {
+	return u.repo.GetByProjectID(ctx, projectID)
+}
+
+// SetCalendar replaces the timezone, working days and holidays of a project on behalf of one of its owners or maintainers
+func (u *CalendarUseCase) SetCalendar(ctx context.Context, projectID uuid.UUID, timezone string, workingDays []time.Weekday, holidays []models.Holiday, requesterID uuid.UUID) (models.WorkingCalendar, error) {
+	if err := requireProjectManager(ctx, u.projectRepo, projectID, requesterID); err != nil {
+		return models.WorkingCalendar{}, err
+	}
+
+	cal, err := models.NewWorkingCalendar(projectID, timezone, workingDays, holidays)
+	if err != nil {
+		return models.WorkingCalendar{}, err
+	}
+
+	if err := u.repo.Replace(ctx, cal); err != nil {
+		return models.WorkingCalendar{}, err
+	}
+	return cal, nil
+}
+
+// ImportHolidays adds the events of an iCalendar file to the project holidays, dated in the project timezone,
+// on behalf of a project owner or maintainer
+func (u *CalendarUseCase) ImportHolidays(ctx context.Context, projectID uuid.UUID, file io.Reader, requesterID uuid.UUID) (models.WorkingCalendar, error) {
+	if err := requireProjectManager(ctx, u.projectRepo, projectID, requesterID); err != nil {
+		return models.WorkingCalendar{}, err
+	}
+
+	cal, err := u.repo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return models.WorkingCalendar{}, err
+	}
+
+	holidays, err := services.ParseICalendarHolidays(file, cal.Location())
+	if err != nil {
+		return models.WorkingCalendar{}, err
+	}
+
+	if err := u.repo.AddHolidays(ctx, projectID, holidays); err != nil {
+		return models.WorkingCalendar{}, err
+	}
+	return u.repo.GetByProjectID(ctx, projectID)
+}
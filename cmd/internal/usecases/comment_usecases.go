@@ -0,0 +1,159 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/comment"
+	"prodyo-backend/cmd/internal/repositories/project"
+	"prodyo-backend/cmd/internal/services"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const maxCommentBodyLength = 10000
+
+var (
+	ErrInvalidCommentBody   = errors.New("comment body is required and must have at most 10000 characters")
+	ErrNotCommentAuthor     = errors.New("only the author can change a comment")
+	ErrInvalidParentComment = errors.New("parent comment must be an existing comment on the same item")
+)
+
+type CommentUseCase struct {
+	repo        *comment.Repository
+	projectRepo *project.Repository
+}
+
+func NewCommentUseCase(repo *comment.Repository, projectRepo *project.Repository) *CommentUseCase {
+	return &CommentUseCase{
+		repo:        repo,
+		projectRepo: projectRepo,
+	}
+}
+
+// GetThreads returns the comments of an item nested by reply, oldest first, to a project member
+func (u *CommentUseCase) GetThreads(ctx context.Context, targetType models.CommentTargetType, targetID, requesterID uuid.UUID) ([]models.Comment, error) {
+	projectID, err := u.repo.GetProjectID(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireProjectMember(ctx, u.projectRepo, projectID, requesterID); err != nil {
+		return nil, err
+	}
+
+	comments, err := u.repo.GetByTarget(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.BuildCommentThreads(comments), nil
+}
+
+// Create adds a comment, or a reply when c.ParentID is set, on behalf of a project member
+// @handles in the body that match project members are recorded as mentions and notified
+func (u *CommentUseCase) Create(ctx context.Context, c models.Comment, authorID uuid.UUID) (models.Comment, error) {
+	body, err := validateCommentBody(c.Body)
+	if err != nil {
+		return models.Comment{}, err
+	}
+	c.Body = body
+
+	projectID, err := u.repo.GetProjectID(ctx, c.TargetType, c.TargetID)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	if err := requireProjectMember(ctx, u.projectRepo, projectID, authorID); err != nil {
+		return models.Comment{}, err
+	}
+
+	if c.ParentID != nil {
+		parent, err := u.repo.GetByID(ctx, *c.ParentID)
+		if err != nil {
+			if errors.Is(err, comment.ErrNotFound) {
+				return models.Comment{}, ErrInvalidParentComment
+			}
+			return models.Comment{}, err
+		}
+		if parent.TargetType != c.TargetType || parent.TargetID != c.TargetID {
+			return models.Comment{}, ErrInvalidParentComment
+		}
+	}
+
+	mentionIDs, err := u.repo.ResolveMentions(ctx, projectID, services.ExtractMentions(c.Body))
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	c.ProjectID = projectID
+	c.Author = &models.Member{ID: authorID}
+
+	if err := u.repo.Create(ctx, c, mentionIDs); err != nil {
+		return models.Comment{}, err
+	}
+
+	return u.repo.GetByID(ctx, c.ID)
+}
+
+// Update changes the body of a comment; only its author may edit it
+// Members mentioned for the first time by the new body are notified
+func (u *CommentUseCase) Update(ctx context.Context, id uuid.UUID, body string, requesterID uuid.UUID) (models.Comment, error) {
+	body, err := validateCommentBody(body)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	existing, err := u.getOwnComment(ctx, id, requesterID)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	mentionIDs, err := u.repo.ResolveMentions(ctx, existing.ProjectID, services.ExtractMentions(body))
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	if err := u.repo.Update(ctx, id, body, mentionIDs); err != nil {
+		return models.Comment{}, err
+	}
+
+	return u.repo.GetByID(ctx, id)
+}
+
+// Delete removes a comment; only its author may delete it
+// Replies are kept under the deleted comment
+func (u *CommentUseCase) Delete(ctx context.Context, id, requesterID uuid.UUID) error {
+	if _, err := u.getOwnComment(ctx, id, requesterID); err != nil {
+		return err
+	}
+	return u.repo.Delete(ctx, id)
+}
+
+// getOwnComment loads a comment that is not deleted and checks the requester wrote it
+func (u *CommentUseCase) getOwnComment(ctx context.Context, id, requesterID uuid.UUID) (models.Comment, error) {
+	existing, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return models.Comment{}, err
+	}
+	if existing.Deleted {
+		return models.Comment{}, comment.ErrNotFound
+	}
+	if existing.Author == nil || existing.Author.ID != requesterID {
+		return models.Comment{}, ErrNotCommentAuthor
+	}
+	return existing, nil
+}
+
+// validateCommentBody trims the markdown body and checks its length in characters
+func validateCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" || utf8.RuneCountInString(body) > maxCommentBodyLength {
+		return "", ErrInvalidCommentBody
+	}
+	return body, nil
+}
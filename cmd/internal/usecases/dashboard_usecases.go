@@ -0,0 +1,103 @@
+package usecases
+
+import (
+	"context"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/calendar"
+	"prodyo-backend/cmd/internal/repositories/dashboard"
+	"prodyo-backend/cmd/internal/repositories/project"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	dashboardOverdueActionsLimit = 10
+	dashboardActivityLimit       = 20
+)
+
+type DashboardUseCase struct {
+	repo         *dashboard.Repository
+	projectRepo  *project.Repository
+	calendarRepo *calendar.Repository
+}
+
+func NewDashboardUseCase(repo *dashboard.Repository, projectRepo *project.Repository, calendarRepo *calendar.Repository) *DashboardUseCase {
+	return &DashboardUseCase{
+		repo:         repo,
+		projectRepo:  projectRepo,
+		calendarRepo: calendarRepo,
+	}
+}
+
+// GetProjectDashboard builds the project home page for a member of the project
+func (u *DashboardUseCase) GetProjectDashboard(ctx context.Context, projectID, requesterID uuid.UUID) (models.ProjectDashboard, error) {
+	now := time.Now()
+
+	summary, current, err := u.repo.GetProject(ctx, projectID, now)
+	if err != nil {
+		return models.ProjectDashboard{}, err
+	}
+
+	isMember, err := u.projectRepo.IsMember(ctx, projectID, requesterID)
+	if err != nil {
+		return models.ProjectDashboard{}, err
+	}
+	if !isMember {
+		return models.ProjectDashboard{}, ErrNotProjectMember
+	}
+
+	indicators, err := u.repo.GetLatestIndicators(ctx, projectID)
+	if err != nil {
+		return models.ProjectDashboard{}, err
+	}
+
+	actions, err := u.repo.GetActions(ctx, projectID, now, dashboardOverdueActionsLimit)
+	if err != nil {
+		return models.ProjectDashboard{}, err
+	}
+
+	cal, err := u.calendarRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return models.ProjectDashboard{}, err
+	}
+
+	var currentID *uuid.UUID
+	if current != nil {
+		currentID = &current.ID
+		current.StartAt = current.StartAt.In(cal.Location())
+		current.EndAt = current.EndAt.In(cal.Location())
+		current.DaysLeft = daysLeft(cal, current.EndAt, now)
+	}
+	for i := range actions.Items {
+		actions.Items[i].EndAt = actions.Items[i].EndAt.In(cal.Location())
+	}
+
+	bugs, improvements, err := u.repo.GetReworkCounts(ctx, projectID, currentID)
+	if err != nil {
+		return models.ProjectDashboard{}, err
+	}
+
+	activity, err := u.repo.GetRecentActivity(ctx, projectID, dashboardActivityLimit)
+	if err != nil {
+		return models.ProjectDashboard{}, err
+	}
+
+	return models.ProjectDashboard{
+		Project:          summary,
+		CurrentIteration: current,
+		Indicators:       indicators,
+		Actions:          actions,
+		Bugs:             bugs,
+		Improvements:     improvements,
+		RecentActivity:   activity,
+	}, nil
+}
+
+// daysLeft counts the working days left in an iteration, today included, zero once it has ended
+func daysLeft(cal models.WorkingCalendar, endAt, now time.Time) int {
+	if !endAt.After(now) {
+		return 0
+	}
+	return cal.CountWorkingDays(now, endAt)
+}
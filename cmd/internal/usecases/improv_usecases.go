@@ -16,8 +16,8 @@ func NewImprovUseCase(repo *improv.Repository) *ImprovUseCase {
 	return &ImprovUseCase{repo: repo}
 }
 
-func (u *ImprovUseCase) GetAll(ctx context.Context, taskID uuid.UUID) ([]models.Improv, error) {
-	return u.repo.GetAll(ctx, taskID)
+func (u *ImprovUseCase) GetAll(ctx context.Context, taskID uuid.UUID, q models.ListQuery) ([]models.Improv, error) {
+	return u.repo.GetAll(ctx, taskID, q)
 }
 
 func (u *ImprovUseCase) GetByID(ctx context.Context, id uuid.UUID) (models.Improv, error) {
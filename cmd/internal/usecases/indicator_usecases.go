@@ -71,23 +71,10 @@ func (u *IndicatorUseCase) UpdateMetricValues(ctx context.Context, indicatorID u
 	return u.repo.UpdateMetricValues(ctx, indicatorID, speed, rework, instability)
 }
 
-func (u *IndicatorUseCase) CalculateAndUpdateMetrics(ctx context.Context, indicatorID uuid.UUID, totalTasks, completedTasks, bugs, improvements int, durationDays float64) error {
-	var speed, rework, instability float64
-
-	if durationDays > 0 {
-		speed = float64(completedTasks) / durationDays
-	}
-
-	if totalTasks > 0 {
-		rework = float64(bugs) / float64(totalTasks)
-	}
-
-	// Instability index: improvements per task
-	if totalTasks > 0 {
-		instability = float64(improvements) / float64(totalTasks)
-	}
-
-	return u.repo.UpdateMetricValues(ctx, indicatorID, speed, rework, instability)
+func (u *IndicatorUseCase) CalculateAndUpdateMetrics(ctx context.Context, indicatorID uuid.UUID, totalTasks, completedTasks, bugs, improvements, workingDays int) error {
+	var ind models.Indicator
+	ind.SetMetricValues(totalTasks, completedTasks, bugs, improvements, workingDays)
+	return u.repo.UpdateMetricValues(ctx, indicatorID, ind.SpeedValue, ind.ReworkValue, ind.InstabilityValue)
 }
 
 // GetIndicatorWithLevels returns the indicator with productivity levels calculated
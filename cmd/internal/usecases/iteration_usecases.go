@@ -2,51 +2,343 @@ package usecases
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
 	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/attachment"
+	"prodyo-backend/cmd/internal/repositories/availability"
+	"prodyo-backend/cmd/internal/repositories/cadence"
+	"prodyo-backend/cmd/internal/repositories/calendar"
 	"prodyo-backend/cmd/internal/repositories/indicator_range"
 	"prodyo-backend/cmd/internal/repositories/iteration"
+	"prodyo-backend/cmd/internal/repositories/project"
 	"prodyo-backend/cmd/internal/repositories/task"
 	"prodyo-backend/cmd/internal/services"
+	"prodyo-backend/cmd/internal/storage"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+const (
+	// Forecasts run inside the request, so the simulation count is kept small enough to bound its cost
+	defaultForecastSimulations = 5000
+	maxForecastSimulations     = 10000
+
+	// Closed iterations whose speed turns capacity hours into points
+	capacityHistoryIterations = 3
+)
+
+var (
+	ErrInvalidForecastMode   = errors.New("mode must be points or tasks")
+	ErrInvalidForecastTarget = errors.New("provide either a positive backlog_size or a future target_date")
+	ErrInvalidForecastRun    = errors.New("simulations and sample_window must not be negative")
+	ErrNoForecastHistory     = errors.New("the project has no closed iterations to sample from")
+	ErrInvalidAvailability   = errors.New("invalid availability")
+	ErrInvalidIterationCount = errors.New("count must be from 1 to 26")
+	ErrCadenceNoWorkingDays  = errors.New("the project calendar has no working days left for the cadence")
+)
+
 type IterationUseCase struct {
 	repo               *iteration.Repository
 	taskRepo           *task.Repository
 	indicatorRangeRepo *indicator_range.Repository
+	projectRepo        *project.Repository
+	availabilityRepo   *availability.Repository
+	calendarRepo       *calendar.Repository
+	cadenceRepo        *cadence.Repository
+	attachmentRepo     *attachment.Repository
+	storage            storage.Storage
 }
 
-func NewIterationUseCase(repo *iteration.Repository, taskRepo *task.Repository, indicatorRangeRepo *indicator_range.Repository) *IterationUseCase {
+func NewIterationUseCase(repo *iteration.Repository, taskRepo *task.Repository, indicatorRangeRepo *indicator_range.Repository, projectRepo *project.Repository, availabilityRepo *availability.Repository, calendarRepo *calendar.Repository, cadenceRepo *cadence.Repository, attachmentRepo *attachment.Repository, storage storage.Storage) *IterationUseCase {
 	return &IterationUseCase{
 		repo:               repo,
 		taskRepo:           taskRepo,
 		indicatorRangeRepo: indicatorRangeRepo,
+		projectRepo:        projectRepo,
+		availabilityRepo:   availabilityRepo,
+		calendarRepo:       calendarRepo,
+		cadenceRepo:        cadenceRepo,
+		attachmentRepo:     attachmentRepo,
+		storage:            storage,
 	}
 }
 
+// GetAll returns the iterations of a project with their dates in the project timezone
 func (u *IterationUseCase) GetAll(ctx context.Context, projectID uuid.UUID) ([]models.Iteration, error) {
-	return u.repo.GetAll(ctx, projectID)
+	iterations, err := u.repo.GetAll(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	cal, err := u.calendarRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range iterations {
+		iterations[i] = iterations[i].In(cal.Location())
+	}
+	return iterations, nil
 }
 
+// GetByID returns the iteration with its dates in the project timezone
 func (u *IterationUseCase) GetByID(ctx context.Context, id uuid.UUID) (models.Iteration, error) {
-	return u.repo.GetByID(ctx, id)
+	it, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return models.Iteration{}, err
+	}
+
+	cal, err := u.calendarRepo.GetByProjectID(ctx, it.ProjectID)
+	if err != nil {
+		return models.Iteration{}, err
+	}
+	return it.In(cal.Location()), nil
+}
+
+// Create adds the iteration with the next number of its project
+// Iterations must end after they start and may not overlap other iterations of the project
+func (u *IterationUseCase) Create(ctx context.Context, iteration models.Iteration) (models.Iteration, error) {
+	if !iteration.EndAt.After(iteration.StartAt) {
+		return models.Iteration{}, &models.IterationError{
+			Code:    models.IterationInvalidDateRange,
+			Message: "end_at must be after start_at",
+		}
+	}
+
+	created, err := u.repo.Create(ctx, iteration)
+	if err != nil {
+		return models.Iteration{}, err
+	}
+
+	cal, err := u.calendarRepo.GetByProjectID(ctx, created.ProjectID)
+	if err != nil {
+		return models.Iteration{}, err
+	}
+	return created.In(cal.Location()), nil
+}
+
+// CreateNext adds an iteration after the latest one of the project with the same cadence: it starts
+// on the same weekday and time of day, on the first week the latest one has ended, and lasts as many days
+// Days are counted in the project timezone so daylight saving changes keep the clock times
+func (u *IterationUseCase) CreateNext(ctx context.Context, projectID uuid.UUID, description string) (models.Iteration, error) {
+	previous, err := u.repo.GetLatest(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, iteration.ErrNotFound) {
+			return models.Iteration{}, &models.IterationError{
+				Code:    models.IterationNoPrevious,
+				Message: "the project has no iteration to copy the cadence from",
+			}
+		}
+		return models.Iteration{}, err
+	}
+
+	cal, err := u.calendarRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return models.Iteration{}, err
+	}
+
+	start := previous.StartAt.In(cal.Location())
+	end := previous.EndAt.In(cal.Location())
+	days := int(math.Round(cal.StartOfDay(end).Sub(cal.StartOfDay(start)).Hours() / 24))
+
+	next := start.AddDate(0, 0, 7)
+	for next.Before(end) {
+		next = next.AddDate(0, 0, 7)
+	}
+	year, month, day := next.AddDate(0, 0, days).Date()
+	nextEnd := time.Date(year, month, day, end.Hour(), end.Minute(), end.Second(), end.Nanosecond(), cal.Location())
+
+	return u.Create(ctx, models.Iteration{
+		ProjectID:   projectID,
+		Description: description,
+		StartAt:     next,
+		EndAt:       nextEnd,
+	})
+}
+
+// GetDuplicateNumbers reports the numbers shared by several iterations of a project,
+// left over from before numbers were assigned by the server; every project the requester manages when projectID is nil
+func (u *IterationUseCase) GetDuplicateNumbers(ctx context.Context, projectID *uuid.UUID, requesterID uuid.UUID) ([]models.DuplicateIterationNumber, error) {
+	if projectID != nil {
+		if err := requireProjectManager(ctx, u.projectRepo, *projectID, requesterID); err != nil {
+			return nil, err
+		}
+	}
+	return u.repo.GetDuplicateNumbers(ctx, projectID, requesterID)
+}
+
+func (u *IterationUseCase) GetCadence(ctx context.Context, projectID uuid.UUID) (models.IterationCadence, error) {
+	return u.cadenceRepo.GetByProjectID(ctx, projectID)
+}
+
+// SetCadence saves the cadence of a project and creates the upcoming iterations it keeps ahead
+// The cadence is saved even when they cannot all be created; the update carries that error
+func (u *IterationUseCase) SetCadence(ctx context.Context, c models.IterationCadence, requesterID uuid.UUID) (models.CadenceUpdate, error) {
+	if err := c.Validate(); err != nil {
+		return models.CadenceUpdate{}, err
+	}
+	if err := requireProjectManager(ctx, u.projectRepo, c.ProjectID, requesterID); err != nil {
+		return models.CadenceUpdate{}, err
+	}
+
+	stored, err := u.cadenceRepo.Upsert(ctx, c)
+	if err != nil {
+		return models.CadenceUpdate{}, err
+	}
+
+	update := models.CadenceUpdate{IterationCadence: stored, Generated: []models.Iteration{}}
+	generated, err := u.keepAhead(ctx, stored, time.Now())
+	if generated != nil {
+		update.Generated = generated
+	}
+	if err != nil {
+		update.GenerationError = generationError(err, stored.ProjectID)
+	}
+	return update, nil
 }
 
-func (u *IterationUseCase) Create(ctx context.Context, iteration models.Iteration) (uuid.UUID, error) {
-	if iteration.ID == uuid.Nil {
-		iteration.ID = uuid.New()
+// generationError describes why upcoming iterations could not be created, without the details
+// of unexpected failures, which are logged instead
+func generationError(err error, projectID uuid.UUID) *models.IterationError {
+	var itErr *models.IterationError
+	switch {
+	case errors.As(err, &itErr):
+		return itErr
+	case errors.Is(err, ErrCadenceNoWorkingDays):
+		return &models.IterationError{Code: models.IterationNotGenerated, Message: err.Error()}
+	default:
+		log.Printf("Failed to generate iterations for project %s: %v", projectID, err)
+		return &models.IterationError{Code: models.IterationNotGenerated, Message: "failed to create the upcoming iterations"}
 	}
+}
+
+// DeleteCadence stops generating iterations for a project; those already created stay
+func (u *IterationUseCase) DeleteCadence(ctx context.Context, projectID, requesterID uuid.UUID) error {
+	if err := requireProjectManager(ctx, u.projectRepo, projectID, requesterID); err != nil {
+		return err
+	}
+	return u.cadenceRepo.Delete(ctx, projectID)
+}
 
-	if err := u.repo.Create(ctx, iteration); err != nil {
-		return uuid.Nil, err
+// GenerateIterations creates the next count iterations of the project following its cadence
+func (u *IterationUseCase) GenerateIterations(ctx context.Context, projectID uuid.UUID, count int, requesterID uuid.UUID) ([]models.Iteration, error) {
+	if count < 1 || count > models.MaxCadenceIterations {
+		return nil, ErrInvalidIterationCount
+	}
+	if err := requireProjectManager(ctx, u.projectRepo, projectID, requesterID); err != nil {
+		return nil, err
 	}
 
-	return iteration.ID, nil
+	c, err := u.cadenceRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return u.generate(ctx, c, count, time.Now())
 }
 
+// KeepSchedulesAhead tops up the upcoming iterations of every project whose cadence keeps some ahead
+// A project that fails is logged and skipped so the others are still served; it returns how many
+// iterations were created
+// Only one replica runs it at a time: the others find the schedule lock taken and create nothing
+func (u *IterationUseCase) KeepSchedulesAhead(ctx context.Context, now time.Time) (int, error) {
+	unlock, ok, err := u.cadenceRepo.TryLockSchedule(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+	defer unlock()
+
+	cadences, err := u.cadenceRepo.GetScheduled(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var created int
+	for _, c := range cadences {
+		// Iterations created before a failure are kept, so they count too
+		iterations, err := u.keepAhead(ctx, c, now)
+		created += len(iterations)
+		if err != nil {
+			log.Printf("Failed to generate iterations for project %s: %v", c.ProjectID, err)
+		}
+	}
+	return created, nil
+}
+
+// keepAhead creates the iterations missing for the project to have c.Ahead upcoming ones
+func (u *IterationUseCase) keepAhead(ctx context.Context, c models.IterationCadence, now time.Time) ([]models.Iteration, error) {
+	if c.Ahead == 0 {
+		return nil, nil
+	}
+
+	upcoming, err := u.cadenceRepo.CountUpcoming(ctx, c.ProjectID, now)
+	if err != nil {
+		return nil, err
+	}
+	if upcoming >= c.Ahead {
+		return nil, nil
+	}
+	return u.generate(ctx, c, c.Ahead-upcoming, now)
+}
+
+// generate creates count iterations after the latest one of the project; each goes through Create,
+// so it is numbered by the server and rejected if it overlaps an iteration added by hand
+func (u *IterationUseCase) generate(ctx context.Context, c models.IterationCadence, count int, now time.Time) ([]models.Iteration, error) {
+	cal, err := u.calendarRepo.GetByProjectID(ctx, c.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous *models.Iteration
+	latest, err := u.repo.GetLatest(ctx, c.ProjectID)
+	switch {
+	case err == nil:
+		previous = &latest
+	case !errors.Is(err, iteration.ErrNotFound):
+		return nil, err
+	}
+
+	generator := services.NewCadenceGenerator(c, cal)
+	created := make([]models.Iteration, 0, count)
+	for i := 0; i < count; i++ {
+		startAt, endAt, ok := generator.Next(previous, now)
+		if !ok {
+			return created, ErrCadenceNoWorkingDays
+		}
+
+		it, err := u.Create(ctx, models.Iteration{
+			ProjectID: c.ProjectID,
+			StartAt:   startAt,
+			EndAt:     endAt,
+		})
+		if err != nil {
+			return created, err
+		}
+		created = append(created, it)
+		previous = &it
+	}
+	return created, nil
+}
+
+// Delete removes the iteration with its tasks, then the files attached to any of them
 func (u *IterationUseCase) Delete(ctx context.Context, id uuid.UUID) error {
-	return u.repo.Delete(ctx, id)
+	keys, err := u.attachmentRepo.GetStorageKeysByIterationID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	deleteStoredObjects(ctx, u.storage, keys...)
+	return nil
 }
 
 func (u *IterationUseCase) GetIterationAnalysis(ctx context.Context, iterationID uuid.UUID) (models.IterationAnalysisResponse, error) {
@@ -65,8 +357,392 @@ func (u *IterationUseCase) GetIterationAnalysis(ctx context.Context, iterationID
 		return models.IterationAnalysisResponse{}, err
 	}
 
+	cal, err := u.calendarRepo.GetByProjectID(ctx, iteration.ProjectID)
+	if err != nil {
+		return models.IterationAnalysisResponse{}, err
+	}
+
 	calculator := services.NewIndicatorCalculator(tasks, ranges)
-	analysis := calculator.CalculateIterationAnalysis(iterationID)
+	analysis := calculator.CalculateIterationAnalysis(iterationID, cal)
 
 	return analysis, nil
 }
+
+// GetMemberAnalysis breaks the iteration indicators down per assignee
+// Only owners and maintainers of the project may see it
+func (u *IterationUseCase) GetMemberAnalysis(ctx context.Context, iterationID, requesterID uuid.UUID) (models.MemberAnalysisResponse, error) {
+	iteration, err := u.repo.GetByID(ctx, iterationID)
+	if err != nil {
+		return models.MemberAnalysisResponse{}, err
+	}
+
+	role, err := u.projectRepo.GetMemberRole(ctx, iteration.ProjectID, requesterID)
+	if err != nil {
+		if errors.Is(err, project.ErrMemberNotFound) {
+			return models.MemberAnalysisResponse{}, ErrNotProjectMember
+		}
+		return models.MemberAnalysisResponse{}, err
+	}
+	if !role.CanViewMemberAnalysis() {
+		return models.MemberAnalysisResponse{}, ErrInsufficientRole
+	}
+
+	tasks, err := u.taskRepo.GetAll(ctx, iterationID)
+	if err != nil {
+		return models.MemberAnalysisResponse{}, err
+	}
+
+	ranges, err := u.indicatorRangeRepo.GetByProjectID(ctx, iteration.ProjectID)
+	if err != nil {
+		return models.MemberAnalysisResponse{}, err
+	}
+
+	calculator := services.NewIndicatorCalculator(tasks, ranges)
+	return calculator.CalculateMemberAnalysis(iterationID), nil
+}
+
+// GetLabelAnalysis breaks the iteration indicators down per label
+func (u *IterationUseCase) GetLabelAnalysis(ctx context.Context, iterationID uuid.UUID) (models.LabelAnalysisResponse, error) {
+	iteration, err := u.repo.GetByID(ctx, iterationID)
+	if err != nil {
+		return models.LabelAnalysisResponse{}, err
+	}
+
+	tasks, err := u.taskRepo.GetAll(ctx, iterationID)
+	if err != nil {
+		return models.LabelAnalysisResponse{}, err
+	}
+
+	ranges, err := u.indicatorRangeRepo.GetByProjectID(ctx, iteration.ProjectID)
+	if err != nil {
+		return models.LabelAnalysisResponse{}, err
+	}
+
+	calculator := services.NewIndicatorCalculator(tasks, ranges)
+	return calculator.CalculateLabelAnalysis(iterationID), nil
+}
+
+// GetBurndown builds the daily burndown and burnup series of an iteration from the task status history
+func (u *IterationUseCase) GetBurndown(ctx context.Context, iterationID uuid.UUID) (models.BurndownResponse, error) {
+	iteration, err := u.repo.GetByID(ctx, iterationID)
+	if err != nil {
+		return models.BurndownResponse{}, err
+	}
+
+	tasks, err := u.taskRepo.GetAll(ctx, iterationID)
+	if err != nil {
+		return models.BurndownResponse{}, err
+	}
+
+	changes, err := u.taskRepo.GetStatusChangesByIterationID(ctx, iterationID)
+	if err != nil {
+		return models.BurndownResponse{}, err
+	}
+
+	cal, err := u.calendarRepo.GetByProjectID(ctx, iteration.ProjectID)
+	if err != nil {
+		return models.BurndownResponse{}, err
+	}
+
+	calculator := services.NewBurndownCalculator(iteration, tasks, changes, cal)
+	return calculator.Calculate(), nil
+}
+
+// GetCumulativeFlow counts the tasks of the iteration in each status per day
+func (u *IterationUseCase) GetCumulativeFlow(ctx context.Context, iterationID uuid.UUID) (models.CumulativeFlowResponse, error) {
+	iteration, err := u.repo.GetByID(ctx, iterationID)
+	if err != nil {
+		return models.CumulativeFlowResponse{}, err
+	}
+
+	tasks, err := u.taskRepo.GetAll(ctx, iterationID)
+	if err != nil {
+		return models.CumulativeFlowResponse{}, err
+	}
+
+	changes, err := u.taskRepo.GetStatusChangesByIterationID(ctx, iterationID)
+	if err != nil {
+		return models.CumulativeFlowResponse{}, err
+	}
+
+	cal, err := u.calendarRepo.GetByProjectID(ctx, iteration.ProjectID)
+	if err != nil {
+		return models.CumulativeFlowResponse{}, err
+	}
+
+	calculator := services.NewFlowCalculator(cal)
+	return calculator.CalculateCumulativeFlow(iteration, tasks, changes), nil
+}
+
+// GetAvailability returns the availability recorded for the members of an iteration
+func (u *IterationUseCase) GetAvailability(ctx context.Context, iterationID uuid.UUID) ([]models.MemberAvailability, error) {
+	if _, err := u.repo.GetByID(ctx, iterationID); err != nil {
+		return nil, err
+	}
+	return u.availabilityRepo.GetByIterationID(ctx, iterationID)
+}
+
+// SetAvailability replaces the availability of the iteration members on behalf of a project owner or maintainer
+// Each member of the project is listed once, works at most 24 hours a day and takes days off within the iteration
+func (u *IterationUseCase) SetAvailability(ctx context.Context, iterationID uuid.UUID, list []models.MemberAvailability, requesterID uuid.UUID) error {
+	it, err := u.repo.GetByID(ctx, iterationID)
+	if err != nil {
+		return err
+	}
+	if err := requireProjectManager(ctx, u.projectRepo, it.ProjectID, requesterID); err != nil {
+		return err
+	}
+
+	first, last := it.StartAt.Format("2006-01-02"), it.EndAt.Format("2006-01-02")
+	seen := make(map[uuid.UUID]bool, len(list))
+	for _, a := range list {
+		if seen[a.Member.ID] {
+			return fmt.Errorf("%w: member %s is listed more than once", ErrInvalidAvailability, a.Member.ID)
+		}
+		seen[a.Member.ID] = true
+
+		if a.HoursPerDay < 0 || a.HoursPerDay > 24 {
+			return fmt.Errorf("%w: hours_per_day must be between 0 and 24", ErrInvalidAvailability)
+		}
+		for _, day := range a.DaysOff {
+			if d := day.Format("2006-01-02"); d < first || d > last {
+				return fmt.Errorf("%w: day off %s is outside the iteration", ErrInvalidAvailability, d)
+			}
+		}
+
+		isMember, err := u.projectRepo.IsMember(ctx, it.ProjectID, a.Member.ID)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return fmt.Errorf("%w: user %s is not a member of the project", ErrInvalidAvailability, a.Member.ID)
+		}
+	}
+
+	return u.availabilityRepo.Replace(ctx, iterationID, list)
+}
+
+// GetCapacity compares the expected time and points planned for each member with their availability
+// Hours become points at the actual speed of the last closed iterations of the project
+func (u *IterationUseCase) GetCapacity(ctx context.Context, iterationID uuid.UUID) (models.IterationCapacity, error) {
+	it, err := u.repo.GetByID(ctx, iterationID)
+	if err != nil {
+		return models.IterationCapacity{}, err
+	}
+
+	tasks, err := u.taskRepo.GetAll(ctx, iterationID)
+	if err != nil {
+		return models.IterationCapacity{}, err
+	}
+
+	list, err := u.availabilityRepo.GetByIterationID(ctx, iterationID)
+	if err != nil {
+		return models.IterationCapacity{}, err
+	}
+
+	// The history ends before the iteration starts, so a closed iteration is not measured against itself
+	history, err := u.closedIterations(ctx, it.ProjectID, it.StartAt, capacityHistoryIterations)
+	if err != nil {
+		return models.IterationCapacity{}, err
+	}
+
+	var historyTasks []models.Task
+	for _, h := range history {
+		closedTasks, err := u.taskRepo.GetAll(ctx, h.ID)
+		if err != nil {
+			return models.IterationCapacity{}, err
+		}
+		historyTasks = append(historyTasks, closedTasks...)
+	}
+	speed := services.NewIndicatorCalculator(historyTasks, nil).CalculateSpeed()
+
+	cal, err := u.calendarRepo.GetByProjectID(ctx, it.ProjectID)
+	if err != nil {
+		return models.IterationCapacity{}, err
+	}
+
+	capacity := services.NewCapacityCalculator(it, tasks, list, speed.ActualSpeed, cal).Calculate()
+	capacity.HistoricalIterations = len(history)
+	return capacity, nil
+}
+
+// GetThroughput counts the tasks completed per day or week across all iterations of a project
+func (u *IterationUseCase) GetThroughput(ctx context.Context, projectID uuid.UUID, interval models.ThroughputInterval) (models.ThroughputResponse, error) {
+	completions, err := u.taskRepo.GetCompletionsByProjectID(ctx, projectID)
+	if err != nil {
+		return models.ThroughputResponse{}, err
+	}
+
+	cal, err := u.calendarRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return models.ThroughputResponse{}, err
+	}
+
+	calculator := services.NewFlowCalculator(cal)
+	return calculator.CalculateThroughput(projectID, completions, interval), nil
+}
+
+// GetEstimateAccuracyTrend returns the estimate accuracy of every iteration of a project
+// so the team can see whether its estimates improve over time
+func (u *IterationUseCase) GetEstimateAccuracyTrend(ctx context.Context, projectID uuid.UUID) (models.EstimateAccuracyTrend, error) {
+	iterations, err := u.repo.GetAll(ctx, projectID)
+	if err != nil {
+		return models.EstimateAccuracyTrend{}, err
+	}
+
+	trend := models.EstimateAccuracyTrend{
+		ProjectID: projectID,
+		XAxis: models.AxisDefinition{
+			Type:  "ITERATION",
+			Label: "Iteração",
+		},
+		YAxis: models.AxisDefinition{
+			Type:  "PERCENT",
+			Label: "Erro de estimativa (%)",
+		},
+		MAPE: []models.DataPoint{},
+		Bias: []models.DataPoint{},
+	}
+
+	for _, it := range iterations {
+		tasks, err := u.taskRepo.GetAll(ctx, it.ID)
+		if err != nil {
+			return models.EstimateAccuracyTrend{}, err
+		}
+
+		accuracy := services.NewIndicatorCalculator(tasks, nil).CalculateEstimateAccuracy()
+		if accuracy.Count == 0 {
+			continue
+		}
+
+		trend.MAPE = append(trend.MAPE, models.DataPoint{X: it.Number, Y: accuracy.MAPE})
+		trend.Bias = append(trend.Bias, models.DataPoint{X: it.Number, Y: accuracy.Bias})
+	}
+
+	return trend, nil
+}
+
+// Forecast runs a Monte Carlo simulation over the delivery of the closed iterations of a project
+func (u *IterationUseCase) Forecast(ctx context.Context, projectID uuid.UUID, req models.ForecastRequest) (models.ForecastResponse, error) {
+	if req.Mode == "" {
+		req.Mode = models.ForecastPoints
+	}
+	if req.Mode != models.ForecastPoints && req.Mode != models.ForecastTasks {
+		return models.ForecastResponse{}, ErrInvalidForecastMode
+	}
+
+	now := time.Now()
+	hasBacklog := req.BacklogSize != nil && *req.BacklogSize > 0
+	hasTarget := req.TargetDate != nil && req.TargetDate.After(now)
+	if hasBacklog == hasTarget {
+		return models.ForecastResponse{}, ErrInvalidForecastTarget
+	}
+
+	if req.Simulations < 0 || req.SampleWindow < 0 {
+		return models.ForecastResponse{}, ErrInvalidForecastRun
+	}
+	if req.Simulations == 0 {
+		req.Simulations = defaultForecastSimulations
+	}
+	if req.Simulations > maxForecastSimulations {
+		req.Simulations = maxForecastSimulations
+	}
+
+	cal, err := u.calendarRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return models.ForecastResponse{}, err
+	}
+
+	samples, err := u.forecastSamples(ctx, projectID, cal, req.Mode, req.SampleWindow, now)
+	if err != nil {
+		return models.ForecastResponse{}, err
+	}
+	if len(samples) == 0 {
+		return models.ForecastResponse{}, ErrNoForecastHistory
+	}
+
+	seed := now.UnixNano()
+	if req.Seed != nil {
+		seed = *req.Seed
+	}
+
+	response := models.ForecastResponse{
+		ProjectID:         projectID,
+		Mode:              req.Mode,
+		Simulations:       req.Simulations,
+		Seed:              seed,
+		SampledIterations: samples,
+	}
+
+	simulator := services.NewForecastSimulator(samples, cal, seed)
+	if hasBacklog {
+		response.BacklogSize = req.BacklogSize
+		response.CompletionDates = simulator.ForecastCompletion(*req.BacklogSize, now, req.Simulations)
+	} else {
+		targetDate := req.TargetDate.In(cal.Location())
+		response.TargetDate = &targetDate
+		response.Scope = simulator.ForecastScope(now, *req.TargetDate, req.Simulations)
+	}
+
+	return response, nil
+}
+
+// forecastSamples returns the delivery and working days of the last sampleWindow closed iterations (all when 0)
+func (u *IterationUseCase) forecastSamples(ctx context.Context, projectID uuid.UUID, cal models.WorkingCalendar, mode models.ForecastMode, sampleWindow int, now time.Time) ([]models.ForecastSample, error) {
+	closed, err := u.closedIterations(ctx, projectID, now, sampleWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]models.ForecastSample, 0, len(closed))
+	for _, it := range closed {
+		tasks, err := u.taskRepo.GetAll(ctx, it.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		var delivered float64
+		for _, t := range tasks {
+			if t.Status != models.StatusCompleted {
+				continue
+			}
+			if mode == models.ForecastTasks {
+				delivered++
+			} else {
+				delivered += float64(t.Points)
+			}
+		}
+
+		samples = append(samples, models.ForecastSample{
+			IterationID: it.ID,
+			Number:      it.Number,
+			Delivered:   delivered,
+			Days:        float64(cal.CountWorkingDays(it.StartAt, it.EndAt)),
+		})
+	}
+
+	return samples, nil
+}
+
+// closedIterations returns the last window iterations of the project closed at now (all when 0), oldest first
+func (u *IterationUseCase) closedIterations(ctx context.Context, projectID uuid.UUID, now time.Time, window int) ([]models.Iteration, error) {
+	iterations, err := u.repo.GetAll(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var closed []models.Iteration
+	for _, it := range iterations {
+		if it.IsClosed(now) && it.EndAt.After(it.StartAt) {
+			closed = append(closed, it)
+		}
+	}
+
+	sort.Slice(closed, func(i, j int) bool {
+		return closed[i].EndAt.Before(closed[j].EndAt)
+	})
+	if window > 0 && len(closed) > window {
+		closed = closed[len(closed)-window:]
+	}
+	return closed, nil
+}
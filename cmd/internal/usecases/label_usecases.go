@@ -0,0 +1,148 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/bug"
+	"prodyo-backend/cmd/internal/repositories/improv"
+	"prodyo-backend/cmd/internal/repositories/label"
+	"prodyo-backend/cmd/internal/repositories/project"
+	"prodyo-backend/cmd/internal/repositories/task"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// maxLabelNameLength counts characters, not bytes
+const maxLabelNameLength = 100
+
+var (
+	ErrInvalidLabelName = errors.New("label name is required and must have at most 100 characters")
+)
+
+type LabelUseCase struct {
+	repo        *label.Repository
+	projectRepo *project.Repository
+	taskRepo    *task.Repository
+	bugRepo     *bug.Repository
+	improvRepo  *improv.Repository
+}
+
+func NewLabelUseCase(
+	repo *label.Repository,
+	projectRepo *project.Repository,
+	taskRepo *task.Repository,
+	bugRepo *bug.Repository,
+	improvRepo *improv.Repository,
+) *LabelUseCase {
+	return &LabelUseCase{
+		repo:        repo,
+		projectRepo: projectRepo,
+		taskRepo:    taskRepo,
+		bugRepo:     bugRepo,
+		improvRepo:  improvRepo,
+	}
+}
+
+func (u *LabelUseCase) GetByProjectID(ctx context.Context, projectID, requesterID uuid.UUID) ([]models.Label, error) {
+	if _, _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, err
+	}
+	if err := requireProjectMember(ctx, u.projectRepo, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	return u.repo.GetByProjectID(ctx, projectID)
+}
+
+// Create adds a label to the project; labels are shared by the whole project, so only its managers define them
+func (u *LabelUseCase) Create(ctx context.Context, l models.Label, requesterID uuid.UUID) (models.Label, error) {
+	l.Name = strings.TrimSpace(l.Name)
+	if l.Name == "" || utf8.RuneCountInString(l.Name) > maxLabelNameLength {
+		return models.Label{}, ErrInvalidLabelName
+	}
+
+	if _, _, err := u.projectRepo.GetByID(ctx, l.ProjectID); err != nil {
+		return models.Label{}, err
+	}
+	if err := requireProjectManager(ctx, u.projectRepo, l.ProjectID, requesterID); err != nil {
+		return models.Label{}, err
+	}
+
+	if l.ID == uuid.Nil {
+		l.ID = uuid.New()
+	}
+
+	if err := u.repo.Create(ctx, l); err != nil {
+		return models.Label{}, err
+	}
+
+	return u.repo.GetByID(ctx, l.ID)
+}
+
+// Update renames or recolors a label of the project
+func (u *LabelUseCase) Update(ctx context.Context, l models.Label, requesterID uuid.UUID) (models.Label, error) {
+	l.Name = strings.TrimSpace(l.Name)
+	if l.Name == "" || utf8.RuneCountInString(l.Name) > maxLabelNameLength {
+		return models.Label{}, ErrInvalidLabelName
+	}
+
+	if err := requireProjectManager(ctx, u.projectRepo, l.ProjectID, requesterID); err != nil {
+		return models.Label{}, err
+	}
+
+	if err := u.repo.Update(ctx, l); err != nil {
+		return models.Label{}, err
+	}
+
+	return u.repo.GetByID(ctx, l.ID)
+}
+
+func (u *LabelUseCase) Delete(ctx context.Context, projectID, id, requesterID uuid.UUID) error {
+	if err := requireProjectManager(ctx, u.projectRepo, projectID, requesterID); err != nil {
+		return err
+	}
+	return u.repo.Delete(ctx, projectID, id)
+}
+
+// SetTaskLabels replaces the labels of a task with labels of its project
+func (u *LabelUseCase) SetTaskLabels(ctx context.Context, taskID uuid.UUID, labelIDs []uuid.UUID, requesterID uuid.UUID) error {
+	if err := u.requireTaskMember(ctx, taskID, requesterID); err != nil {
+		return err
+	}
+	return u.repo.SetTaskLabels(ctx, taskID, labelIDs)
+}
+
+// SetBugLabels replaces the labels of a bug with labels of its project
+func (u *LabelUseCase) SetBugLabels(ctx context.Context, bugID uuid.UUID, labelIDs []uuid.UUID, requesterID uuid.UUID) error {
+	b, err := u.bugRepo.GetByID(ctx, bugID)
+	if err != nil {
+		return err
+	}
+	if err := u.requireTaskMember(ctx, b.TaskID, requesterID); err != nil {
+		return err
+	}
+	return u.repo.SetBugLabels(ctx, bugID, labelIDs)
+}
+
+// SetImprovementLabels replaces the labels of an improvement with labels of its project
+func (u *LabelUseCase) SetImprovementLabels(ctx context.Context, improvementID uuid.UUID, labelIDs []uuid.UUID, requesterID uuid.UUID) error {
+	i, err := u.improvRepo.GetByID(ctx, improvementID)
+	if err != nil {
+		return err
+	}
+	if err := u.requireTaskMember(ctx, i.TaskID, requesterID); err != nil {
+		return err
+	}
+	return u.repo.SetImprovementLabels(ctx, improvementID, labelIDs)
+}
+
+// requireTaskMember fails unless the user belongs to the project of the task
+func (u *LabelUseCase) requireTaskMember(ctx context.Context, taskID, userID uuid.UUID) error {
+	t, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	return requireProjectMember(ctx, u.projectRepo, t.ProjectID, userID)
+}
@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"context"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/notification"
+
+	"github.com/google/uuid"
+)
+
+type NotificationUseCase struct {
+	repo *notification.Repository
+}
+
+func NewNotificationUseCase(repo *notification.Repository) *NotificationUseCase {
+	return &NotificationUseCase{
+		repo: repo,
+	}
+}
+
+// GetByUserID returns a page of the user's notifications and how many of them are unread
+func (u *NotificationUseCase) GetByUserID(ctx context.Context, userID uuid.UUID, unreadOnly bool, pagination models.PaginationRequest) ([]models.Notification, models.PaginationResponse, int64, error) {
+	notifications, paginationResp, err := u.repo.GetByUserID(ctx, userID, unreadOnly, pagination)
+	if err != nil {
+		return nil, models.PaginationResponse{}, 0, err
+	}
+
+	unread, err := u.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, models.PaginationResponse{}, 0, err
+	}
+
+	return notifications, paginationResp, unread, nil
+}
+
+func (u *NotificationUseCase) MarkRead(ctx context.Context, userID, id uuid.UUID) error {
+	return u.repo.MarkRead(ctx, userID, id)
+}
+
+func (u *NotificationUseCase) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return u.repo.MarkAllRead(ctx, userID)
+}
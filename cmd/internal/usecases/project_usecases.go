@@ -2,30 +2,46 @@ package usecases
 
 import (
 	"context"
+	"errors"
 	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/attachment"
 	"prodyo-backend/cmd/internal/repositories/project"
+	"prodyo-backend/cmd/internal/storage"
 
 	"github.com/google/uuid"
 )
 
+var (
+	ErrInvalidRole      = errors.New("role must be owner, maintainer or member")
+	ErrInsufficientRole = errors.New("your role in the project does not allow this")
+	ErrLastOwner        = errors.New("a project must keep at least one owner")
+)
+
 type ProjectUseCase struct {
-	repo *project.Repository
+	repo           *project.Repository
+	attachmentRepo *attachment.Repository
+	storage        storage.Storage
 }
 
 // Construtor
-func NewProjectUseCase(repo *project.Repository) *ProjectUseCase {
-	return &ProjectUseCase{repo: repo}
+func NewProjectUseCase(repo *project.Repository, attachmentRepo *attachment.Repository, storage storage.Storage) *ProjectUseCase {
+	return &ProjectUseCase{
+		repo:           repo,
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+	}
 }
 
-func (u *ProjectUseCase) GetAll(ctx context.Context, pagination models.PaginationRequest) ([]models.Project, models.PaginationResponse, error) {
-	return u.repo.GetAll(ctx, pagination)
+func (u *ProjectUseCase) GetAll(ctx context.Context, pagination models.PaginationRequest, q models.ListQuery) ([]models.Project, models.PaginationResponse, error) {
+	return u.repo.GetAll(ctx, pagination, q)
 }
 
 func (u *ProjectUseCase) GetByID(ctx context.Context, id uuid.UUID) (models.Project, int64, error) {
 	return u.repo.GetByID(ctx, id)
 }
 
-func (u *ProjectUseCase) Add(ctx context.Context, newProject models.Project) (uuid.UUID, error) {
+// Add creates the project; the creator joins it as owner
+func (u *ProjectUseCase) Add(ctx context.Context, newProject models.Project, creatorID uuid.UUID) (uuid.UUID, error) {
 	if newProject.ID == uuid.Nil {
 		newProject.ID = uuid.New()
 	}
@@ -34,6 +50,12 @@ func (u *ProjectUseCase) Add(ctx context.Context, newProject models.Project) (uu
 		return uuid.Nil, err
 	}
 
+	if creatorID != uuid.Nil {
+		if err := u.repo.SetMemberRole(ctx, newProject.ID, creatorID, models.RoleOwner); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
 	return newProject.ID, nil
 }
 
@@ -41,10 +63,83 @@ func (u *ProjectUseCase) Update(ctx context.Context, project models.Project) err
 	return u.repo.Update(ctx, project)
 }
 
+// Delete removes the project with everything in it, then the files uploaded to it
 func (u *ProjectUseCase) Delete(ctx context.Context, id uuid.UUID) error {
-	return u.repo.Delete(ctx, id)
+	keys, err := u.attachmentRepo.GetStorageKeysByProjectID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	deleteStoredObjects(ctx, u.storage, keys...)
+	return nil
 }
 
 func (u *ProjectUseCase) GetByMemberID(ctx context.Context, userID uuid.UUID, pagination models.PaginationRequest) ([]models.Project, models.PaginationResponse, map[uuid.UUID]int64, error) {
 	return u.repo.GetByMemberID(ctx, userID, pagination)
-}
\ No newline at end of file
+}
+
+// SetMemberRole changes the role of a member; only owners can do it and the last owner cannot be demoted
+func (u *ProjectUseCase) SetMemberRole(ctx context.Context, projectID, requesterID, userID uuid.UUID, role models.ProjectRoleEnum) error {
+	if !models.IsValidProjectRole(role) {
+		return ErrInvalidRole
+	}
+
+	requesterRole, err := u.repo.GetMemberRole(ctx, projectID, requesterID)
+	if err != nil {
+		if errors.Is(err, project.ErrMemberNotFound) {
+			return ErrNotProjectMember
+		}
+		return err
+	}
+	if requesterRole != models.RoleOwner {
+		return ErrInsufficientRole
+	}
+
+	currentRole, err := u.repo.GetMemberRole(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+
+	if currentRole == models.RoleOwner && role != models.RoleOwner {
+		owners, err := u.repo.CountOwners(ctx, projectID)
+		if err != nil {
+			return err
+		}
+		if owners <= 1 {
+			return ErrLastOwner
+		}
+	}
+
+	return u.repo.SetMemberRole(ctx, projectID, userID, role)
+}
+
+// requireProjectMember fails with ErrNotProjectMember unless the user belongs to the project
+func requireProjectMember(ctx context.Context, projectRepo *project.Repository, projectID, userID uuid.UUID) error {
+	isMember, err := projectRepo.IsMember(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrNotProjectMember
+	}
+	return nil
+}
+
+// requireProjectManager fails unless the user belongs to the project with a role that may change its settings
+func requireProjectManager(ctx context.Context, projectRepo *project.Repository, projectID, userID uuid.UUID) error {
+	role, err := projectRepo.GetMemberRole(ctx, projectID, userID)
+	if err != nil {
+		if errors.Is(err, project.ErrMemberNotFound) {
+			return ErrNotProjectMember
+		}
+		return err
+	}
+	if !role.CanManageProject() {
+		return ErrInsufficientRole
+	}
+	return nil
+}
@@ -0,0 +1,280 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/indicator_range"
+	"prodyo-backend/cmd/internal/repositories/iteration"
+	"prodyo-backend/cmd/internal/repositories/project"
+	"prodyo-backend/cmd/internal/repositories/retrospective"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNotProjectMember       = errors.New("user is not a member of the project")
+	ErrRetrospectiveExists    = errors.New("retrospective already exists for this iteration")
+	ErrNotItemAuthor          = errors.New("only the author can change this item")
+	ErrItemAlreadyPromoted    = errors.New("item has already been promoted")
+	ErrIndicatorRangeMismatch = errors.New("indicator range does not belong to the iteration project")
+)
+
+type RetrospectiveUseCase struct {
+	repo               *retrospective.Repository
+	iterationRepo      *iteration.Repository
+	projectRepo        *project.Repository
+	indicatorRangeRepo *indicator_range.Repository
+}
+
+func NewRetrospectiveUseCase(
+	repo *retrospective.Repository,
+	iterationRepo *iteration.Repository,
+	projectRepo *project.Repository,
+	indicatorRangeRepo *indicator_range.Repository,
+) *RetrospectiveUseCase {
+	return &RetrospectiveUseCase{
+		repo:               repo,
+		iterationRepo:      iterationRepo,
+		projectRepo:        projectRepo,
+		indicatorRangeRepo: indicatorRangeRepo,
+	}
+}
+
+func (u *RetrospectiveUseCase) GetByIterationID(ctx context.Context, iterationID uuid.UUID) (models.Retrospective, error) {
+	return u.repo.GetByIterationID(ctx, iterationID)
+}
+
+func (u *RetrospectiveUseCase) GetByID(ctx context.Context, id uuid.UUID) (models.Retrospective, error) {
+	return u.repo.GetByID(ctx, id)
+}
+
+// Create opens the retrospective of an iteration, using the default columns when none are given
+// Only members of the iteration project may open it
+func (u *RetrospectiveUseCase) Create(ctx context.Context, retro models.Retrospective, requesterID uuid.UUID) (uuid.UUID, error) {
+	if _, err := u.requireMember(ctx, retro.IterationID, requesterID); err != nil {
+		return uuid.Nil, err
+	}
+
+	if _, err := u.repo.GetByIterationID(ctx, retro.IterationID); err == nil {
+		return uuid.Nil, ErrRetrospectiveExists
+	} else if !errors.Is(err, retrospective.ErrNotFound) {
+		return uuid.Nil, err
+	}
+
+	if retro.ID == uuid.Nil {
+		retro.ID = uuid.New()
+	}
+
+	if len(retro.Columns) == 0 {
+		for _, title := range models.DefaultRetrospectiveColumns() {
+			retro.Columns = append(retro.Columns, models.RetrospectiveColumn{Title: title})
+		}
+	}
+
+	if err := u.repo.Create(ctx, retro); err != nil {
+		return uuid.Nil, err
+	}
+
+	return retro.ID, nil
+}
+
+// AddColumn appends a column to a retrospective; only members of the iteration project may add one
+func (u *RetrospectiveUseCase) AddColumn(ctx context.Context, column models.RetrospectiveColumn, requesterID uuid.UUID) (uuid.UUID, error) {
+	retro, err := u.repo.GetByID(ctx, column.RetrospectiveID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if _, err := u.requireMember(ctx, retro.IterationID, requesterID); err != nil {
+		return uuid.Nil, err
+	}
+
+	if column.ID == uuid.Nil {
+		column.ID = uuid.New()
+	}
+
+	if err := u.repo.AddColumn(ctx, column); err != nil {
+		return uuid.Nil, err
+	}
+
+	return column.ID, nil
+}
+
+// CreateItem adds a note to a column; only members of the iteration project may write items
+func (u *RetrospectiveUseCase) CreateItem(ctx context.Context, item models.RetrospectiveItem) (uuid.UUID, error) {
+	iterationID, err := u.repo.GetIterationIDByColumnID(ctx, item.ColumnID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if _, err := u.requireMember(ctx, iterationID, item.Author.ID); err != nil {
+		return uuid.Nil, err
+	}
+
+	if item.ID == uuid.Nil {
+		item.ID = uuid.New()
+	}
+
+	if err := u.repo.CreateItem(ctx, item); err != nil {
+		return uuid.Nil, err
+	}
+
+	return item.ID, nil
+}
+
+func (u *RetrospectiveUseCase) DeleteItem(ctx context.Context, itemID, userID uuid.UUID) error {
+	item, err := u.repo.GetItemByID(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	if item.Author.ID != userID {
+		return ErrNotItemAuthor
+	}
+
+	return u.repo.DeleteItem(ctx, itemID)
+}
+
+func (u *RetrospectiveUseCase) Vote(ctx context.Context, itemID, userID uuid.UUID) (models.RetrospectiveItem, error) {
+	if _, err := u.requireItemMember(ctx, itemID, userID); err != nil {
+		return models.RetrospectiveItem{}, err
+	}
+
+	if err := u.repo.AddVote(ctx, itemID, userID); err != nil {
+		return models.RetrospectiveItem{}, err
+	}
+
+	return u.repo.GetItemByID(ctx, itemID)
+}
+
+func (u *RetrospectiveUseCase) Unvote(ctx context.Context, itemID, userID uuid.UUID) (models.RetrospectiveItem, error) {
+	if _, err := u.requireItemMember(ctx, itemID, userID); err != nil {
+		return models.RetrospectiveItem{}, err
+	}
+
+	if err := u.repo.RemoveVote(ctx, itemID, userID); err != nil {
+		return models.RetrospectiveItem{}, err
+	}
+
+	return u.repo.GetItemByID(ctx, itemID)
+}
+
+// PromoteToCause turns an item into a cause on one of the project indicator ranges
+func (u *RetrospectiveUseCase) PromoteToCause(ctx context.Context, itemID, userID uuid.UUID, newCause models.Cause) (models.RetrospectiveItem, error) {
+	item, err := u.preparePromotion(ctx, itemID, userID, newCause.IndicatorRangeID)
+	if err != nil {
+		return models.RetrospectiveItem{}, err
+	}
+
+	if newCause.ID == uuid.Nil {
+		newCause.ID = uuid.New()
+	}
+	if newCause.Description == "" {
+		newCause.Description = item.Description
+	}
+
+	if err := u.promote(ctx, itemID, newCause, nil); err != nil {
+		return models.RetrospectiveItem{}, err
+	}
+
+	return u.repo.GetItemByID(ctx, itemID)
+}
+
+// PromoteToAction turns an item into an action; like any action it needs a cause,
+// which is created from the item as well
+func (u *RetrospectiveUseCase) PromoteToAction(ctx context.Context, itemID, userID uuid.UUID, newCause models.Cause, newAction models.Action) (models.RetrospectiveItem, error) {
+	item, err := u.preparePromotion(ctx, itemID, userID, newCause.IndicatorRangeID)
+	if err != nil {
+		return models.RetrospectiveItem{}, err
+	}
+
+	if newCause.ID == uuid.Nil {
+		newCause.ID = uuid.New()
+	}
+	if newCause.Description == "" {
+		newCause.Description = item.Description
+	}
+
+	if newAction.ID == uuid.Nil {
+		newAction.ID = uuid.New()
+	}
+	newAction.IndicatorRangeID = newCause.IndicatorRangeID
+	newAction.Cause = newCause
+	if newAction.Description == "" {
+		newAction.Description = item.Description
+	}
+
+	if err := u.promote(ctx, itemID, newCause, &newAction); err != nil {
+		return models.RetrospectiveItem{}, err
+	}
+
+	return u.repo.GetItemByID(ctx, itemID)
+}
+
+// promote creates the cause and action and marks the item in one transaction, so a failure leaves nothing behind
+func (u *RetrospectiveUseCase) promote(ctx context.Context, itemID uuid.UUID, newCause models.Cause, newAction *models.Action) error {
+	err := u.repo.Promote(ctx, itemID, newCause, newAction)
+	if errors.Is(err, retrospective.ErrItemPromoted) {
+		return ErrItemAlreadyPromoted
+	}
+	return err
+}
+
+func (u *RetrospectiveUseCase) preparePromotion(ctx context.Context, itemID, userID, indicatorRangeID uuid.UUID) (models.RetrospectiveItem, error) {
+	item, err := u.repo.GetItemByID(ctx, itemID)
+	if err != nil {
+		return models.RetrospectiveItem{}, err
+	}
+
+	if item.CauseID != nil || item.ActionID != nil {
+		return models.RetrospectiveItem{}, ErrItemAlreadyPromoted
+	}
+
+	projectID, err := u.requireItemMember(ctx, itemID, userID)
+	if err != nil {
+		return models.RetrospectiveItem{}, err
+	}
+
+	ir, err := u.indicatorRangeRepo.GetByID(ctx, indicatorRangeID)
+	if err != nil {
+		return models.RetrospectiveItem{}, err
+	}
+	if ir.ProjectID != projectID {
+		return models.RetrospectiveItem{}, ErrIndicatorRangeMismatch
+	}
+
+	return item, nil
+}
+
+func (u *RetrospectiveUseCase) requireItemMember(ctx context.Context, itemID, userID uuid.UUID) (uuid.UUID, error) {
+	item, err := u.repo.GetItemByID(ctx, itemID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	iterationID, err := u.repo.GetIterationIDByColumnID(ctx, item.ColumnID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return u.requireMember(ctx, iterationID, userID)
+}
+
+// requireMember checks that the user belongs to the project of the iteration and returns the project ID
+func (u *RetrospectiveUseCase) requireMember(ctx context.Context, iterationID, userID uuid.UUID) (uuid.UUID, error) {
+	it, err := u.iterationRepo.GetByID(ctx, iterationID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	isMember, err := u.projectRepo.IsMember(ctx, it.ProjectID, userID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !isMember {
+		return uuid.Nil, ErrNotProjectMember
+	}
+
+	return it.ProjectID, nil
+}
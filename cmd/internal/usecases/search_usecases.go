@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/search"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 50
+)
+
+var (
+	ErrEmptySearchQuery  = errors.New("search query is required")
+	ErrInvalidSearchType = errors.New("invalid search type")
+)
+
+type SearchUseCase struct {
+	repo *search.Repository
+}
+
+func NewSearchUseCase(repo *search.Repository) *SearchUseCase {
+	return &SearchUseCase{repo: repo}
+}
+
+// Search runs the full-text query over the projects the requester belongs to
+func (u *SearchUseCase) Search(ctx context.Context, requesterID uuid.UUID, req models.SearchRequest) (models.SearchResponse, error) {
+	req.Query = strings.TrimSpace(req.Query)
+	if req.Query == "" {
+		return models.SearchResponse{}, ErrEmptySearchQuery
+	}
+
+	for _, t := range req.Types {
+		if !models.IsValidSearchHitType(t) {
+			return models.SearchResponse{}, ErrInvalidSearchType
+		}
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = defaultSearchLimit
+	}
+	if req.Limit > maxSearchLimit {
+		req.Limit = maxSearchLimit
+	}
+
+	hits, err := u.repo.Search(ctx, requesterID, req)
+	if err != nil {
+		return models.SearchResponse{}, err
+	}
+
+	return models.SearchResponse{
+		Query: req.Query,
+		Hits:  hits,
+	}, nil
+}
@@ -2,45 +2,629 @@ package usecases
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
 	"prodyo-backend/cmd/internal/models"
+	"prodyo-backend/cmd/internal/repositories/attachment"
+	"prodyo-backend/cmd/internal/repositories/calendar"
+	"prodyo-backend/cmd/internal/repositories/comment"
+	"prodyo-backend/cmd/internal/repositories/dependency"
+	"prodyo-backend/cmd/internal/repositories/indicator"
+	"prodyo-backend/cmd/internal/repositories/iteration"
+	"prodyo-backend/cmd/internal/repositories/project"
+	"prodyo-backend/cmd/internal/repositories/status_transition"
 	"prodyo-backend/cmd/internal/repositories/task"
+	"prodyo-backend/cmd/internal/repositories/wip_limit"
+	"prodyo-backend/cmd/internal/storage"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+var (
+	ErrInvalidStatus           = errors.New("invalid status")
+	ErrInvalidStatusTransition = errors.New("status transition not allowed in this project")
+	ErrTaskBlocked             = errors.New("task has unfinished blockers; pass force=true to start it anyway")
+	ErrWIPLimitReached         = errors.New("WIP limit reached")
+	ErrInvalidWIPLimit         = errors.New("WIP limits need a known status, listed once, and a max_tasks above zero")
+	ErrInvalidBulkRequest      = errors.New("invalid bulk request")
+	ErrSubTaskMove             = errors.New("sub-tasks move with their parent task")
+	ErrIterationOtherProject   = errors.New("target iteration belongs to another project")
+	ErrIterationClosed         = errors.New("target iteration is already closed")
+	ErrNotInBacklog            = errors.New("task is not in the project backlog")
+)
+
+// TaskInclude selects the optional relations loaded with a task
+type TaskInclude struct {
+	Comments    bool
+	Attachments bool
+}
+
+// TaskMove is where a task goes on the board; zero fields keep the current iteration and status,
+// and without AfterID and BeforeID the task lands at the bottom of its column
+type TaskMove struct {
+	IterationID uuid.UUID
+	Backlog     bool // Send the task back to the project backlog instead of an iteration
+	Status      models.StatusEnum
+	AfterID     *uuid.UUID // Task right above the new position
+	BeforeID    *uuid.UUID // Task right below the new position
+	Force       bool       // Start the task even if it has unfinished blockers
+}
+
 type TaskUseCase struct {
-	repo *task.Repository
+	repo                 *task.Repository
+	iterationRepo        *iteration.Repository
+	statusTransitionRepo *status_transition.Repository
+	commentRepo          *comment.Repository
+	attachmentRepo       *attachment.Repository
+	dependencyRepo       *dependency.Repository
+	wipLimitRepo         *wip_limit.Repository
+	indicatorRepo        *indicator.Repository
+	calendarRepo         *calendar.Repository
+	projectRepo          *project.Repository
+	storage              storage.Storage
 }
 
-func NewTaskUseCase(repo *task.Repository) *TaskUseCase {
-	return &TaskUseCase{repo: repo}
+func NewTaskUseCase(
+	repo *task.Repository,
+	iterationRepo *iteration.Repository,
+	statusTransitionRepo *status_transition.Repository,
+	commentRepo *comment.Repository,
+	attachmentRepo *attachment.Repository,
+	dependencyRepo *dependency.Repository,
+	wipLimitRepo *wip_limit.Repository,
+	indicatorRepo *indicator.Repository,
+	calendarRepo *calendar.Repository,
+	projectRepo *project.Repository,
+	storage storage.Storage,
+) *TaskUseCase {
+	return &TaskUseCase{
+		repo:                 repo,
+		iterationRepo:        iterationRepo,
+		statusTransitionRepo: statusTransitionRepo,
+		commentRepo:          commentRepo,
+		attachmentRepo:       attachmentRepo,
+		dependencyRepo:       dependencyRepo,
+		wipLimitRepo:         wipLimitRepo,
+		indicatorRepo:        indicatorRepo,
+		calendarRepo:         calendarRepo,
+		projectRepo:          projectRepo,
+		storage:              storage,
+	}
 }
 
-func (u *TaskUseCase) GetAll(ctx context.Context, iterationID uuid.UUID) ([]models.Task, error) {
-	return u.repo.GetAll(ctx, iterationID)
+func (u *TaskUseCase) GetAll(ctx context.Context, iterationID uuid.UUID, q models.ListQuery) ([]models.Task, error) {
+	return u.repo.List(ctx, iterationID, q)
+}
+
+// GetBacklog returns the top-level tasks of the project backlog, by priority unless the query sorts them
+func (u *TaskUseCase) GetBacklog(ctx context.Context, projectID uuid.UUID, q models.ListQuery) ([]models.Task, error) {
+	return u.repo.ListBacklog(ctx, projectID, q)
 }
 
 func (u *TaskUseCase) GetByID(ctx context.Context, id uuid.UUID) (models.Task, error) {
 	return u.repo.GetByID(ctx, id)
 }
 
-func (u *TaskUseCase) Create(ctx context.Context, newTask models.Task) (uuid.UUID, error) {
+// GetDetail returns a task with the optional relations selected in include
+// Comments are only shown to members of the task's project, as on the comment endpoints
+func (u *TaskUseCase) GetDetail(ctx context.Context, id uuid.UUID, include TaskInclude, requesterID uuid.UUID) (models.Task, error) {
+	t, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return models.Task{}, err
+	}
+
+	if include.Comments {
+		if err := requireProjectMember(ctx, u.projectRepo, t.ProjectID, requesterID); err != nil {
+			return models.Task{}, err
+		}
+		comments, err := u.commentRepo.GetByTarget(ctx, models.CommentOnTask, id)
+		if err != nil {
+			return models.Task{}, err
+		}
+		t.Comments = models.BuildCommentThreads(comments)
+	}
+
+	if include.Attachments {
+		attachments, err := u.attachmentRepo.GetByTarget(ctx, models.AttachmentOnTask, id)
+		if err != nil {
+			return models.Task{}, err
+		}
+		t.Attachments = attachments
+	}
+
+	return t, nil
+}
+
+// Create adds the task to its iteration, or to the backlog of newTask.ProjectID when it has no iteration
+func (u *TaskUseCase) Create(ctx context.Context, newTask models.Task, actorID uuid.UUID) (uuid.UUID, error) {
 	if newTask.ID == uuid.Nil {
 		newTask.ID = uuid.New()
 	}
 
-	if err := u.repo.Create(ctx, newTask); err != nil {
-		return uuid.Nil, err
+	if newTask.Status != "" && !models.IsValidStatus(newTask.Status) {
+		return uuid.Nil, ErrInvalidStatus
 	}
 
+	if newTask.IterationID != nil {
+		it, err := u.iterationRepo.GetByID(ctx, *newTask.IterationID)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		newTask.ProjectID = it.ProjectID
+	}
+
+	status := newTask.Status
+	if status == "" {
+		status = models.StatusNotStarted
+	}
+	// Sub-tasks are not cards on the board and do not count against its limits
+	var checkColumn func(ctx context.Context, board task.Board) error
+	if newTask.ParentTaskID == nil {
+		checkColumn = func(ctx context.Context, board task.Board) error {
+			return u.checkWIPLimit(ctx, board, newTask.ProjectID, newTask.IterationID, status, newTask.ID)
+		}
+	}
+
+	if err := u.repo.Create(ctx, newTask, actorID, checkColumn); err != nil {
+		return uuid.Nil, err
+	}
 	return newTask.ID, nil
 }
 
-func (u *TaskUseCase) Update(ctx context.Context, task models.Task) error {
-	return u.repo.Update(ctx, task)
+// Update saves the task; an empty status leaves it unchanged
+// A status change is checked against the project transition rules and the status read with the task
+// row locked; starting a task with unfinished blockers is rejected unless force is set
+func (u *TaskUseCase) Update(ctx context.Context, updated models.Task, actorID uuid.UUID, force bool) error {
+	if updated.Status != "" && !models.IsValidStatus(updated.Status) {
+		return ErrInvalidStatus
+	}
+
+	checkStatus := func(ctx context.Context, board task.Board, current models.Task, to models.StatusEnum) error {
+		return u.checkStatusChange(ctx, board, current, to, force)
+	}
+	return u.repo.Update(ctx, updated, actorID, checkStatus)
+}
+
+// Move changes the iteration, the status and the board position of a task in one step
+// The status change goes through the same checks as Update; a task changing iteration must stay
+// in its project, cannot land in a closed iteration and takes its sub-tasks along
+func (u *TaskUseCase) Move(ctx context.Context, id uuid.UUID, move TaskMove, actorID uuid.UUID) error {
+	existing, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	status := move.Status
+	if status == "" {
+		status = existing.Status
+	}
+	if !models.IsValidStatus(status) {
+		return ErrInvalidStatus
+	}
+
+	// nil stands for the project backlog
+	iterationID := existing.IterationID
+	switch {
+	case move.Backlog:
+		iterationID = nil
+	case move.IterationID != uuid.Nil:
+		iterationID = &move.IterationID
+	}
+
+	changesIteration := !models.SameIteration(iterationID, existing.IterationID)
+	if changesIteration {
+		if err := u.checkIterationChange(ctx, existing, iterationID); err != nil {
+			return err
+		}
+	}
+
+	// The checks look at the column the task lands in
+	checkColumn := func(ctx context.Context, board task.Board, current models.Task) error {
+		moved := current
+		moved.IterationID = iterationID
+		switch {
+		case current.Status != status:
+			return u.checkStatusChange(ctx, board, moved, status, move.Force)
+		case changesIteration:
+			return u.checkWIPLimit(ctx, board, current.ProjectID, iterationID, status, id)
+		}
+		return nil
+	}
+
+	return u.repo.Move(ctx, id, iterationID, status, move.AfterID, move.BeforeID, actorID, checkColumn)
+}
+
+// checkIterationChange fails when the task cannot leave its iteration, or the backlog, for the target iteration
+// A nil target is the backlog of the project, which takes any top-level task of the project back
+func (u *TaskUseCase) checkIterationChange(ctx context.Context, existing models.Task, targetID *uuid.UUID) error {
+	if existing.ParentTaskID != nil {
+		return ErrSubTaskMove
+	}
+	if targetID == nil {
+		return nil
+	}
+
+	target, err := u.iterationRepo.GetByID(ctx, *targetID)
+	if err != nil {
+		return err
+	}
+	if target.IsClosed(time.Now()) {
+		return ErrIterationClosed
+	}
+	if existing.ProjectID != target.ProjectID {
+		return ErrIterationOtherProject
+	}
+	return nil
+}
+
+// Plan pulls backlog tasks into an iteration of their project, each at the bottom of its status column
+// Like a bulk move, a task failing its checks, or no longer in the backlog, is reported and left out
+func (u *TaskUseCase) Plan(ctx context.Context, iterationID uuid.UUID, taskIDs []uuid.UUID, actorID uuid.UUID, force bool) (models.TaskBulkResponse, error) {
+	req := models.TaskBulkRequest{
+		TaskIDs:     taskIDs,
+		Operation:   models.TaskBulkMoveToIteration,
+		IterationID: &iterationID,
+		Force:       force,
+	}
+	return u.bulk(ctx, req, actorID, true)
+}
+
+// Bulk applies one operation to several tasks in a single transaction and reports the outcome per task
+// A task failing its checks is left out without undoing the others
+// Indicators are recalculated once per affected iteration after the commit
+func (u *TaskUseCase) Bulk(ctx context.Context, req models.TaskBulkRequest, actorID uuid.UUID) (models.TaskBulkResponse, error) {
+	return u.bulk(ctx, req, actorID, false)
+}
+
+// bulk runs a bulk operation; with fromBacklog only tasks of the project backlog are moved
+func (u *TaskUseCase) bulk(ctx context.Context, req models.TaskBulkRequest, actorID uuid.UUID, fromBacklog bool) (models.TaskBulkResponse, error) {
+	ids := make([]uuid.UUID, 0, len(req.TaskIDs))
+	seen := make(map[uuid.UUID]bool, len(req.TaskIDs))
+	for _, id := range req.TaskIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 || len(ids) > models.MaxBulkTasks {
+		return models.TaskBulkResponse{}, fmt.Errorf("%w: between 1 and %d task_ids are required", ErrInvalidBulkRequest, models.MaxBulkTasks)
+	}
+
+	var target models.Iteration
+	switch req.Operation {
+	case models.TaskBulkPatch:
+		if req.Patch == nil || req.Patch.IsEmpty() {
+			return models.TaskBulkResponse{}, fmt.Errorf("%w: patch needs at least one field", ErrInvalidBulkRequest)
+		}
+		if req.Patch.Status != nil && !models.IsValidStatus(*req.Patch.Status) {
+			return models.TaskBulkResponse{}, ErrInvalidStatus
+		}
+	case models.TaskBulkMoveToIteration:
+		if req.IterationID == nil {
+			return models.TaskBulkResponse{}, fmt.Errorf("%w: move_to_iteration needs an iteration_id", ErrInvalidBulkRequest)
+		}
+		it, err := u.iterationRepo.GetByID(ctx, *req.IterationID)
+		if err != nil {
+			return models.TaskBulkResponse{}, err
+		}
+		if it.IsClosed(time.Now()) {
+			return models.TaskBulkResponse{}, ErrIterationClosed
+		}
+		target = it
+	case models.TaskBulkDelete:
+	default:
+		return models.TaskBulkResponse{}, fmt.Errorf("%w: unknown operation %q", ErrInvalidBulkRequest, req.Operation)
+	}
+
+	affected := make(map[uuid.UUID]bool)
+	touch := func(iterationID *uuid.UUID) {
+		if iterationID != nil {
+			affected[*iterationID] = true
+		}
+	}
+	var storageKeys []string
+
+	apply := func(ctx context.Context, btx task.BulkTx, id uuid.UUID) error {
+		current, err := btx.Lock(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		switch req.Operation {
+		case models.TaskBulkPatch:
+			checkStatus := func(ctx context.Context, board task.Board, to models.StatusEnum) error {
+				return u.checkStatusChange(ctx, board, current, to, req.Force)
+			}
+			if err := btx.Patch(ctx, current, *req.Patch, actorID, checkStatus); err != nil {
+				return err
+			}
+			touch(current.IterationID)
+
+		case models.TaskBulkMoveToIteration:
+			if current.ParentTaskID != nil {
+				return ErrSubTaskMove
+			}
+			if current.ProjectID != target.ProjectID {
+				return ErrIterationOtherProject
+			}
+			if fromBacklog && current.IterationID != nil {
+				return ErrNotInBacklog
+			}
+			if models.SameIteration(current.IterationID, &target.ID) {
+				return nil
+			}
+			checkColumn := func(ctx context.Context, board task.Board) error {
+				return u.checkWIPLimit(ctx, board, target.ProjectID, &target.ID, current.Status, current.ID)
+			}
+			if err := btx.MoveToIteration(ctx, current, target.ID, checkColumn); err != nil {
+				return err
+			}
+			touch(current.IterationID)
+			touch(&target.ID)
+
+		case models.TaskBulkDelete:
+			keys, err := u.attachmentRepo.GetStorageKeysByTaskIDTx(ctx, btx.Tx(), id)
+			if err != nil {
+				return err
+			}
+			if err := btx.Delete(ctx, id); err != nil {
+				return err
+			}
+			storageKeys = append(storageKeys, keys...)
+			touch(current.IterationID)
+		}
+
+		return nil
+	}
+
+	errs, err := u.repo.Bulk(ctx, ids, apply)
+	if err != nil {
+		return models.TaskBulkResponse{}, err
+	}
+
+	resp := models.TaskBulkResponse{
+		Operation: req.Operation,
+		Results:   make([]models.TaskBulkResult, len(ids)),
+	}
+	for i, id := range ids {
+		resp.Results[i] = models.TaskBulkResult{TaskID: id, OK: errs[i] == nil, Err: errs[i]}
+		if errs[i] == nil {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+	}
+
+	deleteStoredObjects(ctx, u.storage, storageKeys...)
+
+	iterationIDs := make([]*uuid.UUID, 0, len(affected))
+	for id := range affected {
+		id := id
+		iterationIDs = append(iterationIDs, &id)
+	}
+	u.recalculateIndicators(ctx, iterationIDs...)
+
+	return resp, nil
+}
+
+// AddDependency records that blockerID blocks blockedID; the requester must belong to their project
+func (u *TaskUseCase) AddDependency(ctx context.Context, blockerID, blockedID, requesterID uuid.UUID) error {
+	if err := u.requireTaskMember(ctx, blockerID, requesterID); err != nil {
+		return err
+	}
+	return u.dependencyRepo.Add(ctx, blockerID, blockedID)
 }
 
+// RemoveDependency deletes the dependency between two tasks in either direction; the requester must belong to their project
+func (u *TaskUseCase) RemoveDependency(ctx context.Context, taskID, otherID, requesterID uuid.UUID) error {
+	if err := u.requireTaskMember(ctx, taskID, requesterID); err != nil {
+		return err
+	}
+	return u.dependencyRepo.Remove(ctx, taskID, otherID)
+}
+
+// requireTaskMember fails unless the user belongs to the project of the task
+func (u *TaskUseCase) requireTaskMember(ctx context.Context, taskID, userID uuid.UUID) error {
+	t, err := u.repo.GetByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	return requireProjectMember(ctx, u.projectRepo, t.ProjectID, userID)
+}
+
+func (u *TaskUseCase) GetDependencyGraph(ctx context.Context, iterationID uuid.UUID) (models.DependencyGraph, error) {
+	if _, err := u.iterationRepo.GetByID(ctx, iterationID); err != nil {
+		return models.DependencyGraph{}, err
+	}
+	return u.dependencyRepo.GetIterationGraph(ctx, iterationID)
+}
+
+// Delete removes the task with its sub-tasks, bugs and improvements, then the files attached to any of them
 func (u *TaskUseCase) Delete(ctx context.Context, id uuid.UUID) error {
-	return u.repo.Delete(ctx, id)
+	keys, err := u.attachmentRepo.GetStorageKeysByTaskID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// The attachment rows went with the task
+	deleteStoredObjects(ctx, u.storage, keys...)
+	return nil
+}
+
+func (u *TaskUseCase) GetStatusHistory(ctx context.Context, id uuid.UUID) ([]models.TaskStatusChange, error) {
+	if _, err := u.repo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return u.repo.GetStatusChangesByTaskID(ctx, id)
+}
+
+func (u *TaskUseCase) GetStatusTransitions(ctx context.Context, projectID uuid.UUID) ([]models.StatusTransition, error) {
+	return u.statusTransitionRepo.GetByProjectID(ctx, projectID)
+}
+
+// SetStatusTransitions replaces the allowed transitions of a project; an empty list allows every transition
+// Only owners and maintainers may change the workflow
+func (u *TaskUseCase) SetStatusTransitions(ctx context.Context, projectID uuid.UUID, transitions []models.StatusTransition, requesterID uuid.UUID) error {
+	for _, t := range transitions {
+		if !models.IsValidStatus(t.FromStatus) || !models.IsValidStatus(t.ToStatus) || t.FromStatus == t.ToStatus {
+			return ErrInvalidStatusTransition
+		}
+	}
+
+	if err := requireProjectManager(ctx, u.projectRepo, projectID, requesterID); err != nil {
+		return err
+	}
+	return u.statusTransitionRepo.Replace(ctx, projectID, transitions)
+}
+
+func (u *TaskUseCase) GetWIPLimits(ctx context.Context, projectID uuid.UUID) ([]models.WIPLimit, error) {
+	return u.wipLimitRepo.GetByProjectID(ctx, projectID)
+}
+
+// SetWIPLimits replaces the WIP limits of a project on behalf of one of its owners or maintainers;
+// statuses left out have no limit
+func (u *TaskUseCase) SetWIPLimits(ctx context.Context, projectID uuid.UUID, limits []models.WIPLimit, requesterID uuid.UUID) error {
+	if err := requireProjectManager(ctx, u.projectRepo, projectID, requesterID); err != nil {
+		return err
+	}
+
+	seen := make(map[models.StatusEnum]bool, len(limits))
+	for _, l := range limits {
+		if !models.IsValidStatus(l.Status) || l.MaxTasks <= 0 || seen[l.Status] {
+			return ErrInvalidWIPLimit
+		}
+		seen[l.Status] = true
+	}
+	return u.wipLimitRepo.Replace(ctx, projectID, limits)
+}
+
+// checkStatusChange runs every rule a task moving to another status must pass, with its board locked
+func (u *TaskUseCase) checkStatusChange(ctx context.Context, board task.Board, existing models.Task, to models.StatusEnum, force bool) error {
+	if err := u.checkTransition(ctx, existing.ProjectID, existing.Status, to); err != nil {
+		return err
+	}
+
+	if to == models.StatusInProgress && !force {
+		blockers, err := u.dependencyRepo.GetUnfinishedBlockersTx(ctx, board.Tx(), existing.ID)
+		if err != nil {
+			return err
+		}
+		if len(blockers) > 0 {
+			return ErrTaskBlocked
+		}
+	}
+
+	// Sub-tasks are not cards on the board and do not count against its limits
+	if existing.ParentTaskID != nil {
+		return nil
+	}
+	return u.checkWIPLimit(ctx, board, existing.ProjectID, existing.IterationID, to, existing.ID)
 }
 
+// checkWIPLimit fails when the status column of the iteration board is already full without taskID
+// The count goes through the locked board, so it includes tasks the same transaction already moved there
+// The backlog, a nil iterationID, is not a board and has no limits
+func (u *TaskUseCase) checkWIPLimit(ctx context.Context, board task.Board, projectID uuid.UUID, iterationID *uuid.UUID, status models.StatusEnum, taskID uuid.UUID) error {
+	if iterationID == nil {
+		return nil
+	}
+
+	limit, err := u.wipLimitRepo.GetByStatus(ctx, projectID, status)
+	if err != nil {
+		return err
+	}
+	if limit == 0 {
+		return nil
+	}
+
+	count, err := board.CountInColumn(ctx, *iterationID, status, taskID)
+	if err != nil {
+		return err
+	}
+	if count >= limit {
+		return fmt.Errorf("%w: %s already holds %d of %d tasks", ErrWIPLimitReached, status, count, limit)
+	}
+	return nil
+}
+
+func (u *TaskUseCase) checkTransition(ctx context.Context, projectID uuid.UUID, from, to models.StatusEnum) error {
+	transitions, err := u.statusTransitionRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	for _, t := range transitions {
+		if t.FromStatus == from && t.ToStatus == to {
+			return nil
+		}
+	}
+
+	return ErrInvalidStatusTransition
+}
+
+// recalculateIndicators refreshes the stored indicator values of each iteration from its current tasks
+// The tasks are already saved, so a failure is only logged; the backlog (nil) and iterations without
+// an indicator are skipped
+func (u *TaskUseCase) recalculateIndicators(ctx context.Context, iterationIDs ...*uuid.UUID) {
+	for _, iterationID := range iterationIDs {
+		if iterationID == nil {
+			continue
+		}
+		if err := u.recalculateIndicator(ctx, *iterationID); err != nil {
+			log.Printf("Failed to recalculate indicators of iteration %s: %v", *iterationID, err)
+		}
+	}
+}
+
+func (u *TaskUseCase) recalculateIndicator(ctx context.Context, iterationID uuid.UUID) error {
+	ind, err := u.indicatorRepo.Get(ctx, iterationID)
+	if err != nil {
+		if errors.Is(err, indicator.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	it, err := u.iterationRepo.GetByID(ctx, iterationID)
+	if err != nil {
+		return err
+	}
+
+	tasks, err := u.repo.GetAll(ctx, iterationID)
+	if err != nil {
+		return err
+	}
+
+	var total, completed, bugs, improvements int
+	var count func(tasks []models.Task)
+	count = func(tasks []models.Task) {
+		for _, t := range tasks {
+			total++
+			if t.Status == models.StatusCompleted {
+				completed++
+			}
+			bugs += len(t.Bugs)
+			improvements += len(t.Improvements)
+			count(t.Tasks)
+		}
+	}
+	count(tasks)
+
+	cal, err := u.calendarRepo.GetByProjectID(ctx, it.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	ind.SetMetricValues(total, completed, bugs, improvements, cal.CountWorkingDays(it.StartAt, it.EndAt))
+	return u.indicatorRepo.UpdateMetricValues(ctx, ind.ID, ind.SpeedValue, ind.ReworkValue, ind.InstabilityValue)
+}
@@ -17,8 +17,8 @@ func NewUserUseCase(repo *user.Repository) *UserUseCase {
 	return &UserUseCase{repo: repo}
 }
 
-func (u *UserUseCase) GetAll(ctx context.Context, pagination models.PaginationRequest) ([]models.User, models.PaginationResponse, error) {
-	return u.repo.GetAll(ctx, pagination)
+func (u *UserUseCase) GetAll(ctx context.Context, pagination models.PaginationRequest, q models.ListQuery) ([]models.User, models.PaginationResponse, error) {
+	return u.repo.GetAll(ctx, pagination, q)
 }
 
 func (u *UserUseCase) GetByID(ctx context.Context, id uuid.UUID) (models.User, error) {
@@ -0,0 +1,6 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS retrospective_votes;
+DROP TABLE IF EXISTS retrospective_items;
+DROP TABLE IF EXISTS retrospective_columns;
+DROP TABLE IF EXISTS retrospectives;
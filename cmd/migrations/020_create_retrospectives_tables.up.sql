@@ -0,0 +1,76 @@
+-- +migrate Up
+
+-- One retrospective per iteration
+CREATE TABLE IF NOT EXISTS retrospectives (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    iteration_id UUID NOT NULL,
+    votes_per_member INTEGER NOT NULL DEFAULT 3,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (iteration_id) REFERENCES iterations(id) ON DELETE CASCADE,
+    UNIQUE(iteration_id),
+    CHECK (votes_per_member >= 0)
+);
+
+-- Configurable columns (e.g. went well / to improve / ideas)
+CREATE TABLE IF NOT EXISTS retrospective_columns (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    retrospective_id UUID NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (retrospective_id) REFERENCES retrospectives(id) ON DELETE CASCADE
+);
+
+-- Items written by project members, optionally promoted into a cause or action
+CREATE TABLE IF NOT EXISTS retrospective_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    column_id UUID NOT NULL,
+    author_id UUID,
+    description TEXT NOT NULL,
+    cause_id UUID,
+    action_id UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (column_id) REFERENCES retrospective_columns(id) ON DELETE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE SET NULL,
+    FOREIGN KEY (cause_id) REFERENCES causes(id) ON DELETE SET NULL,
+    FOREIGN KEY (action_id) REFERENCES actions(id) ON DELETE SET NULL
+);
+
+-- Dot-voting: a member may place several dots on the same item
+CREATE TABLE IF NOT EXISTS retrospective_votes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    item_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (item_id) REFERENCES retrospective_items(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_retrospectives_iteration_id ON retrospectives (iteration_id);
+CREATE INDEX IF NOT EXISTS idx_retrospective_columns_retrospective_id ON retrospective_columns (retrospective_id);
+CREATE INDEX IF NOT EXISTS idx_retrospective_items_column_id ON retrospective_items (column_id);
+CREATE INDEX IF NOT EXISTS idx_retrospective_items_author_id ON retrospective_items (author_id);
+CREATE INDEX IF NOT EXISTS idx_retrospective_votes_item_id ON retrospective_votes (item_id);
+CREATE INDEX IF NOT EXISTS idx_retrospective_votes_user_id ON retrospective_votes (user_id);
+
+-- Create triggers for updated_at
+DROP TRIGGER IF EXISTS trg_retrospectives_set_updated_at ON retrospectives;
+CREATE TRIGGER trg_retrospectives_set_updated_at
+BEFORE UPDATE ON retrospectives
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+DROP TRIGGER IF EXISTS trg_retrospective_columns_set_updated_at ON retrospective_columns;
+CREATE TRIGGER trg_retrospective_columns_set_updated_at
+BEFORE UPDATE ON retrospective_columns
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+DROP TRIGGER IF EXISTS trg_retrospective_items_set_updated_at ON retrospective_items;
+CREATE TRIGGER trg_retrospective_items_set_updated_at
+BEFORE UPDATE ON retrospective_items
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
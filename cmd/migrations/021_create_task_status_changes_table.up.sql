@@ -0,0 +1,24 @@
+-- +migrate Up
+
+-- Every status a task goes through, so progress charts can use the real change time
+-- instead of tasks.updated_at
+CREATE TABLE IF NOT EXISTS task_status_changes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_id UUID NOT NULL,
+    from_status VARCHAR(50),
+    to_status VARCHAR(50) NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    CHECK (from_status IS NULL OR from_status IN ('NotStarted', 'InProgress', 'Completed')),
+    CHECK (to_status IN ('NotStarted', 'InProgress', 'Completed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_status_changes_task_id ON task_status_changes (task_id);
+CREATE INDEX IF NOT EXISTS idx_task_status_changes_changed_at ON task_status_changes (changed_at);
+
+-- Backfill one entry per existing task with its current status
+-- Tasks that already moved on are assumed to have done so at their last update
+INSERT INTO task_status_changes (task_id, from_status, to_status, changed_at)
+SELECT id, NULL, status,
+       CASE WHEN status = 'NotStarted' THEN created_at ELSE updated_at END
+FROM tasks;
@@ -0,0 +1,9 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS project_status_transitions;
+
+ALTER TABLE task_status_changes
+DROP CONSTRAINT IF EXISTS task_status_changes_actor_id_fkey;
+
+ALTER TABLE task_status_changes
+DROP COLUMN IF EXISTS actor_id;
@@ -0,0 +1,26 @@
+-- +migrate Up
+
+-- Record who changed the status of a task
+ALTER TABLE task_status_changes
+ADD COLUMN actor_id UUID;
+
+ALTER TABLE task_status_changes
+ADD CONSTRAINT task_status_changes_actor_id_fkey
+FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE SET NULL;
+
+-- Allowed status transitions per project
+-- A project without rows accepts every transition
+CREATE TABLE IF NOT EXISTS project_status_transitions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    from_status VARCHAR(50) NOT NULL,
+    to_status VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    UNIQUE (project_id, from_status, to_status),
+    CHECK (from_status IN ('NotStarted', 'InProgress', 'Completed')),
+    CHECK (to_status IN ('NotStarted', 'InProgress', 'Completed')),
+    CHECK (from_status <> to_status)
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_status_transitions_project_id ON project_status_transitions (project_id);
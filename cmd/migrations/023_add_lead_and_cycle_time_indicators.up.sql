@@ -0,0 +1,20 @@
+-- +migrate Up
+
+-- Allow the lead time and cycle time indicators in project ranges
+ALTER TABLE indicator_ranges
+DROP CONSTRAINT IF EXISTS indicator_ranges_indicator_type_check;
+
+ALTER TABLE indicator_ranges
+ADD CONSTRAINT indicator_ranges_indicator_type_check
+CHECK (indicator_type IN ('SpeedPerIteration', 'ReworkPerIteration', 'InstabilityIndex', 'LeadTime', 'CycleTime'));
+
+-- Give existing projects the default ranges (in hours) for the new indicators
+INSERT INTO indicator_ranges (project_id, indicator_type, ok_min, ok_max, alert_min, alert_max, critical_min, critical_max)
+SELECT id, 'LeadTime', 0, 72, 72, 168, 168, 100000
+FROM projects
+ON CONFLICT (project_id, indicator_type) DO NOTHING;
+
+INSERT INTO indicator_ranges (project_id, indicator_type, ok_min, ok_max, alert_min, alert_max, critical_min, critical_max)
+SELECT id, 'CycleTime', 0, 24, 24, 72, 72, 100000
+FROM projects
+ON CONFLICT (project_id, indicator_type) DO NOTHING;
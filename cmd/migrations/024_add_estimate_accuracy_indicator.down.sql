@@ -0,0 +1,11 @@
+-- +migrate Down
+
+DELETE FROM indicator_ranges
+WHERE indicator_type = 'EstimateAccuracy';
+
+ALTER TABLE indicator_ranges
+DROP CONSTRAINT IF EXISTS indicator_ranges_indicator_type_check;
+
+ALTER TABLE indicator_ranges
+ADD CONSTRAINT indicator_ranges_indicator_type_check
+CHECK (indicator_type IN ('SpeedPerIteration', 'ReworkPerIteration', 'InstabilityIndex', 'LeadTime', 'CycleTime'));
@@ -0,0 +1,15 @@
+-- +migrate Up
+
+-- Allow the estimate accuracy indicator in project ranges
+ALTER TABLE indicator_ranges
+DROP CONSTRAINT IF EXISTS indicator_ranges_indicator_type_check;
+
+ALTER TABLE indicator_ranges
+ADD CONSTRAINT indicator_ranges_indicator_type_check
+CHECK (indicator_type IN ('SpeedPerIteration', 'ReworkPerIteration', 'InstabilityIndex', 'LeadTime', 'CycleTime', 'EstimateAccuracy'));
+
+-- Give existing projects the default range (MAPE in percent) for the new indicator
+INSERT INTO indicator_ranges (project_id, indicator_type, ok_min, ok_max, alert_min, alert_max, critical_min, critical_max)
+SELECT id, 'EstimateAccuracy', 0, 20, 20, 50, 50, 100000
+FROM projects
+ON CONFLICT (project_id, indicator_type) DO NOTHING;
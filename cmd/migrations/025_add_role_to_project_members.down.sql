@@ -0,0 +1,7 @@
+-- +migrate Down
+
+ALTER TABLE project_members
+DROP CONSTRAINT IF EXISTS project_members_role_check;
+
+ALTER TABLE project_members
+DROP COLUMN IF EXISTS role;
@@ -0,0 +1,20 @@
+-- +migrate Up
+
+-- Members have a role in the project; owners and maintainers can see sensitive data
+ALTER TABLE project_members
+ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'member';
+
+ALTER TABLE project_members
+ADD CONSTRAINT project_members_role_check
+CHECK (role IN ('owner', 'maintainer', 'member'));
+
+-- The earliest member of each existing project becomes its owner
+UPDATE project_members pm
+SET role = 'owner'
+FROM (
+    SELECT DISTINCT ON (project_id) project_id, user_id
+    FROM project_members
+    ORDER BY project_id, created_at ASC, user_id ASC
+) first_members
+WHERE pm.project_id = first_members.project_id
+  AND pm.user_id = first_members.user_id;
@@ -0,0 +1,15 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_actions_search_vector;
+DROP INDEX IF EXISTS idx_causes_search_vector;
+DROP INDEX IF EXISTS idx_improvements_search_vector;
+DROP INDEX IF EXISTS idx_bugs_search_vector;
+DROP INDEX IF EXISTS idx_tasks_search_vector;
+DROP INDEX IF EXISTS idx_projects_search_vector;
+
+ALTER TABLE actions DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE causes DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE improvements DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE bugs DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE tasks DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE projects DROP COLUMN IF EXISTS search_vector;
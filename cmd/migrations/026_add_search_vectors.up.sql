@@ -0,0 +1,42 @@
+-- +migrate Up
+
+-- Full-text search vectors, kept up to date by PostgreSQL as generated columns
+-- The simple configuration is used because content mixes Portuguese and English
+ALTER TABLE projects
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+) STORED;
+
+ALTER TABLE tasks
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+) STORED;
+
+ALTER TABLE bugs
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    to_tsvector('simple', coalesce(description, ''))
+) STORED;
+
+ALTER TABLE improvements
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    to_tsvector('simple', coalesce(description, ''))
+) STORED;
+
+ALTER TABLE causes
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    to_tsvector('simple', coalesce(description, ''))
+) STORED;
+
+ALTER TABLE actions
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    to_tsvector('simple', coalesce(description, ''))
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_projects_search_vector ON projects USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_tasks_search_vector ON tasks USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_bugs_search_vector ON bugs USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_improvements_search_vector ON improvements USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_causes_search_vector ON causes USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_actions_search_vector ON actions USING GIN (search_vector);
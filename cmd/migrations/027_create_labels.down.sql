@@ -0,0 +1,8 @@
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS trg_labels_set_updated_at ON labels;
+
+DROP TABLE IF EXISTS improvement_labels;
+DROP TABLE IF EXISTS bug_labels;
+DROP TABLE IF EXISTS task_labels;
+DROP TABLE IF EXISTS labels;
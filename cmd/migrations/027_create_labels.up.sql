@@ -0,0 +1,47 @@
+-- +migrate Up
+
+-- Project-defined labels; items reference the label row so a rename shows everywhere
+CREATE TABLE IF NOT EXISTS labels (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    color VARCHAR(50),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    UNIQUE(project_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS task_labels (
+    task_id UUID NOT NULL,
+    label_id UUID NOT NULL,
+    PRIMARY KEY (task_id, label_id),
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (label_id) REFERENCES labels(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS bug_labels (
+    bug_id UUID NOT NULL,
+    label_id UUID NOT NULL,
+    PRIMARY KEY (bug_id, label_id),
+    FOREIGN KEY (bug_id) REFERENCES bugs(id) ON DELETE CASCADE,
+    FOREIGN KEY (label_id) REFERENCES labels(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS improvement_labels (
+    improvement_id UUID NOT NULL,
+    label_id UUID NOT NULL,
+    PRIMARY KEY (improvement_id, label_id),
+    FOREIGN KEY (improvement_id) REFERENCES improvements(id) ON DELETE CASCADE,
+    FOREIGN KEY (label_id) REFERENCES labels(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_labels_project_id ON labels (project_id);
+CREATE INDEX IF NOT EXISTS idx_task_labels_label_id ON task_labels (label_id);
+CREATE INDEX IF NOT EXISTS idx_bug_labels_label_id ON bug_labels (label_id);
+CREATE INDEX IF NOT EXISTS idx_improvement_labels_label_id ON improvement_labels (label_id);
+
+CREATE TRIGGER trg_labels_set_updated_at
+BEFORE UPDATE ON labels
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
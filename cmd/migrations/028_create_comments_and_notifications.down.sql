@@ -0,0 +1,7 @@
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS trg_comments_set_updated_at ON comments;
+
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS comment_mentions;
+DROP TABLE IF EXISTS comments;
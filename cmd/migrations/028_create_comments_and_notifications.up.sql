@@ -0,0 +1,62 @@
+-- +migrate Up
+
+-- Threaded comments on tasks, bugs, improvements and actions
+-- Exactly one target column is set; project_id is resolved on insert so member checks need no joins
+CREATE TABLE IF NOT EXISTS comments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    task_id UUID,
+    bug_id UUID,
+    improvement_id UUID,
+    action_id UUID,
+    parent_id UUID,
+    author_id UUID,
+    body TEXT NOT NULL,
+    edited_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (bug_id) REFERENCES bugs(id) ON DELETE CASCADE,
+    FOREIGN KEY (improvement_id) REFERENCES improvements(id) ON DELETE CASCADE,
+    FOREIGN KEY (action_id) REFERENCES actions(id) ON DELETE CASCADE,
+    FOREIGN KEY (parent_id) REFERENCES comments(id) ON DELETE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE SET NULL,
+    CHECK (num_nonnulls(task_id, bug_id, improvement_id, action_id) = 1)
+);
+
+CREATE TABLE IF NOT EXISTS comment_mentions (
+    comment_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    PRIMARY KEY (comment_id, user_id),
+    FOREIGN KEY (comment_id) REFERENCES comments(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    comment_id UUID,
+    actor_id UUID,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (comment_id) REFERENCES comments(id) ON DELETE CASCADE,
+    FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE SET NULL,
+    CHECK (type IN ('Mention'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_comments_task_id ON comments (task_id);
+CREATE INDEX IF NOT EXISTS idx_comments_bug_id ON comments (bug_id);
+CREATE INDEX IF NOT EXISTS idx_comments_improvement_id ON comments (improvement_id);
+CREATE INDEX IF NOT EXISTS idx_comments_action_id ON comments (action_id);
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments (parent_id);
+CREATE INDEX IF NOT EXISTS idx_comment_mentions_user_id ON comment_mentions (user_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id_created_at ON notifications (user_id, created_at DESC, id DESC);
+
+CREATE TRIGGER trg_comments_set_updated_at
+BEFORE UPDATE ON comments
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
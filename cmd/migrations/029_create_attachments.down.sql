@@ -0,0 +1,6 @@
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS trg_attachment_settings_set_updated_at ON attachment_settings;
+
+DROP TABLE IF EXISTS attachment_settings;
+DROP TABLE IF EXISTS attachments;
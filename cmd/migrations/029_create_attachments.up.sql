@@ -0,0 +1,43 @@
+-- +migrate Up
+
+-- Files attached to tasks, bugs and improvements; the content lives in the configured storage under storage_key
+CREATE TABLE IF NOT EXISTS attachments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    task_id UUID,
+    bug_id UUID,
+    improvement_id UUID,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    storage_key VARCHAR(512) NOT NULL UNIQUE,
+    uploaded_by UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (bug_id) REFERENCES bugs(id) ON DELETE CASCADE,
+    FOREIGN KEY (improvement_id) REFERENCES improvements(id) ON DELETE CASCADE,
+    FOREIGN KEY (uploaded_by) REFERENCES users(id) ON DELETE SET NULL,
+    CHECK (num_nonnulls(task_id, bug_id, improvement_id) = 1),
+    CHECK (size_bytes >= 0)
+);
+
+-- Per-project upload limits; projects without a row use the defaults of the application
+CREATE TABLE IF NOT EXISTS attachment_settings (
+    project_id UUID PRIMARY KEY,
+    max_size_bytes BIGINT NOT NULL,
+    allowed_types TEXT[] NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    CHECK (max_size_bytes > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_task_id ON attachments (task_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_bug_id ON attachments (bug_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_improvement_id ON attachments (improvement_id);
+
+CREATE TRIGGER trg_attachment_settings_set_updated_at
+BEFORE UPDATE ON attachment_settings
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
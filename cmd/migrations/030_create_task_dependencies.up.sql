@@ -0,0 +1,14 @@
+-- +migrate Up
+
+-- blocker_id must be finished before blocked_id can start; tasks may sit in different iterations of a project
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    blocker_id UUID NOT NULL,
+    blocked_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (blocker_id, blocked_id),
+    FOREIGN KEY (blocker_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (blocked_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_blocked_id ON task_dependencies (blocked_id);
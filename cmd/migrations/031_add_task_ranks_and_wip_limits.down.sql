@@ -0,0 +1,8 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS project_wip_limits;
+
+DROP INDEX IF EXISTS idx_tasks_board_rank;
+
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS rank;
@@ -0,0 +1,36 @@
+-- +migrate Up
+
+-- Position of a task within its board column (iteration, parent and status)
+-- Ranks are compared byte by byte, so a task moves by taking a rank between its new neighbours
+ALTER TABLE tasks
+ADD COLUMN rank TEXT COLLATE "C";
+
+-- Existing columns keep their creation order
+UPDATE tasks t
+SET rank = ranked.rank
+FROM (
+    SELECT id, LPAD(ROW_NUMBER() OVER (
+        PARTITION BY iteration_id, parent_task_id, status
+        ORDER BY created_at ASC, id ASC
+    )::text, 10, '0') || 'V' AS rank
+    FROM tasks
+) ranked
+WHERE t.id = ranked.id;
+
+ALTER TABLE tasks
+ALTER COLUMN rank SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_board_rank ON tasks (iteration_id, status, rank);
+
+-- Most tasks allowed in a status column of an iteration board
+-- A status without a row has no limit
+CREATE TABLE IF NOT EXISTS project_wip_limits (
+    project_id UUID NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    max_tasks INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (project_id, status),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    CHECK (status IN ('NotStarted', 'InProgress', 'Completed')),
+    CHECK (max_tasks > 0)
+);
@@ -0,0 +1,16 @@
+-- +migrate Down
+
+-- Backlog tasks have nowhere to go once every task needs an iteration again
+DELETE FROM tasks WHERE iteration_id IS NULL;
+
+DROP INDEX IF EXISTS idx_tasks_backlog_rank;
+DROP INDEX IF EXISTS idx_tasks_project_id;
+
+ALTER TABLE tasks
+ALTER COLUMN iteration_id SET NOT NULL;
+
+ALTER TABLE tasks
+DROP CONSTRAINT IF EXISTS tasks_project_id_fkey;
+
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS project_id;
@@ -0,0 +1,23 @@
+-- +migrate Up
+
+-- Tasks belong to a project; those without an iteration form the project backlog
+ALTER TABLE tasks
+ADD COLUMN project_id UUID;
+
+UPDATE tasks t
+SET project_id = i.project_id
+FROM iterations i
+WHERE t.iteration_id = i.id;
+
+ALTER TABLE tasks
+ALTER COLUMN project_id SET NOT NULL;
+
+ALTER TABLE tasks
+ADD CONSTRAINT tasks_project_id_fkey
+FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE;
+
+ALTER TABLE tasks
+ALTER COLUMN iteration_id DROP NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_project_id ON tasks (project_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_backlog_rank ON tasks (project_id, rank) WHERE iteration_id IS NULL;
@@ -0,0 +1,14 @@
+-- +migrate Up
+
+-- Time a member can give to an iteration: hours on each working day, minus the days off
+CREATE TABLE IF NOT EXISTS iteration_member_availability (
+    iteration_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    hours_per_day DOUBLE PRECISION NOT NULL,
+    days_off DATE[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (iteration_id, user_id),
+    FOREIGN KEY (iteration_id) REFERENCES iterations(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CHECK (hours_per_day >= 0 AND hours_per_day <= 24)
+);
@@ -0,0 +1,4 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS project_holidays;
+DROP TABLE IF EXISTS project_calendars;
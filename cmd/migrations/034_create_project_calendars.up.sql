@@ -0,0 +1,23 @@
+-- +migrate Up
+
+-- Working calendar of a project; a project without a row works Monday to Friday in UTC
+-- working_days holds weekdays from 0 (Sunday) to 6 (Saturday)
+CREATE TABLE IF NOT EXISTS project_calendars (
+    project_id UUID PRIMARY KEY,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    working_days SMALLINT[] NOT NULL DEFAULT '{1,2,3,4,5}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    CHECK (cardinality(working_days) > 0 AND working_days <@ '{0,1,2,3,4,5,6}'::SMALLINT[])
+);
+
+-- Days off for the whole project
+CREATE TABLE IF NOT EXISTS project_holidays (
+    project_id UUID NOT NULL,
+    date DATE NOT NULL,
+    name TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (project_id, date),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
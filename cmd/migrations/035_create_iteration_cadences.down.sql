@@ -0,0 +1,3 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS iteration_cadences;
@@ -0,0 +1,21 @@
+-- +migrate Up
+
+-- Fixed cadence iterations of a project are generated with; length_days counts working days of the
+-- project calendar, gap_days calendar days left between two iterations
+-- ahead is how many upcoming iterations the scheduler keeps created, 0 to generate only on request
+CREATE TABLE IF NOT EXISTS iteration_cadences (
+    project_id UUID PRIMARY KEY,
+    length_days INTEGER NOT NULL,
+    start_weekday SMALLINT NOT NULL,
+    gap_days INTEGER NOT NULL DEFAULT 0,
+    ahead INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    CHECK (length_days > 0),
+    CHECK (start_weekday BETWEEN 0 AND 6),
+    CHECK (gap_days >= 0),
+    CHECK (ahead >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_iteration_cadences_ahead ON iteration_cadences (project_id) WHERE ahead > 0;
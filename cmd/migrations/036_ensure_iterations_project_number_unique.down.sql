@@ -0,0 +1,3 @@
+-- +migrate Down
+
+-- Nothing to undo: the index may be the one backing the constraint declared in 007
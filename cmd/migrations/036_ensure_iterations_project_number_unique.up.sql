@@ -0,0 +1,18 @@
+-- +migrate Up
+
+-- 007 declares UNIQUE(project_id, number), but databases created before it was in place can lack it
+-- and hold duplicate numbers. Projects with duplicates are renumbered by start date first so the index
+-- can be built; projects without any keep their numbers
+UPDATE iterations i
+SET number = r.number
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY project_id ORDER BY start_at ASC, created_at ASC, id ASC) AS number
+    FROM iterations
+    WHERE project_id IN (
+        SELECT project_id FROM iterations GROUP BY project_id, number HAVING COUNT(*) > 1
+    )
+) r
+WHERE i.id = r.id AND i.number <> r.number;
+
+-- The name matches the index backing the 007 constraint so nothing is added where it already exists
+CREATE UNIQUE INDEX IF NOT EXISTS iterations_project_id_number_key ON iterations (project_id, number);
@@ -24,6 +24,128 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/attachments/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download the content of an attached file",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Download an attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Attachment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File content",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid attachment ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can see or upload files",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Attachment not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to download file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an attached file. The uploader, project owners and maintainers can delete it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Delete an attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Attachment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Attachment deleted"
+                    },
+                    "400": {
+                        "description": "Invalid attachment ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the uploader, owners and maintainers can delete a file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Attachment not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/auth/login": {
             "post": {
                 "description": "Authenticate user and return session token",
@@ -167,7 +289,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all bugs for a specific task",
+                "description": "Get the bugs of a task, filtered by assignee, labels, points or dates",
                 "consumes": [
                     "application/json"
                 ],
@@ -186,6 +308,54 @@ const docTemplate = `{
                         "name": "task_id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated assignee IDs",
+                        "name": "assignee_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated label names; bugs with any of them",
+                        "name": "label",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated label IDs; bugs with any of them",
+                        "name": "label_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Points; points_gte, points_lte, points_gt and points_lt are also accepted",
+                        "name": "points",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after; also created_at_lte, updated_at_gte, ...",
+                        "name": "created_at_gte",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "number",
+                            "points",
+                            "created_at",
+                            "updated_at"
+                        ],
+                        "type": "string",
+                        "description": "Comma separated fields, descending with a - prefix, e.g. -points; by number by default",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search in description",
+                        "name": "q",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -199,7 +369,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid task_id",
+                        "description": "Invalid task_id, unknown filter field or invalid value",
                         "schema": {
                             "type": "string"
                         }
@@ -313,14 +483,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/improvements": {
+        "/bugs/{id}/attachments": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all improvements for a specific task",
+                "description": "Get the metadata of the files attached to a bug, oldest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -328,37 +498,55 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "improvements"
+                    "attachments"
                 ],
-                "summary": "Get all improvements",
+                "summary": "Get bug attachments",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Task ID",
-                        "name": "task_id",
-                        "in": "query",
+                        "description": "Bug ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of improvements",
+                        "description": "Attachments",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Improv"
+                                "$ref": "#/definitions/models.Attachment"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid task_id",
+                        "description": "Invalid bug ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can see or upload files",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Bug not found",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to retrieve improvements",
+                        "description": "Failed to retrieve attachments",
                         "schema": {
                             "type": "string"
                         }
@@ -371,44 +559,73 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new improvement for a task",
+                "description": "Upload a file, such as a screenshot or a log, as multipart/form-data in the file field. Size and media type are checked against the project limits",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "improvements"
+                    "attachments"
                 ],
-                "summary": "Create a new improvement",
+                "summary": "Attach a file to a bug",
                 "parameters": [
                     {
-                        "description": "Improvement data",
-                        "name": "improvement",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateImprovRequest"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Bug ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File to attach",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Improvement created successfully",
+                        "description": "Uploaded attachment",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.Attachment"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid bug ID, form or file name",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can see or upload files",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Bug not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "File is larger than the project allows",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "415": {
+                        "description": "File type is not allowed in this project",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to create improvement",
+                        "description": "Failed to upload file",
                         "schema": {
                             "type": "string"
                         }
@@ -416,14 +633,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/improvements/{id}": {
+        "/bugs/{id}/comments": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a specific improvement by its ID",
+                "description": "Get the comments of a bug as threads, oldest first. Replies are nested under their parent",
                 "consumes": [
                     "application/json"
                 ],
@@ -431,14 +648,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "improvements"
+                    "comments"
                 ],
-                "summary": "Get improvement by ID",
+                "summary": "Get bug comments",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Improvement ID",
+                        "description": "Bug ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -446,34 +663,47 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Improvement details",
+                        "description": "Comment threads",
                         "schema": {
-                            "$ref": "#/definitions/models.Improv"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Comment"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid improvement ID",
+                        "description": "Invalid bug ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can read comments",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Improvement not found",
+                        "description": "Bug not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve comments",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            }
-        },
-        "/indicators": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get indicator with causes, actions, and calculated productivity levels for a specific iteration",
+                "description": "Add a markdown comment, or a reply when parent_id is set. @email or @user-id mentions of project members notify them, as do @name mentions (the part of the email before the @) when only one member has that name",
                 "consumes": [
                     "application/json"
                 ],
@@ -481,47 +711,70 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "indicators"
+                    "comments"
                 ],
-                "summary": "Get indicator",
+                "summary": "Comment on a bug",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Iteration ID",
-                        "name": "iteration_id",
-                        "in": "query",
+                        "description": "Bug ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Comment data",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateCommentRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Indicator details",
+                    "201": {
+                        "description": "Created comment",
                         "schema": {
-                            "$ref": "#/definitions/models.Indicator"
+                            "$ref": "#/definitions/models.Comment"
                         }
                     },
                     "400": {
-                        "description": "Invalid iteration_id",
+                        "description": "Invalid bug ID, request body or parent comment",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can comment",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Indicator not found",
+                        "description": "Bug not found",
                         "schema": {
                             "type": "string"
                         }
-                    }
-                }
-            },
-            "post": {
+                    },
+                    "500": {
+                        "description": "Failed to create comment",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/bugs/{id}/labels": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new indicator for an iteration",
+                "description": "Replace the labels of a bug. Labels must belong to the bug's project; an empty list removes all labels",
                 "consumes": [
                     "application/json"
                 ],
@@ -529,36 +782,64 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "indicators"
+                    "labels"
                 ],
-                "summary": "Create a new indicator",
+                "summary": "Set bug labels",
                 "parameters": [
                     {
-                        "description": "Indicator data",
-                        "name": "indicator",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Bug ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Label IDs",
+                        "name": "labels",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateIndicatorRequest"
+                            "$ref": "#/definitions/handlers.SetLabelsRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Indicator created successfully",
+                    "204": {
+                        "description": "Labels updated"
+                    },
+                    "400": {
+                        "description": "Invalid bug ID or request body",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can label items",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Bug not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Label does not belong to the project of the item",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to create indicator",
+                        "description": "Failed to update labels",
                         "schema": {
                             "type": "string"
                         }
@@ -566,14 +847,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/indicators/actions": {
-            "post": {
+        "/comments/{id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new action for an indicator",
+                "description": "Replace the body of a comment. Only the author can edit; newly mentioned members are notified",
                 "consumes": [
                     "application/json"
                 ],
@@ -581,51 +862,68 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "indicators"
+                    "comments"
                 ],
-                "summary": "Create a new action",
+                "summary": "Edit a comment",
                 "parameters": [
                     {
-                        "description": "Action data",
-                        "name": "action",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment data",
+                        "name": "comment",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateActionRequest"
+                            "$ref": "#/definitions/handlers.UpdateCommentRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Action created successfully",
+                    "200": {
+                        "description": "Updated comment",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.Comment"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid comment ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the author can change a comment",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Comment not found",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to create action",
+                        "description": "Failed to update comment",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            }
-        },
-        "/indicators/actions/{id}": {
-            "patch": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Partially update an existing action (only provided fields will be updated)",
+                "description": "Delete a comment. Only the author can delete; replies stay in the thread under a deleted placeholder",
                 "consumes": [
                     "application/json"
                 ],
@@ -633,49 +931,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "indicators"
+                    "comments"
                 ],
-                "summary": "Partially update action",
+                "summary": "Delete a comment",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Action ID",
+                        "description": "Comment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Partial action data",
-                        "name": "action",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.PatchActionRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Updated action",
+                    "204": {
+                        "description": "Comment deleted"
+                    },
+                    "400": {
+                        "description": "Invalid comment ID",
                         "schema": {
-                            "$ref": "#/definitions/models.Action"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Invalid action ID or request body",
+                    "403": {
+                        "description": "Only the author can change a comment",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Action not found",
+                        "description": "Comment not found",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to update action",
+                        "description": "Failed to delete comment",
                         "schema": {
                             "type": "string"
                         }
@@ -683,14 +975,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/indicators/causes": {
-            "post": {
+        "/improvements": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new cause for an indicator",
+                "description": "Get the improvements of a task, filtered by assignee, labels, points or dates",
                 "consumes": [
                     "application/json"
                 ],
@@ -698,51 +990,98 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "indicators"
+                    "improvements"
                 ],
-                "summary": "Create a new cause",
+                "summary": "Get all improvements",
                 "parameters": [
                     {
-                        "description": "Cause data",
-                        "name": "cause",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateCauseRequest"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated assignee IDs",
+                        "name": "assignee_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated label names; improvements with any of them",
+                        "name": "label",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated label IDs; improvements with any of them",
+                        "name": "label_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Points; points_gte, points_lte, points_gt and points_lt are also accepted",
+                        "name": "points",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after; also created_at_lte, updated_at_gte, ...",
+                        "name": "created_at_gte",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "number",
+                            "points",
+                            "created_at",
+                            "updated_at"
+                        ],
+                        "type": "string",
+                        "description": "Comma separated fields, descending with a - prefix, e.g. -points; by number by default",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search in description",
+                        "name": "q",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Cause created successfully",
+                    "200": {
+                        "description": "List of improvements",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Improv"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid task_id, unknown filter field or invalid value",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to create cause",
+                        "description": "Failed to retrieve improvements",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            }
-        },
-        "/indicators/ranges": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create or update the productivity range (OK, Alert, Critical min/max values) for a specific indicator type at project level",
+                "description": "Create a new improvement for a task",
                 "consumes": [
                     "application/json"
                 ],
@@ -750,23 +1089,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "indicators"
+                    "improvements"
                 ],
-                "summary": "Set productivity range for an indicator type",
+                "summary": "Create a new improvement",
                 "parameters": [
                     {
-                        "description": "Range configuration",
-                        "name": "range",
+                        "description": "Improvement data",
+                        "name": "improvement",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.SetRangeRequest"
+                            "$ref": "#/definitions/handlers.CreateImprovRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Range set successfully",
+                        "description": "Improvement created successfully",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -779,7 +1118,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "Failed to set range",
+                        "description": "Failed to create improvement",
                         "schema": {
                             "type": "string"
                         }
@@ -787,14 +1126,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/indicators/ranges/{range_id}": {
-            "delete": {
+        "/improvements/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove a productivity range configuration",
+                "description": "Get a specific improvement by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -802,31 +1141,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "indicators"
+                    "improvements"
                 ],
-                "summary": "Delete a productivity range",
+                "summary": "Get improvement by ID",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Range ID",
-                        "name": "range_id",
+                        "description": "Improvement ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Range deleted successfully"
+                    "200": {
+                        "description": "Improvement details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Improv"
+                        }
                     },
                     "400": {
-                        "description": "Invalid range_id",
+                        "description": "Invalid improvement ID",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Failed to delete range",
+                    "404": {
+                        "description": "Improvement not found",
                         "schema": {
                             "type": "string"
                         }
@@ -834,14 +1176,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/indicators/{indicator_id}/metrics": {
-            "put": {
+        "/improvements/{id}/attachments": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update the calculated values for speed, rework, and instability metrics",
+                "description": "Get the metadata of the files attached to an improvement, oldest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -849,96 +1191,134 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "indicators"
+                    "attachments"
                 ],
-                "summary": "Update calculated metric values",
+                "summary": "Get improvement attachments",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Indicator ID",
-                        "name": "indicator_id",
+                        "description": "Improvement ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Metric values",
-                        "name": "metrics",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdateMetricValuesRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated indicator",
+                        "description": "Attachments",
                         "schema": {
-                            "$ref": "#/definitions/models.Indicator"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Attachment"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Invalid improvement ID",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Failed to update metrics",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "string"
                         }
-                    }
-                }
-            }
-        },
-        "/indicators/{indicator_id}/summary": {
-            "get": {
+                    },
+                    "403": {
+                        "description": "Only project members can see or upload files",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Improvement not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve attachments",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a summary of all indicators with their values and productivity levels",
+                "description": "Upload a file as multipart/form-data in the file field. Size and media type are checked against the project limits",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "indicators"
+                    "attachments"
                 ],
-                "summary": "Get metric summary",
+                "summary": "Attach a file to an improvement",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Indicator ID",
-                        "name": "indicator_id",
+                        "description": "Improvement ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File to attach",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Metric summary",
+                    "201": {
+                        "description": "Uploaded attachment",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.IndicatorMetricValue"
-                            }
+                            "$ref": "#/definitions/models.Attachment"
                         }
                     },
                     "400": {
-                        "description": "Invalid indicator_id",
+                        "description": "Invalid improvement ID, form or file name",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can see or upload files",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Indicator not found",
+                        "description": "Improvement not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "File is larger than the project allows",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "415": {
+                        "description": "File type is not allowed in this project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to upload file",
                         "schema": {
                             "type": "string"
                         }
@@ -946,14 +1326,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/iterations": {
+        "/improvements/{id}/comments": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all iterations for a specific project",
+                "description": "Get the comments of an improvement as threads, oldest first. Replies are nested under their parent",
                 "consumes": [
                     "application/json"
                 ],
@@ -961,37 +1341,49 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "iterations"
+                    "comments"
                 ],
-                "summary": "Get all iterations",
+                "summary": "Get improvement comments",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Project ID",
-                        "name": "project_id",
-                        "in": "query",
+                        "description": "Improvement ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of iterations",
+                        "description": "Comment threads",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Iteration"
+                                "$ref": "#/definitions/models.Comment"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid project_id",
+                        "description": "Invalid improvement ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can read comments",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Improvement not found",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to retrieve iterations",
+                        "description": "Failed to retrieve comments",
                         "schema": {
                             "type": "string"
                         }
@@ -1004,7 +1396,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new iteration for a project",
+                "description": "Add a markdown comment, or a reply when parent_id is set. @email or @user-id mentions of project members notify them, as do @name mentions (the part of the email before the @) when only one member has that name",
                 "consumes": [
                     "application/json"
                 ],
@@ -1012,36 +1404,55 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "iterations"
+                    "comments"
                 ],
-                "summary": "Create a new iteration",
+                "summary": "Comment on an improvement",
                 "parameters": [
                     {
-                        "description": "Iteration data",
-                        "name": "iteration",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Improvement ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment data",
+                        "name": "comment",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateIterationRequest"
+                            "$ref": "#/definitions/handlers.CreateCommentRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Iteration created successfully",
+                        "description": "Created comment",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.Comment"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid improvement ID, request body or parent comment",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can comment",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Improvement not found",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to create iteration",
+                        "description": "Failed to create comment",
                         "schema": {
                             "type": "string"
                         }
@@ -1049,14 +1460,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/iterations/{id}": {
-            "get": {
+        "/improvements/{id}/labels": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a specific iteration by its ID",
+                "description": "Replace the labels of an improvement. Labels must belong to the improvement's project; an empty list removes all labels",
                 "consumes": [
                     "application/json"
                 ],
@@ -1064,47 +1475,79 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "iterations"
+                    "labels"
                 ],
-                "summary": "Get iteration by ID",
+                "summary": "Set improvement labels",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Iteration ID",
+                        "description": "Improvement ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Label IDs",
+                        "name": "labels",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetLabelsRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Iteration details",
+                    "204": {
+                        "description": "Labels updated"
+                    },
+                    "400": {
+                        "description": "Invalid improvement ID or request body",
                         "schema": {
-                            "$ref": "#/definitions/models.Iteration"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Invalid iteration ID",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can label items",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Iteration not found",
+                        "description": "Improvement not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Label does not belong to the project of the item",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update labels",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/indicators": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an iteration by its ID",
+                "description": "Get indicator with causes, actions, and calculated productivity levels for a specific iteration",
                 "consumes": [
                     "application/json"
                 ],
@@ -1112,46 +1555,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "iterations"
+                    "indicators"
                 ],
-                "summary": "Delete iteration",
+                "summary": "Get indicator",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
                         "description": "Iteration ID",
-                        "name": "id",
-                        "in": "path",
+                        "name": "iteration_id",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Iteration deleted successfully"
+                    "200": {
+                        "description": "Indicator details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Indicator"
+                        }
                     },
                     "400": {
-                        "description": "Invalid iteration ID",
+                        "description": "Invalid iteration_id",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Failed to delete iteration",
+                    "404": {
+                        "description": "Indicator not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            }
-        },
-        "/iterations/{id}/analysis": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get detailed analysis of iteration indicators with data points for graphing",
+                "description": "Create a new indicator for an iteration",
                 "consumes": [
                     "application/json"
                 ],
@@ -1159,40 +1603,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "iterations"
+                    "indicators"
                 ],
-                "summary": "Get iteration indicator analysis",
+                "summary": "Create a new indicator",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Iteration ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Indicator data",
+                        "name": "indicator",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateIndicatorRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Iteration analysis with indicator data points",
+                    "201": {
+                        "description": "Indicator created successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.IterationAnalysisResponse"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Invalid iteration ID",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "404": {
-                        "description": "Iteration not found",
+                        "description": "Invalid request body",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to retrieve analysis",
+                        "description": "Failed to create indicator",
                         "schema": {
                             "type": "string"
                         }
@@ -1200,14 +1640,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/iterations/{iteration_id}/causes-actions": {
-            "get": {
+        "/indicators/actions": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves all causes and actions associated with a specific iteration",
+                "description": "Create a new action for an indicator",
                 "consumes": [
                     "application/json"
                 ],
@@ -1217,32 +1657,34 @@ const docTemplate = `{
                 "tags": [
                     "indicators"
                 ],
-                "summary": "Get all causes and actions for an iteration",
+                "summary": "Create a new action",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Iteration ID",
-                        "name": "iteration_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Action data",
+                        "name": "action",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateActionRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Causes and actions retrieved successfully",
+                    "201": {
+                        "description": "Action created successfully",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Invalid iteration ID",
+                        "description": "Invalid request body",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to retrieve causes and actions",
+                        "description": "Failed to create action",
                         "schema": {
                             "type": "string"
                         }
@@ -1250,14 +1692,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects": {
-            "get": {
+        "/indicators/actions/{id}": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a paginated list of all projects with their members",
+                "description": "Partially update an existing action (only provided fields will be updated)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1265,49 +1707,64 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "indicators"
                 ],
-                "summary": "Get all projects",
+                "summary": "Partially update action",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Action ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "maximum": 100,
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Page size",
-                        "name": "page_size",
-                        "in": "query"
+                        "description": "Partial action data",
+                        "name": "action",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PatchActionRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Projects with pagination",
+                        "description": "Updated action",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.Action"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid action ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Action not found",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Failed to update action",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/indicators/actions/{id}/comments": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new project with members and optional custom indicator ranges. If indicator_ranges is not provided, default ranges will be created.",
+                "description": "Get the comments of an action as threads, oldest first. Replies are nested under their parent",
                 "consumes": [
                     "application/json"
                 ],
@@ -1315,51 +1772,62 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "comments"
                 ],
-                "summary": "Create a new project",
+                "summary": "Get action comments",
                 "parameters": [
                     {
-                        "description": "Project data",
-                        "name": "project",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateProjectRequest"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Action ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created project",
+                    "200": {
+                        "description": "Comment threads",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Comment"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid action ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can read comments",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Action not found",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to create project",
+                        "description": "Failed to retrieve comments",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            }
-        },
-        "/projects/member/{userId}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all projects where the specified user is a member",
+                "description": "Add a markdown comment, or a reply when parent_id is set. @email or @user-id mentions of project members notify them, as do @name mentions (the part of the email before the @) when only one member has that name",
                 "consumes": [
                     "application/json"
                 ],
@@ -1367,50 +1835,55 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "comments"
                 ],
-                "summary": "Get projects by member ID",
+                "summary": "Comment on an action",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "User ID",
-                        "name": "userId",
+                        "description": "Action ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 100,
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Page size",
-                        "name": "page_size",
-                        "in": "query"
+                        "description": "Comment data",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateCommentRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Projects with pagination",
+                    "201": {
+                        "description": "Created comment",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.Comment"
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID",
+                        "description": "Invalid action ID, request body or parent comment",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can comment",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Action not found",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Failed to create comment",
                         "schema": {
                             "type": "string"
                         }
@@ -1418,14 +1891,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{id}": {
-            "get": {
+        "/indicators/causes": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a specific project by its ID with all members",
+                "description": "Create a new cause for an indicator",
                 "consumes": [
                     "application/json"
                 ],
@@ -1433,47 +1906,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "indicators"
                 ],
-                "summary": "Get project by ID",
+                "summary": "Create a new cause",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Project ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Cause data",
+                        "name": "cause",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateCauseRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Project details",
+                    "201": {
+                        "description": "Cause created successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.Project"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Invalid project ID",
+                        "description": "Invalid request body",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Project not found",
+                    "500": {
+                        "description": "Failed to create cause",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/indicators/ranges": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing project with new data",
+                "description": "Create or update the productivity range (OK, Alert, Critical min/max values) for a specific indicator type at project level",
                 "consumes": [
                     "application/json"
                 ],
@@ -1481,56 +1958,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "indicators"
                 ],
-                "summary": "Update project",
+                "summary": "Set productivity range for an indicator type",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Project ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated project data",
-                        "name": "project",
+                        "description": "Range configuration",
+                        "name": "range",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.UpdateProjectRequest"
+                            "$ref": "#/definitions/handlers.SetRangeRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Updated project",
+                    "201": {
+                        "description": "Range set successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.Project"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Invalid project ID or request body",
+                        "description": "Invalid request body",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to update project",
+                        "description": "Failed to set range",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/indicators/ranges/{range_id}": {
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a project by its ID",
+                "description": "Remove a productivity range configuration",
                 "consumes": [
                     "application/json"
                 ],
@@ -1538,31 +2010,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "indicators"
                 ],
-                "summary": "Delete project",
+                "summary": "Delete a productivity range",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Project ID",
-                        "name": "id",
+                        "description": "Range ID",
+                        "name": "range_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "Project deleted successfully"
+                        "description": "Range deleted successfully"
                     },
                     "400": {
-                        "description": "Invalid project ID",
+                        "description": "Invalid range_id",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to delete project",
+                        "description": "Failed to delete range",
                         "schema": {
                             "type": "string"
                         }
@@ -1570,14 +2042,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{project_id}/indicator-range-ids/{indicator_type}": {
-            "get": {
+        "/indicators/{indicator_id}/metrics": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the indicator range ID based on project ID and indicator type",
+                "description": "Update the calculated values for speed, rework, and instability metrics",
                 "consumes": [
                     "application/json"
                 ],
@@ -1587,40 +2059,41 @@ const docTemplate = `{
                 "tags": [
                     "indicators"
                 ],
-                "summary": "Get indicator range ID by project ID and indicator type",
+                "summary": "Update calculated metric values",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Project ID",
-                        "name": "project_id",
+                        "description": "Indicator ID",
+                        "name": "indicator_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Indicator type (SpeedPerIteration, ReworkPerIteration, InstabilityIndex)",
-                        "name": "indicator_type",
-                        "in": "path",
-                        "required": true
+                        "description": "Metric values",
+                        "name": "metrics",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateMetricValuesRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Indicator range ID",
+                        "description": "Updated indicator",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.Indicator"
                         }
                     },
                     "400": {
-                        "description": "Invalid parameters",
+                        "description": "Invalid request",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Indicator range not found",
+                    "500": {
+                        "description": "Failed to update metrics",
                         "schema": {
                             "type": "string"
                         }
@@ -1628,14 +2101,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{project_id}/indicator-ranges": {
+        "/indicators/{indicator_id}/summary": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all productivity ranges configured for a project",
+                "description": "Get a summary of all indicators with their values and productivity levels",
                 "consumes": [
                     "application/json"
                 ],
@@ -1645,35 +2118,35 @@ const docTemplate = `{
                 "tags": [
                     "indicators"
                 ],
-                "summary": "Get all indicator ranges for a project",
+                "summary": "Get metric summary",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Project ID",
-                        "name": "project_id",
+                        "description": "Indicator ID",
+                        "name": "indicator_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of ranges",
+                        "description": "Metric summary",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.IndicatorRange"
+                                "$ref": "#/definitions/models.IndicatorMetricValue"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid project_id",
+                        "description": "Invalid indicator_id",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Failed to get ranges",
+                    "404": {
+                        "description": "Indicator not found",
                         "schema": {
                             "type": "string"
                         }
@@ -1681,14 +2154,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{project_id}/indicator-ranges/default": {
-            "post": {
+        "/iterations": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create default productivity ranges for all indicator types for a project",
+                "description": "Get all iterations for a specific project",
                 "consumes": [
                     "application/json"
                 ],
@@ -1696,25 +2169,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "indicators"
+                    "iterations"
                 ],
-                "summary": "Create default indicator ranges for a project",
+                "summary": "Get all iterations",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
                         "description": "Project ID",
                         "name": "project_id",
-                        "in": "path",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Default ranges created",
+                    "200": {
+                        "description": "List of iterations",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Iteration"
+                            }
                         }
                     },
                     "400": {
@@ -1724,22 +2199,20 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "Failed to create default ranges",
+                        "description": "Failed to retrieve iterations",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            }
-        },
-        "/projects/{project_id}/indicator-ranges/{indicator_type}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get the productivity range for a specific indicator type of a project",
+                "description": "Create a new iteration for a project, numbered after the last one. Iterations must end after they start and may not overlap; errors come as JSON with a code (invalid_date_range, overlapping_iteration) and the conflicting iterations",
                 "consumes": [
                     "application/json"
                 ],
@@ -1747,41 +2220,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "indicators"
+                    "iterations"
                 ],
-                "summary": "Get range for a specific indicator type",
+                "summary": "Create a new iteration",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Project ID",
-                        "name": "project_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Indicator type (SpeedPerIteration, ReworkPerIteration, InstabilityIndex)",
-                        "name": "indicator_type",
-                        "in": "path",
-                        "required": true
+                        "description": "Iteration data",
+                        "name": "iteration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateIterationRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Range configuration",
+                    "201": {
+                        "description": "Iteration created successfully",
                         "schema": {
-                            "$ref": "#/definitions/models.IndicatorRange"
+                            "$ref": "#/definitions/models.Iteration"
                         }
                     },
                     "400": {
-                        "description": "Invalid parameters",
+                        "description": "Invalid request body",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Range not found",
+                        "description": "Project not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Overlapping iterations",
+                        "schema": {
+                            "$ref": "#/definitions/models.IterationError"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid date range",
+                        "schema": {
+                            "$ref": "#/definitions/models.IterationError"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create iteration",
                         "schema": {
                             "type": "string"
                         }
@@ -1789,102 +2274,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks": {
+        "/iterations/duplicate-numbers": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all tasks for a specific iteration",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List the numbers held by more than one iteration of the same project, with those iterations; every project the caller owns or maintains unless project_id is given",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "iterations"
                 ],
-                "summary": "Get all tasks",
+                "summary": "Report duplicate iteration numbers",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Iteration ID",
-                        "name": "iteration_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of tasks",
+                        "description": "Duplicate numbers",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Task"
+                                "$ref": "#/definitions/models.DuplicateIterationNumber"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid iteration_id",
+                        "description": "Invalid project_id",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Failed to retrieve tasks",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "string"
                         }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Create a new task for an iteration",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "tasks"
-                ],
-                "summary": "Create a new task",
-                "parameters": [
-                    {
-                        "description": "Task data",
-                        "name": "task",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateTaskRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Task created successfully",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "403": {
+                        "description": "Only project owners and maintainers can report duplicate numbers",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to create task",
+                        "description": "Failed to detect duplicate numbers",
                         "schema": {
                             "type": "string"
                         }
@@ -1892,14 +2335,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks/{id}": {
+        "/iterations/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a specific task by its ID",
+                "description": "Get a specific iteration by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1907,14 +2350,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "iterations"
                 ],
-                "summary": "Get task by ID",
+                "summary": "Get iteration by ID",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Iteration ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1922,32 +2365,32 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Task details",
+                        "description": "Iteration details",
                         "schema": {
-                            "$ref": "#/definitions/models.Task"
+                            "$ref": "#/definitions/models.Iteration"
                         }
                     },
                     "400": {
-                        "description": "Invalid task ID",
+                        "description": "Invalid iteration ID",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Task not found",
+                        "description": "Iteration not found",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing task",
+                "description": "Delete an iteration by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1955,56 +2398,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "iterations"
                 ],
-                "summary": "Update task",
+                "summary": "Delete iteration",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Iteration ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Updated task data",
-                        "name": "task",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdateTaskRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Updated task",
-                        "schema": {
-                            "$ref": "#/definitions/models.Task"
-                        }
+                    "204": {
+                        "description": "Iteration deleted successfully"
                     },
                     "400": {
-                        "description": "Invalid task ID or request body",
+                        "description": "Invalid iteration ID",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to update task",
+                        "description": "Failed to delete iteration",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/iterations/{id}/analysis": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a task by its ID",
+                "description": "Get detailed analysis of iteration indicators with data points for graphing",
                 "consumes": [
                     "application/json"
                 ],
@@ -2012,44 +2445,67 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "iterations"
                 ],
-                "summary": "Delete task",
+                "summary": "Get iteration indicator analysis",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Iteration ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Set to assignee for a per-member breakdown (owners and maintainers only) or to label for a per-label breakdown",
+                        "name": "group_by",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Task deleted successfully"
+                    "200": {
+                        "description": "Per-label analysis when group_by=label",
+                        "schema": {
+                            "$ref": "#/definitions/models.LabelAnalysisResponse"
+                        }
                     },
                     "400": {
-                        "description": "Invalid task ID",
+                        "description": "Invalid iteration ID or group_by",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project owners and maintainers can see the per-member analysis",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Iteration not found",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to delete task",
+                        "description": "Failed to retrieve analysis",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/iterations/{id}/availability": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Partially update an existing task (only provided fields will be updated)",
+                "description": "Get the hours per day and days off recorded for the members of an iteration",
                 "consumes": [
                     "application/json"
                 ],
@@ -2057,64 +2513,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "iterations"
                 ],
-                "summary": "Partially update task",
+                "summary": "Get member availability",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Iteration ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Partial task data",
-                        "name": "task",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.PatchTaskRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated task",
+                        "description": "Availability per member",
                         "schema": {
-                            "$ref": "#/definitions/models.Task"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MemberAvailability"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid task ID or request body",
+                        "description": "Invalid iteration ID",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Task not found",
+                        "description": "Iteration not found",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to update task",
+                        "description": "Failed to retrieve availability",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            }
-        },
-        "/users": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a paginated list of all users",
+                "description": "Replace the hours per day and days off of the iteration members; members left out have no capacity",
                 "consumes": [
                     "application/json"
                 ],
@@ -2122,44 +2570,85 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "iterations"
                 ],
-                "summary": "Get all users",
+                "summary": "Replace member availability",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Iteration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "maximum": 100,
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Page size",
-                        "name": "page_size",
-                        "in": "query"
+                        "description": "Availability per member",
+                        "name": "availability",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetAvailabilityRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Users with pagination",
+                        "description": "Saved availability",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MemberAvailability"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid iteration ID, request body or date",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project owners and maintainers can change availability",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Iteration not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Member listed twice or outside the project, hours out of range or day off outside the iteration",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Failed to update availability",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new user",
+            }
+        },
+        "/iterations/{id}/burndown": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the daily remaining points and hours, the ideal line and the burnup series with scope changes",
                 "consumes": [
                     "application/json"
                 ],
@@ -2167,36 +2656,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "iterations"
                 ],
-                "summary": "Create a new user",
+                "summary": "Get iteration burndown and burnup",
                 "parameters": [
                     {
-                        "description": "User data",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateUserRequest"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Iteration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created user",
+                    "200": {
+                        "description": "Iteration burndown and burnup series",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.BurndownResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid iteration ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Iteration not found",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to create user",
+                        "description": "Failed to retrieve burndown",
                         "schema": {
                             "type": "string"
                         }
@@ -2204,14 +2697,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/project/{projectId}": {
+        "/iterations/{id}/capacity": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all users who are members of the specified project",
+                "description": "Compare the expected time and points of the planned tasks with the capacity of each member and of the team. Capacity counts the weekdays of the iteration minus days off; hours become points at the actual speed of the last 3 iterations closed before this one. Overcommitted members and teams are listed in warnings",
                 "consumes": [
                     "application/json"
                 ],
@@ -2219,50 +2712,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "iterations"
                 ],
-                "summary": "Get users by project ID",
+                "summary": "Get iteration capacity",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Project ID",
-                        "name": "projectId",
+                        "description": "Iteration ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 100,
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Page size",
-                        "name": "page_size",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Users with pagination",
+                        "description": "Capacity against planned work",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.IterationCapacity"
                         }
                     },
                     "400": {
-                        "description": "Invalid project ID",
+                        "description": "Invalid iteration ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Iteration not found",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Failed to retrieve capacity",
                         "schema": {
                             "type": "string"
                         }
@@ -2270,9 +2753,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
+        "/iterations/{id}/cfd": {
             "get": {
-                "description": "Get a specific user by its ID",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the number of tasks and points in each status for every day of the iteration",
                 "consumes": [
                     "application/json"
                 ],
@@ -2280,14 +2768,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "iterations"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Get iteration cumulative flow diagram",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "User ID",
+                        "description": "Iteration ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2295,79 +2783,93 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "User details",
+                        "description": "Cumulative flow series per status",
                         "schema": {
-                            "$ref": "#/definitions/models.User"
+                            "$ref": "#/definitions/models.CumulativeFlowResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID",
+                        "description": "Invalid iteration ID",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Iteration not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve cumulative flow",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update an existing user",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/iterations/{id}/dependency-graph": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Get every task of the iteration as a node and every dependency touching them as an edge from blocker to blocked. Tasks of other iterations at the far end of an edge are flagged external",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "tasks"
                 ],
-                "summary": "Update user",
+                "summary": "Get the dependency graph of an iteration",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "User ID",
+                        "description": "Iteration ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Updated user data",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdateUserRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated user",
+                        "description": "Dependency graph",
                         "schema": {
-                            "$ref": "#/definitions/models.User"
+                            "$ref": "#/definitions/models.DependencyGraph"
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID or request body",
+                        "description": "Invalid iteration ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Iteration not found",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Failed to update user",
+                        "description": "Failed to retrieve dependency graph",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a user by its ID",
+            }
+        },
+        "/iterations/{id}/plan": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move up to 200 backlog tasks of the iteration project into the iteration, with their sub-tasks, each at the bottom of its status column. Each task passes the same checks as a bulk move; a failing task is reported and stays in the backlog",
                 "consumes": [
                     "application/json"
                 ],
@@ -2375,280 +2877,6667 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "tasks"
                 ],
-                "summary": "Delete user",
+                "summary": "Pull backlog tasks into an iteration",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "User ID",
+                        "description": "Iteration ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Backlog tasks to plan",
+                        "name": "plan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PlanIterationRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Plan tasks even if they have unfinished blockers",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "User deleted successfully"
+                    "200": {
+                        "description": "Outcome per task",
+                        "schema": {
+                            "$ref": "#/definitions/models.TaskBulkResponse"
+                        }
                     },
                     "400": {
-                        "description": "Invalid user ID",
+                        "description": "Invalid iteration ID or request body",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Failed to delete user",
+                    "404": {
+                        "description": "Iteration not found",
                         "schema": {
                             "type": "string"
                         }
-                    }
-                }
-            }
-        }
+                    },
+                    "422": {
+                        "description": "Iteration closed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to plan iteration",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/iterations/{id}/retrospective": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the retrospective of an iteration with its columns, items, votes and the iteration analysis",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "retrospectives"
+                ],
+                "summary": "Get iteration retrospective",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Iteration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Retrospective details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Retrospective"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid iteration ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Retrospective not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve retrospective",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Open the retrospective of an iteration. Columns default to \"Went well\", \"To improve\" and \"Ideas\".",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "retrospectives"
+                ],
+                "summary": "Create iteration retrospective",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Iteration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Retrospective configuration",
+                        "name": "retrospective",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateRetrospectiveRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Retrospective created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.Retrospective"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not a member of the project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Iteration not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Retrospective already exists",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create retrospective",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/iterations/{iteration_id}/causes-actions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves all causes and actions associated with a specific iteration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "indicators"
+                ],
+                "summary": "Get all causes and actions for an iteration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Iteration ID",
+                        "name": "iteration_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Causes and actions retrieved successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid iteration ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve causes and actions",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the notifications of the authenticated user, newest first, with the number still unread",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Get my notifications",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only unread notifications",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the next_cursor of the previous page of the same list",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notifications with pagination and unread count",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid cursor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve notifications",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/read-all": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark every unread notification of the authenticated user as read",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Mark all notifications as read",
+                "responses": {
+                    "200": {
+                        "description": "Number of notifications marked as read",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update notifications",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark one notification of the authenticated user as read",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Mark a notification as read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Notification marked as read"
+                    },
+                    "400": {
+                        "description": "Invalid notification ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Notification not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update notification",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a paginated list of all projects with their members",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get all projects",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from next_cursor of the previous page of the same list; takes precedence over page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Exact project name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated member IDs",
+                        "name": "member_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after; also created_at_lte, updated_at_gte, ...",
+                        "name": "created_at_gte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields, descending with a - prefix, e.g. -updated_at; cannot be combined with cursor",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search in name and description",
+                        "name": "q",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Projects with pagination",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid cursor, unknown filter field or invalid value",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new project with members and optional custom indicator ranges. If indicator_ranges is not provided, default ranges will be created.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Create a new project",
+                "parameters": [
+                    {
+                        "description": "Project data",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateProjectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created project",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/member/{userId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all projects where the specified user is a member",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get projects by member ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from next_cursor of the previous page of the same list; takes precedence over page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Projects with pagination",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific project by its ID with all members",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get project by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Project"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing project with new data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Update project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated project data",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateProjectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated project",
+                        "schema": {
+                            "$ref": "#/definitions/models.Project"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a project by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Delete project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Project deleted successfully"
+                    },
+                    "400": {
+                        "description": "Invalid project ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/attachment-settings": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the maximum file size and the allowed media types of a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Get project upload limits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Upload limits",
+                        "schema": {
+                            "$ref": "#/definitions/models.AttachmentSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve upload limits",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the maximum file size (up to 100 MB) and the allowed media types, such as image/* or application/pdf. Owners and maintainers only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Set project upload limits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Upload limits",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AttachmentSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Upload limits",
+                        "schema": {
+                            "$ref": "#/definitions/models.AttachmentSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID, request body or limits",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project owners and maintainers can change upload limits",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update upload limits",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/backlog": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the top-level tasks of the project that are not planned in any iteration, in priority order unless sorted otherwise. Backlog tasks are left out of every iteration indicator and report",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get project backlog",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated statuses, e.g. NotStarted,InProgress",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated assignee IDs",
+                        "name": "assignee_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated label names; tasks with any of them",
+                        "name": "label",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated label IDs; tasks with any of them",
+                        "name": "label_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Points; points_gte, points_lte, points_gt and points_lt are also accepted",
+                        "name": "points",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after; also created_at_lte, updated_at_gte, ...",
+                        "name": "created_at_gte",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "status",
+                            "points",
+                            "name",
+                            "rank",
+                            "created_at",
+                            "updated_at"
+                        ],
+                        "type": "string",
+                        "description": "Comma separated fields, descending with a - prefix; priority (rank) by default",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search in name and description",
+                        "name": "q",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Backlog tasks",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Task"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID, unknown filter field or invalid value",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve backlog",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a task outside any iteration, at the bottom of the backlog priority order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Add a task to the project backlog",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task data",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateBacklogTaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Task created successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unknown status",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create task",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/calendar": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the timezone, working weekdays and holidays used by every duration in days of the project; projects that never set one work Monday to Friday in UTC",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get project calendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project calendar",
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkingCalendar"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve calendar",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the timezone, working weekdays (0 is Sunday) and holidays of a project; burndown, speed, capacity and forecasts count only its working days",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Replace project calendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Project calendar",
+                        "name": "calendar",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetCalendarRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project calendar",
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkingCalendar"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project owners and maintainers can change the calendar",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid timezone, working days or holidays",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update calendar",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/calendar/holidays/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add the events of an iCalendar (.ics) file to the project holidays, each day of an event becoming a holiday named after its summary; holidays already on those dates are renamed. Recurring events are imported for their first date only",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Import holidays",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "iCalendar file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project calendar",
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkingCalendar"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID, multipart form or missing file field",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project owners and maintainers can change the calendar",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid iCalendar file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to import holidays",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/dashboard": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get everything the project home page shows in one response: current iteration status, latest classification of each indicator, open and overdue actions, bug and improvement totals and recent activity",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get project dashboard",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project dashboard",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProjectDashboard"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not a member of the project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to load dashboard",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/estimate-accuracy": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the estimate error (MAPE and bias) of each iteration of a project, ordered by iteration number",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get estimate accuracy trend",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Estimate accuracy per iteration",
+                        "schema": {
+                            "$ref": "#/definitions/models.EstimateAccuracyTrend"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve estimate accuracy",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/forecast": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Simulate when a backlog will be done, or how much will be done by a target date, by sampling the delivery of closed iterations. Results are given at 50, 85 and 95% confidence; send the returned seed to reproduce them. Up to 10000 simulations of at most 200 iterations each are run",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Forecast delivery with Monte Carlo simulation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Forecast parameters",
+                        "name": "forecast",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ForecastRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Forecast",
+                        "schema": {
+                            "$ref": "#/definitions/models.ForecastResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid forecast parameters or no closed iterations",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to run forecast",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/iteration-cadence": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the cadence the iterations of a project are generated with",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "iterations"
+                ],
+                "summary": "Get iteration cadence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Iteration cadence",
+                        "schema": {
+                            "$ref": "#/definitions/models.IterationCadence"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Project has no iteration cadence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve iteration cadence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the cadence of a project: iterations start on start_weekday, gap_days after the previous one ends, and last length_days working days of the project calendar, so holidays push their end. With ahead above 0 the upcoming iterations missing are created now and kept created by a background job; the cadence is saved even when they cannot all be created, and generation_error tells why",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "iterations"
+                ],
+                "summary": "Set iteration cadence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Iteration cadence",
+                        "name": "cadence",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetCadenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Iteration cadence with the iterations generated",
+                        "schema": {
+                            "$ref": "#/definitions/models.CadenceUpdate"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project owners and maintainers can change the iteration cadence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid cadence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update iteration cadence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop generating iterations for a project; iterations already created stay",
+                "tags": [
+                    "iterations"
+                ],
+                "summary": "Delete iteration cadence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Iteration cadence deleted"
+                    },
+                    "400": {
+                        "description": "Invalid project ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project owners and maintainers can change the iteration cadence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Project has no iteration cadence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete iteration cadence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/iterations/generate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create the next count iterations (1 to 26) of the project following its cadence, after its latest iteration and never before tomorrow. Stops at the first iteration that overlaps one added by hand",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "iterations"
+                ],
+                "summary": "Generate iterations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Number of iterations",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GenerateIterationsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Iterations created",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Iteration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project owners and maintainers can generate iterations",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Project has no iteration cadence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Generated iteration overlaps another one",
+                        "schema": {
+                            "$ref": "#/definitions/models.IterationError"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid count or no working days left",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to generate iterations",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/iterations/next": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create an iteration after the latest one of the project with the same cadence: same weekday and time of day, first week after the latest one ends, same length in days of the project timezone",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "iterations"
+                ],
+                "summary": "Create the next iteration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Iteration description",
+                        "name": "iteration",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateNextIterationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Iteration created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.Iteration"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Overlapping iterations",
+                        "schema": {
+                            "$ref": "#/definitions/models.IterationError"
+                        }
+                    },
+                    "422": {
+                        "description": "Project has no iteration yet",
+                        "schema": {
+                            "$ref": "#/definitions/models.IterationError"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create iteration",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/labels": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the labels defined in a project, sorted by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "labels"
+                ],
+                "summary": "Get project labels",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project labels",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Label"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can see labels",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve labels",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a label in a project. Names are unique within the project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "labels"
+                ],
+                "summary": "Create a label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Label data",
+                        "name": "label",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LabelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created label",
+                        "schema": {
+                            "$ref": "#/definitions/models.Label"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID, request body or name",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project owners and maintainers can manage labels",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "A label with this name already exists in the project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create label",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/labels/{labelId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rename or recolor a label. Tasks, bugs and improvements reference the label, so the change shows everywhere",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "labels"
+                ],
+                "summary": "Update a label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Label ID",
+                        "name": "labelId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Label data",
+                        "name": "label",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LabelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated label",
+                        "schema": {
+                            "$ref": "#/definitions/models.Label"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID, request body or name",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project owners and maintainers can manage labels",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Label not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "A label with this name already exists in the project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update label",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a label and detach it from every task, bug and improvement",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "labels"
+                ],
+                "summary": "Delete a label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Label ID",
+                        "name": "labelId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Label deleted"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project owners and maintainers can manage labels",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Label not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete label",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/members/{userId}/role": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the role (owner, maintainer or member) of a project member. Only owners can change roles and the last owner cannot be demoted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Change the role of a project member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New role",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetMemberRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Role updated"
+                    },
+                    "400": {
+                        "description": "Invalid ID, request body or role",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only owners can change roles",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "User is not a member of the project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "A project must keep at least one owner",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update role",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/status-transitions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the status transitions allowed in a project; an empty list means every transition is allowed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get allowed status transitions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Allowed transitions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.StatusTransition"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve status transitions",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the status transitions allowed in a project; send an empty list to allow every transition",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Replace allowed status transitions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Allowed transitions",
+                        "name": "transitions",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetStatusTransitionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Allowed transitions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.StatusTransition"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only owners and maintainers may change the workflow",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid transition",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update status transitions",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/throughput": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the number of tasks and points completed per day or week across the iterations of a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get project throughput",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket size: day (default) or week",
+                        "name": "interval",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Throughput series",
+                        "schema": {
+                            "$ref": "#/definitions/models.ThroughputResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID or interval",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve throughput",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/wip-limits": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the most tasks each status column of an iteration board may hold; statuses left out have no limit",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get WIP limits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "WIP limits",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WIPLimit"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve WIP limits",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the WIP limits of a project; send an empty list to remove every limit. Columns already above a new limit keep their tasks but accept no more",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Replace WIP limits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "WIP limits",
+                        "name": "limits",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetWIPLimitsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "WIP limits",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WIPLimit"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project owners and maintainers can change WIP limits",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid limit",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update WIP limits",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/indicator-range-ids/{indicator_type}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the indicator range ID based on project ID and indicator type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "indicators"
+                ],
+                "summary": "Get indicator range ID by project ID and indicator type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Indicator type (SpeedPerIteration, ReworkPerIteration, InstabilityIndex, LeadTime, CycleTime, EstimateAccuracy)",
+                        "name": "indicator_type",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Indicator range ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Indicator range not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/indicator-ranges": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all productivity ranges configured for a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "indicators"
+                ],
+                "summary": "Get all indicator ranges for a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of ranges",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.IndicatorRange"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project_id",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get ranges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/indicator-ranges/default": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create default productivity ranges for all indicator types for a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "indicators"
+                ],
+                "summary": "Create default indicator ranges for a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Default ranges created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project_id",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create default ranges",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/indicator-ranges/{indicator_type}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the productivity range for a specific indicator type of a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "indicators"
+                ],
+                "summary": "Get range for a specific indicator type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Indicator type (SpeedPerIteration, ReworkPerIteration, InstabilityIndex, LeadTime, CycleTime, EstimateAccuracy)",
+                        "name": "indicator_type",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Range configuration",
+                        "schema": {
+                            "$ref": "#/definitions/models.IndicatorRange"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Range not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/retrospectives/items": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add an item to a retrospective column. The author is the authenticated user, who must be a project member.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "retrospectives"
+                ],
+                "summary": "Add retrospective item",
+                "parameters": [
+                    {
+                        "description": "Item data",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateRetrospectiveItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Item created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.RetrospectiveItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not a member of the project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Column not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create item",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/retrospectives/items/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an item. Only its author may delete it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "retrospectives"
+                ],
+                "summary": "Delete retrospective item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Item deleted successfully"
+                    },
+                    "400": {
+                        "description": "Invalid item ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the author can change this item",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Item not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete item",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/retrospectives/items/{id}/promote": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Promote an item into a cause, or into an action (with its cause), on an indicator range of the iteration project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "retrospectives"
+                ],
+                "summary": "Promote retrospective item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Promotion data",
+                        "name": "promotion",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PromoteRetrospectiveItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Promoted item",
+                        "schema": {
+                            "$ref": "#/definitions/models.RetrospectiveItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not a member of the project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Item or indicator range not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Item has already been promoted",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to promote item",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/retrospectives/items/{id}/votes": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Place one dot on an item. Each member has a limited number of dots per retrospective.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "retrospectives"
+                ],
+                "summary": "Vote for retrospective item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item with updated votes",
+                        "schema": {
+                            "$ref": "#/definitions/models.RetrospectiveItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid item ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not a member of the project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Item not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "No votes left",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to vote",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Take back one of the authenticated user's dots from an item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "retrospectives"
+                ],
+                "summary": "Remove vote from retrospective item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item with updated votes",
+                        "schema": {
+                            "$ref": "#/definitions/models.RetrospectiveItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid item ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Vote not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to remove vote",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/retrospectives/{id}/columns": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Append a new column to a retrospective",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "retrospectives"
+                ],
+                "summary": "Add retrospective column",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Retrospective ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Column data",
+                        "name": "column",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateRetrospectiveColumnRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Column created successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not a member of the project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Retrospective not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create column",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Search project names and descriptions, tasks, bugs, improvements, causes and actions of the projects the user is a member of. Hits are ranked and carry an HTML-escaped fragment with the matched terms in \u003cmark\u003e tags. The query accepts web search syntax: quoted phrases, OR and -excluded words",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Full-text search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search terms",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "project",
+                            "task",
+                            "bug",
+                            "improvement",
+                            "cause",
+                            "action"
+                        ],
+                        "type": "string",
+                        "description": "Comma separated hit types",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Only search this project",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 50,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of hits",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Search hits",
+                        "schema": {
+                            "$ref": "#/definitions/models.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing q, invalid type, project_id or limit",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to search",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all tasks for a specific iteration, optionally filtered, sorted and searched",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get all tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Iteration ID",
+                        "name": "iteration_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated statuses, e.g. InProgress,Completed",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated assignee IDs",
+                        "name": "assignee_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated label names; tasks with any of them",
+                        "name": "label",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated label IDs; tasks with any of them",
+                        "name": "label_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Points; points_gte, points_lte, points_gt and points_lt are also accepted",
+                        "name": "points",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after; also created_at_lte, updated_at_gte, ...",
+                        "name": "created_at_gte",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "status",
+                            "points",
+                            "name",
+                            "rank",
+                            "created_at",
+                            "updated_at"
+                        ],
+                        "type": "string",
+                        "description": "Comma separated fields, descending with a - prefix, e.g. -updated_at; board order (rank) by default",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search in name and description",
+                        "name": "q",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of tasks",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Task"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid iteration_id, unknown filter field or invalid value",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve tasks",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new task for an iteration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Create a new task",
+                "parameters": [
+                    {
+                        "description": "Task data",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateTaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Task created successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Iteration not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "The status column is at its WIP limit",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unknown status",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create task",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/bulk": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Patch status, assignee (clear_assignee unassigns), points or expected time, move to another iteration of the project, or delete up to 200 tasks in one transaction. Each task passes the same checks as a single change; a failing task is reported and left out without undoing the others. Sub-tasks move with their parent",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Apply an operation to several tasks",
+                "parameters": [
+                    {
+                        "description": "Tasks and operation",
+                        "name": "bulk",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.BulkTaskRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Start tasks even if they have unfinished blockers",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Outcome per task",
+                        "schema": {
+                            "$ref": "#/definitions/models.TaskBulkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or operation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Iteration not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unknown status or target iteration closed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to run bulk operation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific task by its ID. include=comments adds the threaded comments and include=attachments the attached files",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get task by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated relations to load: comments, attachments",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Task"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID or include",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can read comments",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve task",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing task; an empty status keeps the current one. Moving it to InProgress while a blocking task is unfinished needs force=true",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Update task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated task data",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateTaskRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Start the task even if it has unfinished blockers",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated task",
+                        "schema": {
+                            "$ref": "#/definitions/models.Task"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Task has unfinished blockers or the column is at its WIP limit",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unknown status or transition not allowed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update task",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a task by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Delete task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Task deleted successfully"
+                    },
+                    "400": {
+                        "description": "Invalid task ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete task",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Partially update an existing task (only provided fields will be updated). Moving it to InProgress while a blocking task is unfinished needs force=true",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Partially update task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Partial task data",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PatchTaskRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Start the task even if it has unfinished blockers",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated task",
+                        "schema": {
+                            "$ref": "#/definitions/models.Task"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Task has unfinished blockers or the column is at its WIP limit",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unknown status or transition not allowed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update task",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/attachments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the metadata of the files attached to a task, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Get task attachments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attachments",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Attachment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can see or upload files",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve attachments",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a file as multipart/form-data in the file field. Size and media type are checked against the project limits",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Attach a file to a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File to attach",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Uploaded attachment",
+                        "schema": {
+                            "$ref": "#/definitions/models.Attachment"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID, form or file name",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can see or upload files",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "File is larger than the project allows",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "415": {
+                        "description": "File type is not allowed in this project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to upload file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/comments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the comments of a task as threads, oldest first. Replies are nested under their parent",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Get task comments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comment threads",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Comment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can read comments",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve comments",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a markdown comment, or a reply when parent_id is set. @email or @user-id mentions of project members notify them, as do @name mentions (the part of the email before the @) when only one member has that name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Comment on a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment data",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created comment",
+                        "schema": {
+                            "$ref": "#/definitions/models.Comment"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID, request body or parent comment",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can comment",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create comment",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/dependencies": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Declare that the task is blocked by another task, or that it blocks another task. Both must be in the same project, in any iteration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Add a task dependency",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The other task, as blocked_by or blocks",
+                        "name": "dependency",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddDependencyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task with its dependencies",
+                        "schema": {
+                            "$ref": "#/definitions/models.Task"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can change dependencies",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Dependency would create a cycle",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Task depends on itself or on a task of another project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to add dependency",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/dependencies/{otherId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the dependency between two tasks, whichever of them is the blocker",
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Remove a task dependency",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID of the other task",
+                        "name": "otherId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Dependency removed"
+                    },
+                    "400": {
+                        "description": "Invalid task ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can change dependencies",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Dependency not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to remove dependency",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/labels": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the labels of a task. Labels must belong to the task's project; an empty list removes all labels",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "labels"
+                ],
+                "summary": "Set task labels",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Label IDs",
+                        "name": "labels",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetLabelsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Labels updated"
+                    },
+                    "400": {
+                        "description": "Invalid task ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Only project members can label items",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Label does not belong to the project of the item",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update labels",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/move": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the iteration, the status and the position of a task within its column in one step. after_id and before_id are tasks of the target column; with neither the task goes to the bottom. A task moved to another open iteration of the same project takes its sub-tasks, bugs, improvements, timer, comments and attachments along; sub-tasks only move with their parent. backlog=true sends the task back to the project backlog, which keeps a single priority order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Move task on the board or to another iteration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target column and neighbours",
+                        "name": "move",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.MoveTaskRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Start the task even if it has unfinished blockers",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Moved task",
+                        "schema": {
+                            "$ref": "#/definitions/models.Task"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Task or iteration not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Task has unfinished blockers or the column is at its WIP limit",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unknown status, transition not allowed, neighbour outside the column, sub-task, or iteration closed or of another project",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to move task",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/status-history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get every status change of a task with the user who made it, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get task status history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status changes",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.TaskStatusChange"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve status history",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a paginated list of all users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get all users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from next_cursor of the previous page of the same list; takes precedence over page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Exact email",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after; also created_at_lte, created_at_gt, created_at_lt",
+                        "name": "created_at_gte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields, descending with a - prefix, e.g. name; cannot be combined with cursor",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search in name and email",
+                        "name": "q",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Users with pagination",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid cursor, unknown filter field or invalid value",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "User data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created user",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create user",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/project/{projectId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all users who are members of the specified project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get users by project ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from next_cursor of the previous page of the same list; takes precedence over page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Users with pagination",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid project ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "description": "Get a specific user by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User details",
+                        "schema": {
+                            "$ref": "#/definitions/models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated user data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated user",
+                        "schema": {
+                            "$ref": "#/definitions/models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or request body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update user",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a user by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "User deleted successfully"
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete user",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
     },
     "definitions": {
+        "handlers.AddDependencyRequest": {
+            "type": "object",
+            "properties": {
+                "blocked_by": {
+                    "description": "The other task must finish before this one starts",
+                    "type": "string"
+                },
+                "blocks": {
+                    "description": "This task must finish before the other one starts",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.AttachmentSettingsRequest": {
+            "type": "object",
+            "properties": {
+                "allowed_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "max_size_bytes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.BulkTaskPatchRequest": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "clear_assignee": {
+                    "description": "Unassign the tasks; not allowed with assignee_id",
+                    "type": "boolean"
+                },
+                "expected_time": {
+                    "type": "number"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.BulkTaskRequest": {
+            "type": "object",
+            "properties": {
+                "iteration_id": {
+                    "description": "Required by move_to_iteration",
+                    "type": "string"
+                },
+                "operation": {
+                    "type": "string",
+                    "enum": [
+                        "patch",
+                        "move_to_iteration",
+                        "delete"
+                    ]
+                },
+                "patch": {
+                    "description": "Required by patch",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handlers.BulkTaskPatchRequest"
+                        }
+                    ]
+                },
+                "task_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "handlers.CreateActionRequest": {
             "type": "object",
             "properties": {
-                "assignee_id": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "cause_description": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "indicator_range_id": {
+                    "type": "string"
+                },
+                "metric": {
+                    "type": "string"
+                },
+                "start_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateBacklogTaskRequest": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "expected_time": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "timer": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateBugRequest": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "task_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateCauseRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "indicator_range_id": {
+                    "type": "string"
+                },
+                "metric": {
+                    "type": "string"
+                },
+                "productivity_level": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateCommentRequest": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateImprovRequest": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "task_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateIndicatorRequest": {
+            "type": "object",
+            "properties": {
+                "iteration_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateIterationRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "start_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateNextIterationRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateProjectRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "indicator_ranges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.IndicatorRangeRequest"
+                    }
+                },
+                "member_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateRetrospectiveColumnRequest": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateRetrospectiveItemRequest": {
+            "type": "object",
+            "properties": {
+                "column_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateRetrospectiveRequest": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "votes_per_member": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.CreateTaskRequest": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "expected_time": {
+                    "type": "number"
+                },
+                "iteration_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "timer": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateUserRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ForecastRequest": {
+            "type": "object",
+            "properties": {
+                "backlog_size": {
+                    "type": "number"
+                },
+                "mode": {
+                    "description": "points (default) or tasks",
+                    "type": "string"
+                },
+                "sample_window": {
+                    "description": "last N closed iterations, 0 for all",
+                    "type": "integer"
+                },
+                "seed": {
+                    "type": "integer"
+                },
+                "simulations": {
+                    "description": "default 5000, at most 10000",
+                    "type": "integer"
+                },
+                "target_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.GenerateIterationsRequest": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "handlers.IndicatorRangeRequest": {
+            "type": "object",
+            "properties": {
+                "indicator_type": {
+                    "type": "string"
+                },
+                "range": {
+                    "$ref": "#/definitions/handlers.ProductivityRangeRequest"
+                }
+            }
+        },
+        "handlers.LabelRequest": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "type": "object",
+                    "properties": {
+                        "email": {
+                            "type": "string"
+                        },
+                        "id": {
+                            "type": "string"
+                        },
+                        "name": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "handlers.MemberAvailabilityRequest": {
+            "type": "object",
+            "properties": {
+                "days_off": {
+                    "description": "Dates within the iteration, e.g. 2024-05-13",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "hours_per_day": {
+                    "type": "number"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.MoveTaskRequest": {
+            "type": "object",
+            "properties": {
+                "after_id": {
+                    "description": "Task right above the new position",
+                    "type": "string"
+                },
+                "backlog": {
+                    "description": "Send the task back to the project backlog",
+                    "type": "boolean"
+                },
+                "before_id": {
+                    "description": "Task right below the new position",
+                    "type": "string"
+                },
+                "iteration_id": {
+                    "description": "Defaults to the current iteration",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Defaults to the current status",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.PatchActionRequest": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "start_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.PatchTaskRequest": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "expected_time": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "timer": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.PlanIterationRequest": {
+            "type": "object",
+            "properties": {
+                "task_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.ProductivityRangeRequest": {
+            "type": "object",
+            "properties": {
+                "alert": {
+                    "$ref": "#/definitions/handlers.RangeValuesRequest"
+                },
+                "critical": {
+                    "$ref": "#/definitions/handlers.RangeValuesRequest"
+                },
+                "ok": {
+                    "$ref": "#/definitions/handlers.RangeValuesRequest"
+                }
+            }
+        },
+        "handlers.PromoteRetrospectiveItemRequest": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "cause_description": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "indicator_range_id": {
+                    "type": "string"
+                },
+                "metric": {
+                    "type": "string"
+                },
+                "productivity_level": {
+                    "type": "string"
+                },
+                "start_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "cause or action",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.RangeValuesRequest": {
+            "type": "object",
+            "properties": {
+                "max": {
+                    "type": "number"
+                },
+                "min": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                }
+            }
+        },
+        "handlers.SetAvailabilityRequest": {
+            "type": "object",
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.MemberAvailabilityRequest"
+                    }
+                }
+            }
+        },
+        "handlers.SetCadenceRequest": {
+            "type": "object",
+            "properties": {
+                "ahead": {
+                    "description": "Upcoming iterations kept created, 0 for none",
+                    "type": "integer",
+                    "example": 2
+                },
+                "gap_days": {
+                    "description": "Days left between two iterations",
+                    "type": "integer",
+                    "example": 0
+                },
+                "length_days": {
+                    "description": "Working days of each iteration",
+                    "type": "integer",
+                    "example": 10
+                },
+                "start_weekday": {
+                    "description": "0 is Sunday",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "handlers.SetCalendarRequest": {
+            "type": "object",
+            "properties": {
+                "holidays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Holiday"
+                    }
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "America/Sao_Paulo"
+                },
+                "working_days": {
+                    "description": "0 is Sunday",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2,
+                        3,
+                        4,
+                        5
+                    ]
+                }
+            }
+        },
+        "handlers.SetLabelsRequest": {
+            "type": "object",
+            "properties": {
+                "label_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.SetMemberRoleRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "description": "owner, maintainer or member",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.SetRangeRequest": {
+            "type": "object",
+            "properties": {
+                "indicator_type": {
+                    "description": "SpeedPerIteration, ReworkPerIteration, InstabilityIndex, LeadTime, CycleTime, EstimateAccuracy",
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "range": {
+                    "$ref": "#/definitions/handlers.ProductivityRangeRequest"
+                }
+            }
+        },
+        "handlers.SetStatusTransitionsRequest": {
+            "type": "object",
+            "properties": {
+                "transitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StatusTransition"
+                    }
+                }
+            }
+        },
+        "handlers.SetWIPLimitsRequest": {
+            "type": "object",
+            "properties": {
+                "limits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WIPLimit"
+                    }
+                }
+            }
+        },
+        "handlers.UpdateCommentRequest": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateMetricValuesRequest": {
+            "type": "object",
+            "properties": {
+                "instability_value": {
+                    "type": "number"
+                },
+                "rework_value": {
+                    "type": "number"
+                },
+                "speed_value": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.UpdateProjectRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "member_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateTaskRequest": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "expected_time": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "timer": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateUserRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Action": {
+            "type": "object",
+            "properties": {
+                "assignee": {
+                    "$ref": "#/definitions/models.User"
+                },
+                "cause": {
+                    "$ref": "#/definitions/models.Cause"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "indicator_range_id": {
+                    "type": "string"
+                },
+                "start_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.StatusEnum"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ActivityEntry": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "$ref": "#/definitions/models.Member"
+                },
+                "at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "$ref": "#/definitions/models.StatusEnum"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "$ref": "#/definitions/models.StatusEnum"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Attachment": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "size_bytes": {
+                    "type": "integer"
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "$ref": "#/definitions/models.AttachmentTargetType"
+                },
+                "uploaded_by": {
+                    "description": "Nil once the uploader account is deleted",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Member"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.AttachmentSettings": {
+            "type": "object",
+            "properties": {
+                "allowed_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "max_size_bytes": {
+                    "type": "integer"
+                },
+                "project_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AttachmentTargetType": {
+            "type": "string",
+            "enum": [
+                "task",
+                "bug",
+                "improvement"
+            ],
+            "x-enum-varnames": [
+                "AttachmentOnTask",
+                "AttachmentOnBug",
+                "AttachmentOnImprovement"
+            ]
+        },
+        "models.AxisDefinition": {
+            "type": "object",
+            "properties": {
+                "label": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Bug": {
+            "type": "object",
+            "properties": {
+                "assignee": {
+                    "$ref": "#/definitions/models.User"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Label"
+                    }
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BurndownResponse": {
+            "type": "object",
+            "properties": {
+                "burndown": {
+                    "$ref": "#/definitions/models.BurndownSeries"
+                },
+                "burnup": {
+                    "$ref": "#/definitions/models.BurnupSeries"
+                },
+                "endAt": {
+                    "type": "string"
+                },
+                "iterationId": {
+                    "type": "string"
+                },
+                "startAt": {
+                    "type": "string"
+                },
+                "xAxis": {
+                    "$ref": "#/definitions/models.AxisDefinition"
+                }
+            }
+        },
+        "models.BurndownSeries": {
+            "type": "object",
+            "properties": {
+                "idealHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataPoint"
+                    }
+                },
+                "idealPoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataPoint"
+                    }
+                },
+                "remainingHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataPoint"
+                    }
+                },
+                "remainingPoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataPoint"
+                    }
+                },
+                "yAxis": {
+                    "$ref": "#/definitions/models.AxisDefinition"
+                }
+            }
+        },
+        "models.BurnupSeries": {
+            "type": "object",
+            "properties": {
+                "completedPoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataPoint"
+                    }
+                },
+                "scopePoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataPoint"
+                    }
+                },
+                "yAxis": {
+                    "$ref": "#/definitions/models.AxisDefinition"
+                }
+            }
+        },
+        "models.CadenceUpdate": {
+            "type": "object",
+            "properties": {
+                "ahead": {
+                    "description": "0 generates only on request",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "gap_days": {
+                    "type": "integer"
+                },
+                "generated": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Iteration"
+                    }
+                },
+                "generation_error": {
+                    "$ref": "#/definitions/models.IterationError"
+                },
+                "length_days": {
+                    "type": "integer"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "start_weekday": {
+                    "description": "0 is Sunday",
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CapacityPlanned": {
+            "type": "object",
+            "properties": {
+                "plannedHours": {
+                    "type": "number"
+                },
+                "plannedPoints": {
+                    "type": "integer"
+                },
+                "tasks": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CapacitySummary": {
+            "type": "object",
+            "properties": {
+                "capacityHours": {
+                    "type": "number"
+                },
+                "capacityPoints": {
+                    "type": "number"
+                },
+                "overcommitted": {
+                    "type": "boolean"
+                },
+                "plannedHours": {
+                    "type": "number"
+                },
+                "plannedPoints": {
+                    "type": "integer"
+                },
+                "tasks": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CapacityWarning": {
+            "type": "object",
+            "properties": {
+                "memberId": {
+                    "description": "Nil for the whole team",
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Cause": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "indicator_range_id": {
+                    "type": "string"
+                },
+                "metric": {
+                    "$ref": "#/definitions/models.MetricEnum"
+                },
+                "productivity_level": {
+                    "$ref": "#/definitions/models.ProductivityEnum"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Comment": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "description": "Nil once the author account is deleted",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Member"
+                        }
+                    ]
+                },
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted": {
+                    "type": "boolean"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Member"
+                    }
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "replies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Comment"
+                    }
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "$ref": "#/definitions/models.CommentTargetType"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CommentTargetType": {
+            "type": "string",
+            "enum": [
+                "task",
+                "bug",
+                "improvement",
+                "action"
+            ],
+            "x-enum-varnames": [
+                "CommentOnTask",
+                "CommentOnBug",
+                "CommentOnImprovement",
+                "CommentOnAction"
+            ]
+        },
+        "models.CumulativeFlowResponse": {
+            "type": "object",
+            "properties": {
+                "iterationId": {
                     "type": "string"
                 },
-                "cause_description": {
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StatusSeries"
+                    }
+                },
+                "xAxis": {
+                    "$ref": "#/definitions/models.AxisDefinition"
+                },
+                "yAxis": {
+                    "$ref": "#/definitions/models.AxisDefinition"
+                }
+            }
+        },
+        "models.DashboardAction": {
+            "type": "object",
+            "properties": {
+                "assignee": {
+                    "$ref": "#/definitions/models.Member"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "indicator_type": {
+                    "$ref": "#/definitions/models.IndicatorEnum"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.StatusEnum"
+                }
+            }
+        },
+        "models.DashboardActions": {
+            "type": "object",
+            "properties": {
+                "open": {
+                    "type": "integer"
+                },
+                "overdue": {
+                    "type": "integer"
+                },
+                "overdue_actions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DashboardAction"
+                    }
+                }
+            }
+        },
+        "models.DashboardCount": {
+            "type": "object",
+            "properties": {
+                "current_iteration": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DashboardIndicator": {
+            "type": "object",
+            "properties": {
+                "indicator_type": {
+                    "$ref": "#/definitions/models.IndicatorEnum"
+                },
+                "iteration_id": {
+                    "type": "string"
+                },
+                "iteration_number": {
+                    "type": "integer"
+                },
+                "productivity_level": {
+                    "$ref": "#/definitions/models.ProductivityEnum"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.DashboardIteration": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "integer"
+                },
+                "completed_points": {
+                    "type": "integer"
+                },
+                "days_left": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "in_progress": {
+                    "type": "integer"
+                },
+                "not_started": {
+                    "type": "integer"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "start_at": {
+                    "type": "string"
+                },
+                "total_points": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DashboardProject": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "iterations_count": {
+                    "type": "integer"
+                },
+                "members_count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DataPoint": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "$ref": "#/definitions/models.ProductivityEnum"
+                },
+                "x": {},
+                "y": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.DependencyEdge": {
+            "type": "object",
+            "properties": {
+                "blocked_id": {
+                    "type": "string"
+                },
+                "blocker_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DependencyGraph": {
+            "type": "object",
+            "properties": {
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DependencyEdge"
+                    }
+                },
+                "iteration_id": {
+                    "type": "string"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DependencyNode"
+                    }
+                }
+            }
+        },
+        "models.DependencyNode": {
+            "type": "object",
+            "properties": {
+                "blocked": {
+                    "type": "boolean"
+                },
+                "external": {
+                    "description": "Belongs to another iteration or to the backlog",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "iteration_id": {
+                    "description": "Nil for backlog tasks",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_task_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.StatusEnum"
+                }
+            }
+        },
+        "models.DuplicateIterationNumber": {
+            "type": "object",
+            "properties": {
+                "iterations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Iteration"
+                    }
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "project_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.EstimateAccuracy": {
+            "type": "object",
+            "properties": {
+                "bias": {
+                    "type": "number"
+                },
+                "byAssignee": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EstimateAccuracyGroup"
+                    }
+                },
+                "byPoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EstimateAccuracyGroup"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "direction": {
+                    "$ref": "#/definitions/models.EstimateDirection"
+                },
+                "mape": {
+                    "type": "number"
+                },
+                "meanRatio": {
+                    "type": "number"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.ProductivityEnum"
+                }
+            }
+        },
+        "models.EstimateAccuracyGroup": {
+            "type": "object",
+            "properties": {
+                "bias": {
+                    "type": "number"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "direction": {
+                    "$ref": "#/definitions/models.EstimateDirection"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "label": {
                     "type": "string"
                 },
-                "description": {
+                "mape": {
+                    "type": "number"
+                },
+                "meanRatio": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.EstimateAccuracyTrend": {
+            "type": "object",
+            "properties": {
+                "bias": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataPoint"
+                    }
+                },
+                "mape": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataPoint"
+                    }
+                },
+                "projectId": {
                     "type": "string"
                 },
-                "end_at": {
-                    "type": "string"
+                "xAxis": {
+                    "$ref": "#/definitions/models.AxisDefinition"
                 },
-                "indicator_range_id": {
-                    "type": "string"
+                "yAxis": {
+                    "$ref": "#/definitions/models.AxisDefinition"
+                }
+            }
+        },
+        "models.EstimateDirection": {
+            "type": "string",
+            "enum": [
+                "Under",
+                "Over",
+                "Accurate"
+            ],
+            "x-enum-varnames": [
+                "EstimateUnder",
+                "EstimateOver",
+                "EstimateAccurate"
+            ]
+        },
+        "models.ForecastDate": {
+            "type": "object",
+            "properties": {
+                "confidence": {
+                    "type": "integer"
                 },
-                "metric": {
+                "date": {
                     "type": "string"
                 },
-                "start_at": {
-                    "type": "string"
+                "iterations": {
+                    "type": "number"
                 },
-                "status": {
-                    "type": "string"
+                "reached": {
+                    "type": "boolean"
                 }
             }
         },
-        "handlers.CreateBugRequest": {
+        "models.ForecastMode": {
+            "type": "string",
+            "enum": [
+                "points",
+                "tasks"
+            ],
+            "x-enum-varnames": [
+                "ForecastPoints",
+                "ForecastTasks"
+            ]
+        },
+        "models.ForecastResponse": {
             "type": "object",
             "properties": {
-                "assignee_id": {
-                    "type": "string"
+                "backlogSize": {
+                    "type": "number"
                 },
-                "description": {
+                "completionDates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ForecastDate"
+                    }
+                },
+                "mode": {
+                    "$ref": "#/definitions/models.ForecastMode"
+                },
+                "projectId": {
                     "type": "string"
                 },
-                "number": {
+                "sampledIterations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ForecastSample"
+                    }
+                },
+                "scope": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ForecastScope"
+                    }
+                },
+                "seed": {
                     "type": "integer"
                 },
-                "points": {
+                "simulations": {
                     "type": "integer"
                 },
-                "task_id": {
+                "targetDate": {
                     "type": "string"
                 }
             }
         },
-        "handlers.CreateCauseRequest": {
+        "models.ForecastSample": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
+                "days": {
+                    "type": "number"
                 },
-                "indicator_range_id": {
-                    "type": "string"
+                "delivered": {
+                    "type": "number"
                 },
-                "metric": {
+                "iterationId": {
                     "type": "string"
                 },
-                "productivity_level": {
-                    "type": "string"
+                "number": {
+                    "type": "integer"
                 }
             }
         },
-        "handlers.CreateImprovRequest": {
+        "models.ForecastScope": {
             "type": "object",
             "properties": {
-                "assignee_id": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "number": {
-                    "type": "integer"
-                },
-                "points": {
+                "confidence": {
                     "type": "integer"
                 },
-                "task_id": {
-                    "type": "string"
+                "value": {
+                    "type": "number"
                 }
             }
         },
-        "handlers.CreateIndicatorRequest": {
+        "models.Holiday": {
             "type": "object",
             "properties": {
-                "iteration_id": {
+                "date": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "handlers.CreateIterationRequest": {
+        "models.Improv": {
             "type": "object",
             "properties": {
+                "assignee": {
+                    "$ref": "#/definitions/models.User"
+                },
+                "created_at": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
-                "end_at": {
+                "id": {
                     "type": "string"
                 },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Label"
+                    }
+                },
                 "number": {
                     "type": "integer"
                 },
-                "project_id": {
+                "points": {
+                    "type": "integer"
+                },
+                "task_id": {
                     "type": "string"
                 },
-                "start_at": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "handlers.CreateProjectRequest": {
+        "models.Indicator": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "color": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "indicator_ranges": {
+                "actions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handlers.IndicatorRangeRequest"
+                        "$ref": "#/definitions/models.Action"
                     }
                 },
-                "member_ids": {
+                "causes": {
+                    "description": "Associated causes and actions for improvement",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.Cause"
                     }
                 },
-                "name": {
-                    "type": "string"
-                }
-            }
-        },
-        "handlers.CreateTaskRequest": {
-            "type": "object",
-            "properties": {
-                "assignee_id": {
+                "created_at": {
                     "type": "string"
                 },
-                "description": {
+                "id": {
                     "type": "string"
                 },
-                "expected_time": {
-                    "description": "Expected time in hours",
+                "instability_level": {
+                    "$ref": "#/definitions/models.ProductivityEnum"
+                },
+                "instability_value": {
+                    "description": "improvements / tasks",
                     "type": "number"
                 },
                 "iteration_id": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "rework_level": {
+                    "$ref": "#/definitions/models.ProductivityEnum"
                 },
-                "points": {
-                    "type": "integer"
+                "rework_value": {
+                    "description": "bugs / tasks",
+                    "type": "number"
                 },
-                "status": {
-                    "type": "string"
+                "speed_level": {
+                    "description": "Calculated productivity levels based on project-level ranges\nThese are computed at runtime, not stored in DB",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ProductivityEnum"
+                        }
+                    ]
                 },
-                "timer": {
+                "speed_value": {
+                    "description": "Calculated metric values (stored in DB for performance)",
+                    "type": "number"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "handlers.CreateUserRequest": {
+        "models.IndicatorAnalysisData": {
             "type": "object",
-            "required": [
-                "email",
-                "name"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "accuracy": {
+                    "$ref": "#/definitions/models.EstimateAccuracy"
                 },
-                "name": {
+                "indicatorType": {
                     "type": "string"
+                },
+                "percentiles": {
+                    "$ref": "#/definitions/models.TimePercentiles"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataPoint"
+                    }
+                },
+                "summary": {
+                    "$ref": "#/definitions/models.SpeedSummary"
+                },
+                "values": {
+                    "$ref": "#/definitions/models.SpeedValues"
+                },
+                "xAxis": {
+                    "$ref": "#/definitions/models.AxisDefinition"
+                },
+                "yAxis": {
+                    "$ref": "#/definitions/models.AxisDefinition"
                 }
             }
         },
-        "handlers.IndicatorRangeRequest": {
+        "models.IndicatorEnum": {
+            "type": "string",
+            "enum": [
+                "SpeedPerIteration",
+                "ReworkPerIteration",
+                "InstabilityIndex",
+                "LeadTime",
+                "CycleTime",
+                "EstimateAccuracy"
+            ],
+            "x-enum-varnames": [
+                "IndicatorSpeedPerIteration",
+                "IndicatorReworkPerIteration",
+                "IndicatorInstabilityIndex",
+                "IndicatorLeadTime",
+                "IndicatorCycleTime",
+                "IndicatorEstimateAccuracy"
+            ]
+        },
+        "models.IndicatorMetricValue": {
             "type": "object",
             "properties": {
                 "indicator_type": {
-                    "type": "string"
+                    "$ref": "#/definitions/models.IndicatorEnum"
                 },
-                "range": {
-                    "$ref": "#/definitions/handlers.ProductivityRangeRequest"
+                "productivity_level": {
+                    "$ref": "#/definitions/models.ProductivityEnum"
+                },
+                "value": {
+                    "type": "number"
                 }
             }
         },
-        "handlers.LoginRequest": {
+        "models.IndicatorRange": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
+                "created_at": {
                     "type": "string"
                 },
-                "password": {
+                "id": {
                     "type": "string"
-                }
-            }
-        },
-        "handlers.LoginResponse": {
-            "type": "object",
-            "properties": {
-                "token": {
+                },
+                "indicator_type": {
+                    "$ref": "#/definitions/models.IndicatorEnum"
+                },
+                "project_id": {
                     "type": "string"
                 },
-                "user": {
-                    "type": "object",
-                    "properties": {
-                        "email": {
-                            "type": "string"
-                        },
-                        "id": {
-                            "type": "string"
-                        },
-                        "name": {
-                            "type": "string"
-                        }
-                    }
+                "range": {
+                    "$ref": "#/definitions/models.ProductivityRange"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "handlers.PatchActionRequest": {
+        "models.Iteration": {
             "type": "object",
             "properties": {
-                "assignee_id": {
+                "created_at": {
                     "type": "string"
                 },
                 "description": {
@@ -2657,300 +9546,391 @@ const docTemplate = `{
                 "end_at": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "project_id": {
+                    "type": "string"
+                },
                 "start_at": {
                     "type": "string"
                 },
-                "status": {
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Task"
+                    }
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "handlers.PatchTaskRequest": {
+        "models.IterationAnalysisResponse": {
             "type": "object",
             "properties": {
-                "assignee_id": {
-                    "type": "string"
+                "analysis": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.IndicatorAnalysisData"
+                    }
                 },
-                "description": {
+                "iterationId": {
                     "type": "string"
+                }
+            }
+        },
+        "models.IterationCadence": {
+            "type": "object",
+            "properties": {
+                "ahead": {
+                    "description": "0 generates only on request",
+                    "type": "integer"
                 },
-                "expected_time": {
-                    "description": "Expected time in hours",
-                    "type": "number"
-                },
-                "name": {
+                "created_at": {
                     "type": "string"
                 },
-                "points": {
+                "gap_days": {
                     "type": "integer"
                 },
-                "status": {
+                "length_days": {
+                    "type": "integer"
+                },
+                "project_id": {
                     "type": "string"
                 },
-                "timer": {
+                "start_weekday": {
+                    "description": "0 is Sunday",
+                    "type": "integer"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "handlers.ProductivityRangeRequest": {
+        "models.IterationCapacity": {
             "type": "object",
             "properties": {
-                "alert": {
-                    "$ref": "#/definitions/handlers.RangeValuesRequest"
+                "historicalIterations": {
+                    "type": "integer"
                 },
-                "critical": {
-                    "$ref": "#/definitions/handlers.RangeValuesRequest"
+                "iterationId": {
+                    "type": "string"
                 },
-                "ok": {
-                    "$ref": "#/definitions/handlers.RangeValuesRequest"
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MemberCapacity"
+                    }
+                },
+                "pointsPerHour": {
+                    "type": "number"
+                },
+                "team": {
+                    "$ref": "#/definitions/models.CapacitySummary"
+                },
+                "unassigned": {
+                    "$ref": "#/definitions/models.CapacityPlanned"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CapacityWarning"
+                    }
+                },
+                "workingDays": {
+                    "type": "integer"
                 }
             }
         },
-        "handlers.RangeValuesRequest": {
+        "models.IterationError": {
             "type": "object",
             "properties": {
-                "max": {
-                    "type": "number"
+                "code": {
+                    "$ref": "#/definitions/models.IterationErrorCode"
                 },
-                "min": {
-                    "type": "number"
+                "conflicts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Iteration"
+                    }
+                },
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "handlers.RegisterRequest": {
-            "type": "object",
-            "required": [
-                "email",
-                "name",
-                "password"
+        "models.IterationErrorCode": {
+            "type": "string",
+            "enum": [
+                "invalid_date_range",
+                "overlapping_iteration",
+                "no_previous_iteration",
+                "generation_failed"
             ],
+            "x-enum-varnames": [
+                "IterationInvalidDateRange",
+                "IterationOverlap",
+                "IterationNoPrevious",
+                "IterationNotGenerated"
+            ]
+        },
+        "models.Label": {
+            "type": "object",
             "properties": {
-                "email": {
+                "color": {
                     "type": "string"
                 },
-                "name": {
+                "created_at": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
-                }
-            }
-        },
-        "handlers.SetRangeRequest": {
-            "type": "object",
-            "properties": {
-                "indicator_type": {
-                    "description": "SpeedPerIteration, ReworkPerIteration, InstabilityIndex",
+                "id": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 },
                 "project_id": {
                     "type": "string"
                 },
-                "range": {
-                    "$ref": "#/definitions/handlers.ProductivityRangeRequest"
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "handlers.UpdateMetricValuesRequest": {
+        "models.LabelAnalysis": {
             "type": "object",
             "properties": {
-                "instability_value": {
+                "bugPoints": {
                     "type": "number"
                 },
-                "rework_value": {
+                "completedPoints": {
+                    "type": "integer"
+                },
+                "completedTasks": {
+                    "type": "integer"
+                },
+                "improvementPoints": {
                     "type": "number"
                 },
-                "speed_value": {
+                "instability": {
+                    "type": "number"
+                },
+                "instabilityStatus": {
+                    "$ref": "#/definitions/models.ProductivityEnum"
+                },
+                "label": {
+                    "$ref": "#/definitions/models.Label"
+                },
+                "pointsShare": {
+                    "type": "number"
+                },
+                "rework": {
                     "type": "number"
+                },
+                "reworkStatus": {
+                    "$ref": "#/definitions/models.ProductivityEnum"
+                },
+                "tasks": {
+                    "type": "integer"
                 }
             }
         },
-        "handlers.UpdateProjectRequest": {
+        "models.LabelAnalysisResponse": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "color": {
+                "groupBy": {
                     "type": "string"
                 },
-                "description": {
+                "iterationId": {
                     "type": "string"
                 },
-                "member_ids": {
+                "labels": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.LabelAnalysis"
                     }
-                },
-                "name": {
-                    "type": "string"
                 }
             }
         },
-        "handlers.UpdateTaskRequest": {
+        "models.Member": {
             "type": "object",
             "properties": {
-                "assignee_id": {
+                "email": {
                     "type": "string"
                 },
-                "description": {
+                "id": {
                     "type": "string"
                 },
-                "expected_time": {
-                    "description": "Expected time in hours",
-                    "type": "number"
-                },
                 "name": {
                     "type": "string"
                 },
-                "points": {
-                    "type": "integer"
-                },
-                "status": {
-                    "type": "string"
-                },
-                "timer": {
-                    "type": "string"
-                }
-            }
-        },
-        "handlers.UpdateUserRequest": {
-            "type": "object",
-            "required": [
-                "email",
-                "name"
-            ],
-            "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "name": {
+                "project_id": {
                     "type": "string"
                 }
             }
         },
-        "models.Action": {
+        "models.MemberAnalysis": {
             "type": "object",
             "properties": {
                 "assignee": {
-                    "$ref": "#/definitions/models.User"
+                    "$ref": "#/definitions/models.Member"
                 },
-                "cause": {
-                    "$ref": "#/definitions/models.Cause"
+                "completedPoints": {
+                    "type": "integer"
                 },
-                "created_at": {
-                    "type": "string"
+                "completedTasks": {
+                    "type": "integer"
                 },
-                "description": {
-                    "type": "string"
+                "instability": {
+                    "type": "number"
                 },
-                "end_at": {
-                    "type": "string"
+                "instabilityStatus": {
+                    "$ref": "#/definitions/models.ProductivityEnum"
                 },
-                "id": {
-                    "type": "string"
+                "loggedHours": {
+                    "type": "number"
                 },
-                "indicator_range_id": {
-                    "type": "string"
+                "pointsShare": {
+                    "type": "number"
                 },
-                "start_at": {
-                    "type": "string"
+                "rework": {
+                    "type": "number"
                 },
-                "status": {
-                    "$ref": "#/definitions/models.StatusEnum"
+                "reworkStatus": {
+                    "$ref": "#/definitions/models.ProductivityEnum"
                 },
-                "updated_at": {
-                    "type": "string"
+                "speed": {
+                    "$ref": "#/definitions/models.SpeedValues"
+                },
+                "speedStatus": {
+                    "$ref": "#/definitions/models.ProductivityEnum"
+                },
+                "timeShare": {
+                    "type": "number"
                 }
             }
         },
-        "models.AxisDefinition": {
+        "models.MemberAnalysisResponse": {
             "type": "object",
             "properties": {
-                "label": {
+                "groupBy": {
                     "type": "string"
                 },
-                "type": {
+                "iterationId": {
                     "type": "string"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MemberAnalysis"
+                    }
                 }
             }
         },
-        "models.Bug": {
+        "models.MemberAvailability": {
             "type": "object",
             "properties": {
-                "assignee": {
-                    "$ref": "#/definitions/models.User"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "number": {
-                    "type": "integer"
-                },
-                "points": {
-                    "type": "integer"
-                },
-                "task_id": {
-                    "type": "string"
+                "days_off": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "updated_at": {
-                    "type": "string"
+                "hours_per_day": {
+                    "type": "number"
+                },
+                "member": {
+                    "$ref": "#/definitions/models.Member"
                 }
             }
         },
-        "models.Cause": {
+        "models.MemberCapacity": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "availableDays": {
+                    "type": "integer"
                 },
-                "description": {
-                    "type": "string"
+                "capacityHours": {
+                    "type": "number"
                 },
-                "id": {
-                    "type": "string"
+                "capacityPoints": {
+                    "type": "number"
                 },
-                "indicator_range_id": {
-                    "type": "string"
+                "daysOff": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "metric": {
-                    "$ref": "#/definitions/models.MetricEnum"
+                "hoursPerDay": {
+                    "type": "number"
                 },
-                "productivity_level": {
-                    "$ref": "#/definitions/models.ProductivityEnum"
+                "member": {
+                    "$ref": "#/definitions/models.Member"
                 },
-                "updated_at": {
-                    "type": "string"
+                "overcommitted": {
+                    "type": "boolean"
+                },
+                "plannedHours": {
+                    "type": "number"
+                },
+                "plannedPoints": {
+                    "type": "integer"
+                },
+                "tasks": {
+                    "type": "integer"
                 }
             }
         },
-        "models.DataPoint": {
+        "models.MetricEnum": {
+            "type": "string",
+            "enum": [
+                "WorkVelocity",
+                "ReworkIndex",
+                "InstabilityIndex"
+            ],
+            "x-enum-varnames": [
+                "MetricWorkVelocity",
+                "MetricReworkIndex",
+                "MetricInstabilityIndex"
+            ]
+        },
+        "models.ProductivityEnum": {
+            "type": "string",
+            "enum": [
+                "Ok",
+                "Alert",
+                "Critical"
+            ],
+            "x-enum-varnames": [
+                "ProductivityOk",
+                "ProductivityAlert",
+                "ProductivityCritical"
+            ]
+        },
+        "models.ProductivityRange": {
             "type": "object",
             "properties": {
-                "status": {
-                    "$ref": "#/definitions/models.ProductivityEnum"
+                "alert": {
+                    "$ref": "#/definitions/models.RangeValues"
                 },
-                "x": {
-                    "type": "integer"
+                "critical": {
+                    "$ref": "#/definitions/models.RangeValues"
                 },
-                "y": {
-                    "type": "number"
+                "ok": {
+                    "$ref": "#/definitions/models.RangeValues"
                 }
             }
         },
-        "models.Improv": {
+        "models.Project": {
             "type": "object",
             "properties": {
-                "assignee": {
-                    "$ref": "#/definitions/models.User"
+                "color": {
+                    "type": "string"
                 },
                 "created_at": {
                     "type": "string"
@@ -2961,13 +9941,13 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "number": {
-                    "type": "integer"
-                },
-                "points": {
-                    "type": "integer"
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.User"
+                    }
                 },
-                "task_id": {
+                "name": {
                     "type": "string"
                 },
                 "updated_at": {
@@ -2975,257 +9955,235 @@ const docTemplate = `{
                 }
             }
         },
-        "models.Indicator": {
+        "models.ProjectDashboard": {
             "type": "object",
             "properties": {
                 "actions": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Action"
-                    }
-                },
-                "causes": {
-                    "description": "Associated causes and actions for improvement",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Cause"
-                    }
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "instability_level": {
-                    "$ref": "#/definitions/models.ProductivityEnum"
-                },
-                "instability_value": {
-                    "description": "improvements / tasks",
-                    "type": "number"
-                },
-                "iteration_id": {
-                    "type": "string"
-                },
-                "rework_level": {
-                    "$ref": "#/definitions/models.ProductivityEnum"
-                },
-                "rework_value": {
-                    "description": "bugs / tasks",
-                    "type": "number"
+                    "$ref": "#/definitions/models.DashboardActions"
                 },
-                "speed_level": {
-                    "description": "Calculated productivity levels based on project-level ranges\nThese are computed at runtime, not stored in DB",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.ProductivityEnum"
-                        }
-                    ]
+                "bugs": {
+                    "$ref": "#/definitions/models.DashboardCount"
                 },
-                "speed_value": {
-                    "description": "Calculated metric values (stored in DB for performance)",
-                    "type": "number"
+                "current_iteration": {
+                    "$ref": "#/definitions/models.DashboardIteration"
                 },
-                "updated_at": {
-                    "type": "string"
-                }
-            }
-        },
-        "models.IndicatorAnalysisData": {
-            "type": "object",
-            "properties": {
-                "indicatorType": {
-                    "type": "string"
+                "improvements": {
+                    "$ref": "#/definitions/models.DashboardCount"
                 },
-                "points": {
+                "indicators": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.DataPoint"
+                        "$ref": "#/definitions/models.DashboardIndicator"
                     }
                 },
-                "xAxis": {
-                    "$ref": "#/definitions/models.AxisDefinition"
+                "project": {
+                    "$ref": "#/definitions/models.DashboardProject"
                 },
-                "yAxis": {
-                    "$ref": "#/definitions/models.AxisDefinition"
+                "recent_activity": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ActivityEntry"
+                    }
                 }
             }
         },
-        "models.IndicatorEnum": {
+        "models.ProjectRoleEnum": {
             "type": "string",
             "enum": [
-                "SpeedPerIteration",
-                "ReworkPerIteration",
-                "InstabilityIndex"
+                "owner",
+                "maintainer",
+                "member"
             ],
             "x-enum-varnames": [
-                "IndicatorSpeedPerIteration",
-                "IndicatorReworkPerIteration",
-                "IndicatorInstabilityIndex"
+                "RoleOwner",
+                "RoleMaintainer",
+                "RoleMember"
             ]
         },
-        "models.IndicatorMetricValue": {
+        "models.RangeValues": {
             "type": "object",
             "properties": {
-                "indicator_type": {
-                    "$ref": "#/definitions/models.IndicatorEnum"
-                },
-                "productivity_level": {
-                    "$ref": "#/definitions/models.ProductivityEnum"
+                "max": {
+                    "type": "number"
                 },
-                "value": {
+                "min": {
                     "type": "number"
                 }
             }
         },
-        "models.IndicatorRange": {
+        "models.Retrospective": {
             "type": "object",
             "properties": {
+                "analysis": {
+                    "$ref": "#/definitions/models.IterationAnalysisResponse"
+                },
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RetrospectiveColumn"
+                    }
+                },
                 "created_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "indicator_type": {
-                    "$ref": "#/definitions/models.IndicatorEnum"
-                },
-                "project_id": {
+                "iteration_id": {
                     "type": "string"
                 },
-                "range": {
-                    "$ref": "#/definitions/models.ProductivityRange"
-                },
                 "updated_at": {
                     "type": "string"
+                },
+                "votes_per_member": {
+                    "type": "integer"
                 }
             }
         },
-        "models.Iteration": {
+        "models.RetrospectiveColumn": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
-                },
-                "end_at": {
-                    "type": "string"
-                },
                 "id": {
                     "type": "string"
                 },
-                "number": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RetrospectiveItem"
+                    }
+                },
+                "position": {
                     "type": "integer"
                 },
-                "project_id": {
+                "retrospective_id": {
                     "type": "string"
                 },
-                "start_at": {
+                "title": {
                     "type": "string"
                 },
-                "tasks": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Task"
-                    }
-                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.IterationAnalysisResponse": {
+        "models.RetrospectiveItem": {
             "type": "object",
             "properties": {
-                "analysis": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "$ref": "#/definitions/models.IndicatorAnalysisData"
-                    }
+                "action_id": {
+                    "type": "string"
                 },
-                "iterationId": {
+                "author": {
+                    "$ref": "#/definitions/models.User"
+                },
+                "cause_id": {
+                    "type": "string"
+                },
+                "column_id": {
+                    "type": "string"
+                },
+                "created_at": {
                     "type": "string"
-                }
-            }
-        },
-        "models.MetricEnum": {
-            "type": "string",
-            "enum": [
-                "WorkVelocity",
-                "ReworkIndex",
-                "InstabilityIndex"
-            ],
-            "x-enum-varnames": [
-                "MetricWorkVelocity",
-                "MetricReworkIndex",
-                "MetricInstabilityIndex"
-            ]
-        },
-        "models.ProductivityEnum": {
-            "type": "string",
-            "enum": [
-                "Ok",
-                "Alert",
-                "Critical"
-            ],
-            "x-enum-varnames": [
-                "ProductivityOk",
-                "ProductivityAlert",
-                "ProductivityCritical"
-            ]
-        },
-        "models.ProductivityRange": {
-            "type": "object",
-            "properties": {
-                "alert": {
-                    "$ref": "#/definitions/models.RangeValues"
                 },
-                "critical": {
-                    "$ref": "#/definitions/models.RangeValues"
+                "description": {
+                    "type": "string"
                 },
-                "ok": {
-                    "$ref": "#/definitions/models.RangeValues"
+                "id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "votes": {
+                    "type": "integer"
                 }
             }
         },
-        "models.Project": {
+        "models.SearchHit": {
             "type": "object",
             "properties": {
-                "color": {
+                "highlight": {
                     "type": "string"
                 },
-                "created_at": {
+                "id": {
                     "type": "string"
                 },
-                "description": {
+                "project_id": {
                     "type": "string"
                 },
-                "id": {
+                "project_name": {
                     "type": "string"
                 },
-                "members": {
+                "rank": {
+                    "type": "number"
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/models.SearchHitType"
+                }
+            }
+        },
+        "models.SearchHitType": {
+            "type": "string",
+            "enum": [
+                "project",
+                "task",
+                "bug",
+                "improvement",
+                "cause",
+                "action"
+            ],
+            "x-enum-varnames": [
+                "SearchProject",
+                "SearchTask",
+                "SearchBug",
+                "SearchImprovement",
+                "SearchCause",
+                "SearchAction"
+            ]
+        },
+        "models.SearchResponse": {
+            "type": "object",
+            "properties": {
+                "hits": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.User"
+                        "$ref": "#/definitions/models.SearchHit"
                     }
                 },
-                "name": {
+                "query": {
                     "type": "string"
+                }
+            }
+        },
+        "models.SpeedSummary": {
+            "type": "object",
+            "properties": {
+                "totalActualTime": {
+                    "type": "number"
                 },
-                "updated_at": {
-                    "type": "string"
+                "totalEstimatedTime": {
+                    "type": "number"
+                },
+                "totalPoints": {
+                    "type": "integer"
                 }
             }
         },
-        "models.RangeValues": {
+        "models.SpeedValues": {
             "type": "object",
             "properties": {
-                "max": {
+                "actualSpeed": {
                     "type": "number"
                 },
-                "min": {
+                "expectedSpeed": {
                     "type": "number"
                 }
             }
@@ -3243,18 +10201,83 @@ const docTemplate = `{
                 "StatusCompleted"
             ]
         },
+        "models.StatusSeries": {
+            "type": "object",
+            "properties": {
+                "counts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataPoint"
+                    }
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataPoint"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/models.StatusEnum"
+                }
+            }
+        },
+        "models.StatusTransition": {
+            "type": "object",
+            "properties": {
+                "from_status": {
+                    "$ref": "#/definitions/models.StatusEnum"
+                },
+                "to_status": {
+                    "$ref": "#/definitions/models.StatusEnum"
+                }
+            }
+        },
         "models.Task": {
             "type": "object",
             "properties": {
                 "assignee": {
                     "$ref": "#/definitions/models.User"
                 },
+                "attachments": {
+                    "description": "Only loaded with include=attachments",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Attachment"
+                    }
+                },
+                "blocked": {
+                    "description": "Some task in BlockedBy is not completed yet",
+                    "type": "boolean"
+                },
+                "blocked_by": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TaskRef"
+                    }
+                },
+                "blocks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TaskRef"
+                    }
+                },
                 "bugs": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/models.Bug"
                     }
                 },
+                "comments": {
+                    "description": "Only loaded with include=comments",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Comment"
+                    }
+                },
+                "completed_at": {
+                    "description": "Last time the task moved to Completed",
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -3274,14 +10297,35 @@ const docTemplate = `{
                     }
                 },
                 "iteration_id": {
+                    "description": "Nil while the task sits in the project backlog",
                     "type": "string"
                 },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Label"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
+                "parent_task_id": {
+                    "type": "string"
+                },
                 "points": {
                     "type": "integer"
                 },
+                "project_id": {
+                    "type": "string"
+                },
+                "rank": {
+                    "description": "Position within the status column; compare as plain strings",
+                    "type": "string"
+                },
+                "started_at": {
+                    "description": "First time the task moved to InProgress",
+                    "type": "string"
+                },
                 "status": {
                     "$ref": "#/definitions/models.StatusEnum"
                 },
@@ -3300,6 +10344,157 @@ const docTemplate = `{
                 }
             }
         },
+        "models.TaskBulkOperation": {
+            "type": "string",
+            "enum": [
+                "patch",
+                "move_to_iteration",
+                "delete"
+            ],
+            "x-enum-varnames": [
+                "TaskBulkPatch",
+                "TaskBulkMoveToIteration",
+                "TaskBulkDelete"
+            ]
+        },
+        "models.TaskBulkResponse": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "operation": {
+                    "$ref": "#/definitions/models.TaskBulkOperation"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TaskBulkResult"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.TaskBulkResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "ok": {
+                    "type": "boolean"
+                },
+                "task_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.TaskRef": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "iteration_id": {
+                    "description": "Nil for backlog tasks",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.StatusEnum"
+                }
+            }
+        },
+        "models.TaskStatusChange": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "changed_at": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "$ref": "#/definitions/models.StatusEnum"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "$ref": "#/definitions/models.StatusEnum"
+                }
+            }
+        },
+        "models.ThroughputInterval": {
+            "type": "string",
+            "enum": [
+                "day",
+                "week"
+            ],
+            "x-enum-varnames": [
+                "ThroughputDaily",
+                "ThroughputWeekly"
+            ]
+        },
+        "models.ThroughputResponse": {
+            "type": "object",
+            "properties": {
+                "completedPoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataPoint"
+                    }
+                },
+                "completedTasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataPoint"
+                    }
+                },
+                "interval": {
+                    "$ref": "#/definitions/models.ThroughputInterval"
+                },
+                "projectId": {
+                    "type": "string"
+                },
+                "xAxis": {
+                    "$ref": "#/definitions/models.AxisDefinition"
+                },
+                "yAxis": {
+                    "$ref": "#/definitions/models.AxisDefinition"
+                }
+            }
+        },
+        "models.TimePercentiles": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "p50": {
+                    "type": "number"
+                },
+                "p85": {
+                    "type": "number"
+                },
+                "p95": {
+                    "type": "number"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.ProductivityEnum"
+                }
+            }
+        },
         "models.User": {
             "type": "object",
             "properties": {
@@ -3318,10 +10513,53 @@ const docTemplate = `{
                 "project_id": {
                     "type": "string"
                 },
+                "role": {
+                    "description": "Only set when listed as a project member",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ProjectRoleEnum"
+                        }
+                    ]
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
+        },
+        "models.WIPLimit": {
+            "type": "object",
+            "properties": {
+                "max_tasks": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.StatusEnum"
+                }
+            }
+        },
+        "models.WorkingCalendar": {
+            "type": "object",
+            "properties": {
+                "holidays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Holiday"
+                    }
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "working_days": {
+                    "description": "0 is Sunday",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
         }
     },
     "securityDefinitions": {